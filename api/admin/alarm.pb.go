@@ -0,0 +1,1049 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: alarm.proto
+
+package admin
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetAlarmQueueStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 返回的延迟队列消息数上限，默认100
+	DelayedLimit  int32 `protobuf:"varint,1,opt,name=delayed_limit,json=delayedLimit,proto3" json:"delayed_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlarmQueueStatsRequest) Reset() {
+	*x = GetAlarmQueueStatsRequest{}
+	mi := &file_alarm_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlarmQueueStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlarmQueueStatsRequest) ProtoMessage() {}
+
+func (x *GetAlarmQueueStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alarm_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlarmQueueStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAlarmQueueStatsRequest) Descriptor() ([]byte, []int) {
+	return file_alarm_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAlarmQueueStatsRequest) GetDelayedLimit() int32 {
+	if x != nil {
+		return x.DelayedLimit
+	}
+	return 0
+}
+
+type AlarmQueueMessage struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Platform string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	Title    string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Info     string                 `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	TraceId  string                 `protobuf:"bytes,4,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	Retry    int32                  `protobuf:"varint,5,opt,name=retry,proto3" json:"retry,omitempty"`
+	MaxRetry int32                  `protobuf:"varint,6,opt,name=max_retry,json=maxRetry,proto3" json:"max_retry,omitempty"`
+	// 延迟消息的预计执行时间
+	ExecuteAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=execute_at,json=executeAt,proto3" json:"execute_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlarmQueueMessage) Reset() {
+	*x = AlarmQueueMessage{}
+	mi := &file_alarm_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlarmQueueMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlarmQueueMessage) ProtoMessage() {}
+
+func (x *AlarmQueueMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_alarm_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlarmQueueMessage.ProtoReflect.Descriptor instead.
+func (*AlarmQueueMessage) Descriptor() ([]byte, []int) {
+	return file_alarm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AlarmQueueMessage) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *AlarmQueueMessage) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AlarmQueueMessage) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+func (x *AlarmQueueMessage) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *AlarmQueueMessage) GetRetry() int32 {
+	if x != nil {
+		return x.Retry
+	}
+	return 0
+}
+
+func (x *AlarmQueueMessage) GetMaxRetry() int32 {
+	if x != nil {
+		return x.MaxRetry
+	}
+	return 0
+}
+
+func (x *AlarmQueueMessage) GetExecuteAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecuteAt
+	}
+	return nil
+}
+
+type FusedAlarmMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 消息指纹（告警内容的md5）
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Info        string `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	// 熔断剩余时长（秒）
+	TtlSeconds    int64 `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FusedAlarmMessage) Reset() {
+	*x = FusedAlarmMessage{}
+	mi := &file_alarm_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FusedAlarmMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FusedAlarmMessage) ProtoMessage() {}
+
+func (x *FusedAlarmMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_alarm_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FusedAlarmMessage.ProtoReflect.Descriptor instead.
+func (*FusedAlarmMessage) Descriptor() ([]byte, []int) {
+	return file_alarm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FusedAlarmMessage) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *FusedAlarmMessage) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+func (x *FusedAlarmMessage) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type GetAlarmQueueStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 主队列深度
+	QueueLength int64 `protobuf:"varint,1,opt,name=queue_length,json=queueLength,proto3" json:"queue_length,omitempty"`
+	// 延迟（重试）队列大小
+	DelayedSize     int64                `protobuf:"varint,2,opt,name=delayed_size,json=delayedSize,proto3" json:"delayed_size,omitempty"`
+	DelayedMessages []*AlarmQueueMessage `protobuf:"bytes,3,rep,name=delayed_messages,json=delayedMessages,proto3" json:"delayed_messages,omitempty"`
+	FusedMessages   []*FusedAlarmMessage `protobuf:"bytes,4,rep,name=fused_messages,json=fusedMessages,proto3" json:"fused_messages,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetAlarmQueueStatsResponse) Reset() {
+	*x = GetAlarmQueueStatsResponse{}
+	mi := &file_alarm_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlarmQueueStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlarmQueueStatsResponse) ProtoMessage() {}
+
+func (x *GetAlarmQueueStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alarm_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlarmQueueStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAlarmQueueStatsResponse) Descriptor() ([]byte, []int) {
+	return file_alarm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAlarmQueueStatsResponse) GetQueueLength() int64 {
+	if x != nil {
+		return x.QueueLength
+	}
+	return 0
+}
+
+func (x *GetAlarmQueueStatsResponse) GetDelayedSize() int64 {
+	if x != nil {
+		return x.DelayedSize
+	}
+	return 0
+}
+
+func (x *GetAlarmQueueStatsResponse) GetDelayedMessages() []*AlarmQueueMessage {
+	if x != nil {
+		return x.DelayedMessages
+	}
+	return nil
+}
+
+func (x *GetAlarmQueueStatsResponse) GetFusedMessages() []*FusedAlarmMessage {
+	if x != nil {
+		return x.FusedMessages
+	}
+	return nil
+}
+
+type AlarmSilence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 标题正则，为空时匹配所有
+	TitlePattern string `protobuf:"bytes,2,opt,name=title_pattern,json=titlePattern,proto3" json:"title_pattern,omitempty"`
+	// 内容正则，为空时匹配所有
+	InfoPattern string `protobuf:"bytes,3,opt,name=info_pattern,json=infoPattern,proto3" json:"info_pattern,omitempty"`
+	// 标签正则：platform/service/env/host/operation/path/user_id
+	Labels    map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Creator   string                 `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	Comment   string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	StartsAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Active    bool                   `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`
+	// 剩余时长（秒）
+	RemainingSeconds int64 `protobuf:"varint,11,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AlarmSilence) Reset() {
+	*x = AlarmSilence{}
+	mi := &file_alarm_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlarmSilence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlarmSilence) ProtoMessage() {}
+
+func (x *AlarmSilence) ProtoReflect() protoreflect.Message {
+	mi := &file_alarm_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlarmSilence.ProtoReflect.Descriptor instead.
+func (*AlarmSilence) Descriptor() ([]byte, []int) {
+	return file_alarm_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AlarmSilence) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AlarmSilence) GetTitlePattern() string {
+	if x != nil {
+		return x.TitlePattern
+	}
+	return ""
+}
+
+func (x *AlarmSilence) GetInfoPattern() string {
+	if x != nil {
+		return x.InfoPattern
+	}
+	return ""
+}
+
+func (x *AlarmSilence) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *AlarmSilence) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *AlarmSilence) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AlarmSilence) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *AlarmSilence) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *AlarmSilence) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AlarmSilence) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *AlarmSilence) GetRemainingSeconds() int64 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+type CreateAlarmSilenceRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TitlePattern string                 `protobuf:"bytes,1,opt,name=title_pattern,json=titlePattern,proto3" json:"title_pattern,omitempty"`
+	InfoPattern  string                 `protobuf:"bytes,2,opt,name=info_pattern,json=infoPattern,proto3" json:"info_pattern,omitempty"`
+	Labels       map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Comment      string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	// 开始时间，为空时立即生效
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// 结束时间，与duration二选一
+	EndsAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// 从开始时间起的持续时长
+	Duration      *durationpb.Duration `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlarmSilenceRequest) Reset() {
+	*x = CreateAlarmSilenceRequest{}
+	mi := &file_alarm_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlarmSilenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlarmSilenceRequest) ProtoMessage() {}
+
+func (x *CreateAlarmSilenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alarm_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlarmSilenceRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlarmSilenceRequest) Descriptor() ([]byte, []int) {
+	return file_alarm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateAlarmSilenceRequest) GetTitlePattern() string {
+	if x != nil {
+		return x.TitlePattern
+	}
+	return ""
+}
+
+func (x *CreateAlarmSilenceRequest) GetInfoPattern() string {
+	if x != nil {
+		return x.InfoPattern
+	}
+	return ""
+}
+
+func (x *CreateAlarmSilenceRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateAlarmSilenceRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CreateAlarmSilenceRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreateAlarmSilenceRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CreateAlarmSilenceRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type ListAlarmSilencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否包含已结束的规则
+	IncludeExpired bool `protobuf:"varint,1,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAlarmSilencesRequest) Reset() {
+	*x = ListAlarmSilencesRequest{}
+	mi := &file_alarm_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlarmSilencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlarmSilencesRequest) ProtoMessage() {}
+
+func (x *ListAlarmSilencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alarm_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlarmSilencesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlarmSilencesRequest) Descriptor() ([]byte, []int) {
+	return file_alarm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListAlarmSilencesRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+type ListAlarmSilencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Silences      []*AlarmSilence        `protobuf:"bytes,1,rep,name=silences,proto3" json:"silences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlarmSilencesResponse) Reset() {
+	*x = ListAlarmSilencesResponse{}
+	mi := &file_alarm_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlarmSilencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlarmSilencesResponse) ProtoMessage() {}
+
+func (x *ListAlarmSilencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alarm_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlarmSilencesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlarmSilencesResponse) Descriptor() ([]byte, []int) {
+	return file_alarm_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAlarmSilencesResponse) GetSilences() []*AlarmSilence {
+	if x != nil {
+		return x.Silences
+	}
+	return nil
+}
+
+type ExpireAlarmSilenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireAlarmSilenceRequest) Reset() {
+	*x = ExpireAlarmSilenceRequest{}
+	mi := &file_alarm_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireAlarmSilenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireAlarmSilenceRequest) ProtoMessage() {}
+
+func (x *ExpireAlarmSilenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alarm_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireAlarmSilenceRequest.ProtoReflect.Descriptor instead.
+func (*ExpireAlarmSilenceRequest) Descriptor() ([]byte, []int) {
+	return file_alarm_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExpireAlarmSilenceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AlarmAck struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Fingerprint string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Creator     string                 `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Comment     string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	AckedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=acked_at,json=ackedAt,proto3" json:"acked_at,omitempty"`
+	// 持续未触发多久后视为恢复（秒）
+	RemainingSeconds int64 `protobuf:"varint,5,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AlarmAck) Reset() {
+	*x = AlarmAck{}
+	mi := &file_alarm_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlarmAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlarmAck) ProtoMessage() {}
+
+func (x *AlarmAck) ProtoReflect() protoreflect.Message {
+	mi := &file_alarm_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlarmAck.ProtoReflect.Descriptor instead.
+func (*AlarmAck) Descriptor() ([]byte, []int) {
+	return file_alarm_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AlarmAck) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *AlarmAck) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *AlarmAck) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AlarmAck) GetAckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AckedAt
+	}
+	return nil
+}
+
+func (x *AlarmAck) GetRemainingSeconds() int64 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+type AckAlarmRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 告警卡片中的Fingerprint
+	Fingerprint   string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Comment       string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckAlarmRequest) Reset() {
+	*x = AckAlarmRequest{}
+	mi := &file_alarm_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckAlarmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckAlarmRequest) ProtoMessage() {}
+
+func (x *AckAlarmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alarm_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckAlarmRequest.ProtoReflect.Descriptor instead.
+func (*AckAlarmRequest) Descriptor() ([]byte, []int) {
+	return file_alarm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AckAlarmRequest) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *AckAlarmRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ListAlarmAcksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Acks          []*AlarmAck            `protobuf:"bytes,1,rep,name=acks,proto3" json:"acks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlarmAcksResponse) Reset() {
+	*x = ListAlarmAcksResponse{}
+	mi := &file_alarm_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlarmAcksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlarmAcksResponse) ProtoMessage() {}
+
+func (x *ListAlarmAcksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alarm_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlarmAcksResponse.ProtoReflect.Descriptor instead.
+func (*ListAlarmAcksResponse) Descriptor() ([]byte, []int) {
+	return file_alarm_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListAlarmAcksResponse) GetAcks() []*AlarmAck {
+	if x != nil {
+		return x.Acks
+	}
+	return nil
+}
+
+type UnackAlarmRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fingerprint   string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnackAlarmRequest) Reset() {
+	*x = UnackAlarmRequest{}
+	mi := &file_alarm_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnackAlarmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnackAlarmRequest) ProtoMessage() {}
+
+func (x *UnackAlarmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alarm_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnackAlarmRequest.ProtoReflect.Descriptor instead.
+func (*UnackAlarmRequest) Descriptor() ([]byte, []int) {
+	return file_alarm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UnackAlarmRequest) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+var File_alarm_proto protoreflect.FileDescriptor
+
+const file_alarm_proto_rawDesc = "" +
+	"\n" +
+	"\valarm.proto\x12\x05admin\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"L\n" +
+	"\x19GetAlarmQueueStatsRequest\x12/\n" +
+	"\rdelayed_limit\x18\x01 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\fdelayedLimit\"\xe2\x01\n" +
+	"\x11AlarmQueueMessage\x12\x1a\n" +
+	"\bplatform\x18\x01 \x01(\tR\bplatform\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04info\x18\x03 \x01(\tR\x04info\x12\x19\n" +
+	"\btrace_id\x18\x04 \x01(\tR\atraceId\x12\x14\n" +
+	"\x05retry\x18\x05 \x01(\x05R\x05retry\x12\x1b\n" +
+	"\tmax_retry\x18\x06 \x01(\x05R\bmaxRetry\x129\n" +
+	"\n" +
+	"execute_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texecuteAt\"j\n" +
+	"\x11FusedAlarmMessage\x12 \n" +
+	"\vfingerprint\x18\x01 \x01(\tR\vfingerprint\x12\x12\n" +
+	"\x04info\x18\x02 \x01(\tR\x04info\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x03R\n" +
+	"ttlSeconds\"\xe8\x01\n" +
+	"\x1aGetAlarmQueueStatsResponse\x12!\n" +
+	"\fqueue_length\x18\x01 \x01(\x03R\vqueueLength\x12!\n" +
+	"\fdelayed_size\x18\x02 \x01(\x03R\vdelayedSize\x12C\n" +
+	"\x10delayed_messages\x18\x03 \x03(\v2\x18.admin.AlarmQueueMessageR\x0fdelayedMessages\x12?\n" +
+	"\x0efused_messages\x18\x04 \x03(\v2\x18.admin.FusedAlarmMessageR\rfusedMessages\"\xfc\x03\n" +
+	"\fAlarmSilence\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rtitle_pattern\x18\x02 \x01(\tR\ftitlePattern\x12!\n" +
+	"\finfo_pattern\x18\x03 \x01(\tR\vinfoPattern\x127\n" +
+	"\x06labels\x18\x04 \x03(\v2\x1f.admin.AlarmSilence.LabelsEntryR\x06labels\x12\x18\n" +
+	"\acreator\x18\x05 \x01(\tR\acreator\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x127\n" +
+	"\tstarts_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
+	"\x06active\x18\n" +
+	" \x01(\bR\x06active\x12+\n" +
+	"\x11remaining_seconds\x18\v \x01(\x03R\x10remainingSeconds\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x88\x04\n" +
+	"\x19CreateAlarmSilenceRequest\x12-\n" +
+	"\rtitle_pattern\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\ftitlePattern\x12+\n" +
+	"\finfo_pattern\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\vinfoPattern\x12\x88\x01\n" +
+	"\x06labels\x18\x03 \x03(\v2,.admin.CreateAlarmSilenceRequest.LabelsEntryBB\xfaB?\x9a\x01<\":r8R\bplatformR\aserviceR\x03envR\x04hostR\toperationR\x04pathR\auser_idR\x06labels\x12$\n" +
+	"\acomment\x18\x04 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\bR\acomment\x127\n" +
+	"\tstarts_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x125\n" +
+	"\bduration\x18\a \x01(\v2\x19.google.protobuf.DurationR\bduration\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"C\n" +
+	"\x18ListAlarmSilencesRequest\x12'\n" +
+	"\x0finclude_expired\x18\x01 \x01(\bR\x0eincludeExpired\"L\n" +
+	"\x19ListAlarmSilencesResponse\x12/\n" +
+	"\bsilences\x18\x01 \x03(\v2\x13.admin.AlarmSilenceR\bsilences\"4\n" +
+	"\x19ExpireAlarmSilenceRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\xc4\x01\n" +
+	"\bAlarmAck\x12 \n" +
+	"\vfingerprint\x18\x01 \x01(\tR\vfingerprint\x12\x18\n" +
+	"\acreator\x18\x02 \x01(\tR\acreator\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x125\n" +
+	"\backed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aackedAt\x12+\n" +
+	"\x11remaining_seconds\x18\x05 \x01(\x03R\x10remainingSeconds\"a\n" +
+	"\x0fAckAlarmRequest\x12*\n" +
+	"\vfingerprint\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01 R\vfingerprint\x12\"\n" +
+	"\acomment\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\acomment\"<\n" +
+	"\x15ListAlarmAcksResponse\x12#\n" +
+	"\x04acks\x18\x01 \x03(\v2\x0f.admin.AlarmAckR\x04acks\"?\n" +
+	"\x11UnackAlarmRequest\x12*\n" +
+	"\vfingerprint\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01 R\vfingerprint2\xf6\x05\n" +
+	"\x05Alarm\x12u\n" +
+	"\x12GetAlarmQueueStats\x12 .admin.GetAlarmQueueStatsRequest\x1a!.admin.GetAlarmQueueStatsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/admin/alarm/queue\x12m\n" +
+	"\x12CreateAlarmSilence\x12 .admin.CreateAlarmSilenceRequest\x1a\x13.admin.AlarmSilence\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/admin/alarm/silences\x12u\n" +
+	"\x11ListAlarmSilences\x12\x1f.admin.ListAlarmSilencesRequest\x1a .admin.ListAlarmSilencesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/admin/alarm/silences\x12r\n" +
+	"\x12ExpireAlarmSilence\x12 .admin.ExpireAlarmSilenceRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/admin/alarm/silences/{id}\x12Q\n" +
+	"\bAckAlarm\x12\x16.admin.AckAlarmRequest\x1a\x0f.admin.AlarmAck\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/admin/alarm/acks\x12`\n" +
+	"\rListAlarmAcks\x12\x16.google.protobuf.Empty\x1a\x1c.admin.ListAlarmAcksResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/admin/alarm/acks\x12g\n" +
+	"\n" +
+	"UnackAlarm\x12\x18.admin.UnackAlarmRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!*\x1f/admin/alarm/acks/{fingerprint}B5Z3github.com/carv-protocol/kratos-ddd/api/admin;adminb\x06proto3"
+
+var (
+	file_alarm_proto_rawDescOnce sync.Once
+	file_alarm_proto_rawDescData []byte
+)
+
+func file_alarm_proto_rawDescGZIP() []byte {
+	file_alarm_proto_rawDescOnce.Do(func() {
+		file_alarm_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_alarm_proto_rawDesc), len(file_alarm_proto_rawDesc)))
+	})
+	return file_alarm_proto_rawDescData
+}
+
+var file_alarm_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_alarm_proto_goTypes = []any{
+	(*GetAlarmQueueStatsRequest)(nil),  // 0: admin.GetAlarmQueueStatsRequest
+	(*AlarmQueueMessage)(nil),          // 1: admin.AlarmQueueMessage
+	(*FusedAlarmMessage)(nil),          // 2: admin.FusedAlarmMessage
+	(*GetAlarmQueueStatsResponse)(nil), // 3: admin.GetAlarmQueueStatsResponse
+	(*AlarmSilence)(nil),               // 4: admin.AlarmSilence
+	(*CreateAlarmSilenceRequest)(nil),  // 5: admin.CreateAlarmSilenceRequest
+	(*ListAlarmSilencesRequest)(nil),   // 6: admin.ListAlarmSilencesRequest
+	(*ListAlarmSilencesResponse)(nil),  // 7: admin.ListAlarmSilencesResponse
+	(*ExpireAlarmSilenceRequest)(nil),  // 8: admin.ExpireAlarmSilenceRequest
+	(*AlarmAck)(nil),                   // 9: admin.AlarmAck
+	(*AckAlarmRequest)(nil),            // 10: admin.AckAlarmRequest
+	(*ListAlarmAcksResponse)(nil),      // 11: admin.ListAlarmAcksResponse
+	(*UnackAlarmRequest)(nil),          // 12: admin.UnackAlarmRequest
+	nil,                                // 13: admin.AlarmSilence.LabelsEntry
+	nil,                                // 14: admin.CreateAlarmSilenceRequest.LabelsEntry
+	(*timestamppb.Timestamp)(nil),      // 15: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),        // 16: google.protobuf.Duration
+	(*emptypb.Empty)(nil),              // 17: google.protobuf.Empty
+}
+var file_alarm_proto_depIdxs = []int32{
+	15, // 0: admin.AlarmQueueMessage.execute_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: admin.GetAlarmQueueStatsResponse.delayed_messages:type_name -> admin.AlarmQueueMessage
+	2,  // 2: admin.GetAlarmQueueStatsResponse.fused_messages:type_name -> admin.FusedAlarmMessage
+	13, // 3: admin.AlarmSilence.labels:type_name -> admin.AlarmSilence.LabelsEntry
+	15, // 4: admin.AlarmSilence.starts_at:type_name -> google.protobuf.Timestamp
+	15, // 5: admin.AlarmSilence.ends_at:type_name -> google.protobuf.Timestamp
+	15, // 6: admin.AlarmSilence.created_at:type_name -> google.protobuf.Timestamp
+	14, // 7: admin.CreateAlarmSilenceRequest.labels:type_name -> admin.CreateAlarmSilenceRequest.LabelsEntry
+	15, // 8: admin.CreateAlarmSilenceRequest.starts_at:type_name -> google.protobuf.Timestamp
+	15, // 9: admin.CreateAlarmSilenceRequest.ends_at:type_name -> google.protobuf.Timestamp
+	16, // 10: admin.CreateAlarmSilenceRequest.duration:type_name -> google.protobuf.Duration
+	4,  // 11: admin.ListAlarmSilencesResponse.silences:type_name -> admin.AlarmSilence
+	15, // 12: admin.AlarmAck.acked_at:type_name -> google.protobuf.Timestamp
+	9,  // 13: admin.ListAlarmAcksResponse.acks:type_name -> admin.AlarmAck
+	0,  // 14: admin.Alarm.GetAlarmQueueStats:input_type -> admin.GetAlarmQueueStatsRequest
+	5,  // 15: admin.Alarm.CreateAlarmSilence:input_type -> admin.CreateAlarmSilenceRequest
+	6,  // 16: admin.Alarm.ListAlarmSilences:input_type -> admin.ListAlarmSilencesRequest
+	8,  // 17: admin.Alarm.ExpireAlarmSilence:input_type -> admin.ExpireAlarmSilenceRequest
+	10, // 18: admin.Alarm.AckAlarm:input_type -> admin.AckAlarmRequest
+	17, // 19: admin.Alarm.ListAlarmAcks:input_type -> google.protobuf.Empty
+	12, // 20: admin.Alarm.UnackAlarm:input_type -> admin.UnackAlarmRequest
+	3,  // 21: admin.Alarm.GetAlarmQueueStats:output_type -> admin.GetAlarmQueueStatsResponse
+	4,  // 22: admin.Alarm.CreateAlarmSilence:output_type -> admin.AlarmSilence
+	7,  // 23: admin.Alarm.ListAlarmSilences:output_type -> admin.ListAlarmSilencesResponse
+	17, // 24: admin.Alarm.ExpireAlarmSilence:output_type -> google.protobuf.Empty
+	9,  // 25: admin.Alarm.AckAlarm:output_type -> admin.AlarmAck
+	11, // 26: admin.Alarm.ListAlarmAcks:output_type -> admin.ListAlarmAcksResponse
+	17, // 27: admin.Alarm.UnackAlarm:output_type -> google.protobuf.Empty
+	21, // [21:28] is the sub-list for method output_type
+	14, // [14:21] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_alarm_proto_init() }
+func file_alarm_proto_init() {
+	if File_alarm_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_alarm_proto_rawDesc), len(file_alarm_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_alarm_proto_goTypes,
+		DependencyIndexes: file_alarm_proto_depIdxs,
+		MessageInfos:      file_alarm_proto_msgTypes,
+	}.Build()
+	File_alarm_proto = out.File
+	file_alarm_proto_goTypes = nil
+	file_alarm_proto_depIdxs = nil
+}
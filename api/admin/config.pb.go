@@ -0,0 +1,133 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: config.proto
+
+package admin
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EffectiveConfigReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// conf.Bootstrap，字段名与config.yaml一致，敏感字段为******
+	Config        *structpb.Struct `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EffectiveConfigReply) Reset() {
+	*x = EffectiveConfigReply{}
+	mi := &file_config_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EffectiveConfigReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectiveConfigReply) ProtoMessage() {}
+
+func (x *EffectiveConfigReply) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectiveConfigReply.ProtoReflect.Descriptor instead.
+func (*EffectiveConfigReply) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EffectiveConfigReply) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+var File_config_proto protoreflect.FileDescriptor
+
+const file_config_proto_rawDesc = "" +
+	"\n" +
+	"\fconfig.proto\x12\x05admin\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"G\n" +
+	"\x14EffectiveConfigReply\x12/\n" +
+	"\x06config\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x06config2j\n" +
+	"\x06Config\x12`\n" +
+	"\x12GetEffectiveConfig\x12\x16.google.protobuf.Empty\x1a\x1b.admin.EffectiveConfigReply\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/admin/configB5Z3github.com/carv-protocol/kratos-ddd/api/admin;adminb\x06proto3"
+
+var (
+	file_config_proto_rawDescOnce sync.Once
+	file_config_proto_rawDescData []byte
+)
+
+func file_config_proto_rawDescGZIP() []byte {
+	file_config_proto_rawDescOnce.Do(func() {
+		file_config_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_config_proto_rawDesc), len(file_config_proto_rawDesc)))
+	})
+	return file_config_proto_rawDescData
+}
+
+var file_config_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_config_proto_goTypes = []any{
+	(*EffectiveConfigReply)(nil), // 0: admin.EffectiveConfigReply
+	(*structpb.Struct)(nil),      // 1: google.protobuf.Struct
+	(*emptypb.Empty)(nil),        // 2: google.protobuf.Empty
+}
+var file_config_proto_depIdxs = []int32{
+	1, // 0: admin.EffectiveConfigReply.config:type_name -> google.protobuf.Struct
+	2, // 1: admin.Config.GetEffectiveConfig:input_type -> google.protobuf.Empty
+	0, // 2: admin.Config.GetEffectiveConfig:output_type -> admin.EffectiveConfigReply
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_config_proto_init() }
+func file_config_proto_init() {
+	if File_config_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_proto_rawDesc), len(file_config_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_config_proto_goTypes,
+		DependencyIndexes: file_config_proto_depIdxs,
+		MessageInfos:      file_config_proto_msgTypes,
+	}.Build()
+	File_config_proto = out.File
+	file_config_proto_goTypes = nil
+	file_config_proto_depIdxs = nil
+}
@@ -0,0 +1,659 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: crontab.proto
+
+package admin
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Job struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Spec  string                 `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// per_instance/singleton/sharded
+	Mode      string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Shards    int32                  `protobuf:"varint,4,opt,name=shards,proto3" json:"shards,omitempty"`
+	NextRunAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	Paused    bool                   `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty"`
+	PausedBy  string                 `protobuf:"bytes,7,opt,name=paused_by,json=pausedBy,proto3" json:"paused_by,omitempty"`
+	PausedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"`
+	// spec所在时区，为空时为服务的本地时区
+	Timezone string `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// 已在配置中停止调度，仍可手动触发
+	Disabled      bool `protobuf:"varint,10,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_crontab_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_crontab_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_crontab_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Job) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Job) GetSpec() string {
+	if x != nil {
+		return x.Spec
+	}
+	return ""
+}
+
+func (x *Job) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Job) GetShards() int32 {
+	if x != nil {
+		return x.Shards
+	}
+	return 0
+}
+
+func (x *Job) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *Job) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *Job) GetPausedBy() string {
+	if x != nil {
+		return x.PausedBy
+	}
+	return ""
+}
+
+func (x *Job) GetPausedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedAt
+	}
+	return nil
+}
+
+func (x *Job) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Job) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type ListJobsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsReply) Reset() {
+	*x = ListJobsReply{}
+	mi := &file_crontab_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsReply) ProtoMessage() {}
+
+func (x *ListJobsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_crontab_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsReply.ProtoReflect.Descriptor instead.
+func (*ListJobsReply) Descriptor() ([]byte, []int) {
+	return file_crontab_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListJobsReply) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type TriggerJobRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	JobName string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	// 任务参数，如回填的时间范围
+	Params        map[string]string `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerJobRequest) Reset() {
+	*x = TriggerJobRequest{}
+	mi := &file_crontab_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerJobRequest) ProtoMessage() {}
+
+func (x *TriggerJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crontab_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerJobRequest.ProtoReflect.Descriptor instead.
+func (*TriggerJobRequest) Descriptor() ([]byte, []int) {
+	return file_crontab_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TriggerJobRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *TriggerJobRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type JobNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobNameRequest) Reset() {
+	*x = JobNameRequest{}
+	mi := &file_crontab_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobNameRequest) ProtoMessage() {}
+
+func (x *JobNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crontab_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobNameRequest.ProtoReflect.Descriptor instead.
+func (*JobNameRequest) Descriptor() ([]byte, []int) {
+	return file_crontab_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JobNameRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+type ListJobRunsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	JobName string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	// 从1开始
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// 默认20，最大100
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobRunsRequest) Reset() {
+	*x = ListJobRunsRequest{}
+	mi := &file_crontab_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobRunsRequest) ProtoMessage() {}
+
+func (x *ListJobRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crontab_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobRunsRequest) Descriptor() ([]byte, []int) {
+	return file_crontab_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListJobRunsRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *ListJobRunsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListJobRunsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type JobRun struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobName      string                 `protobuf:"bytes,2,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Shard        int32                  `protobuf:"varint,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	Shards       int32                  `protobuf:"varint,4,opt,name=shards,proto3" json:"shards,omitempty"`
+	InstanceId   string                 `protobuf:"bytes,5,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	FencingToken int64                  `protobuf:"varint,6,opt,name=fencing_token,json=fencingToken,proto3" json:"fencing_token,omitempty"`
+	// running/succeeded/failed/cancelled
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,9,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobRun) Reset() {
+	*x = JobRun{}
+	mi := &file_crontab_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRun) ProtoMessage() {}
+
+func (x *JobRun) ProtoReflect() protoreflect.Message {
+	mi := &file_crontab_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRun.ProtoReflect.Descriptor instead.
+func (*JobRun) Descriptor() ([]byte, []int) {
+	return file_crontab_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *JobRun) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *JobRun) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *JobRun) GetShard() int32 {
+	if x != nil {
+		return x.Shard
+	}
+	return 0
+}
+
+func (x *JobRun) GetShards() int32 {
+	if x != nil {
+		return x.Shards
+	}
+	return 0
+}
+
+func (x *JobRun) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *JobRun) GetFencingToken() int64 {
+	if x != nil {
+		return x.FencingToken
+	}
+	return 0
+}
+
+func (x *JobRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *JobRun) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *JobRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *JobRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type ListJobRunsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*JobRun              `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobRunsReply) Reset() {
+	*x = ListJobRunsReply{}
+	mi := &file_crontab_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobRunsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobRunsReply) ProtoMessage() {}
+
+func (x *ListJobRunsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_crontab_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobRunsReply.ProtoReflect.Descriptor instead.
+func (*ListJobRunsReply) Descriptor() ([]byte, []int) {
+	return file_crontab_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListJobRunsReply) GetRuns() []*JobRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *ListJobRunsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_crontab_proto protoreflect.FileDescriptor
+
+const file_crontab_proto_rawDesc = "" +
+	"\n" +
+	"\rcrontab.proto\x12\x05admin\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbb\x02\n" +
+	"\x03Job\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04spec\x18\x02 \x01(\tR\x04spec\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12\x16\n" +
+	"\x06shards\x18\x04 \x01(\x05R\x06shards\x12:\n" +
+	"\vnext_run_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\x12\x16\n" +
+	"\x06paused\x18\x06 \x01(\bR\x06paused\x12\x1b\n" +
+	"\tpaused_by\x18\a \x01(\tR\bpausedBy\x127\n" +
+	"\tpaused_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bpausedAt\x12\x1a\n" +
+	"\btimezone\x18\t \x01(\tR\btimezone\x12\x1a\n" +
+	"\bdisabled\x18\n" +
+	" \x01(\bR\bdisabled\"/\n" +
+	"\rListJobsReply\x12\x1e\n" +
+	"\x04jobs\x18\x01 \x03(\v2\n" +
+	".admin.JobR\x04jobs\"\xb2\x01\n" +
+	"\x11TriggerJobRequest\x12$\n" +
+	"\bjob_name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\ajobName\x12<\n" +
+	"\x06params\x18\x02 \x03(\v2$.admin.TriggerJobRequest.ParamsEntryR\x06params\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"6\n" +
+	"\x0eJobNameRequest\x12$\n" +
+	"\bjob_name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\ajobName\"}\n" +
+	"\x12ListJobRunsRequest\x12$\n" +
+	"\bjob_name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\ajobName\x12\x1b\n" +
+	"\x04page\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12$\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bpageSize\"\xee\x02\n" +
+	"\x06JobRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bjob_name\x18\x02 \x01(\tR\ajobName\x12\x14\n" +
+	"\x05shard\x18\x03 \x01(\x05R\x05shard\x12\x16\n" +
+	"\x06shards\x18\x04 \x01(\x05R\x06shards\x12\x1f\n" +
+	"\vinstance_id\x18\x05 \x01(\tR\n" +
+	"instanceId\x12#\n" +
+	"\rfencing_token\x18\x06 \x01(\x03R\ffencingToken\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x12\x1f\n" +
+	"\vduration_ms\x18\t \x01(\x03R\n" +
+	"durationMs\x129\n" +
+	"\n" +
+	"started_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"K\n" +
+	"\x10ListJobRunsReply\x12!\n" +
+	"\x04runs\x18\x01 \x03(\v2\r.admin.JobRunR\x04runs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total2\x9d\x04\n" +
+	"\aCrontab\x12U\n" +
+	"\bListJobs\x12\x16.google.protobuf.Empty\x1a\x14.admin.ListJobsReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/admin/crontab/jobs\x12q\n" +
+	"\n" +
+	"TriggerJob\x12\x18.admin.TriggerJobRequest\x1a\x16.google.protobuf.Empty\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/admin/crontab/jobs/{job_name}/trigger\x12j\n" +
+	"\bPauseJob\x12\x15.admin.JobNameRequest\x1a\x16.google.protobuf.Empty\"/\x82\xd3\xe4\x93\x02):\x01*\"$/admin/crontab/jobs/{job_name}/pause\x12l\n" +
+	"\tResumeJob\x12\x15.admin.JobNameRequest\x1a\x16.google.protobuf.Empty\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/admin/crontab/jobs/{job_name}/resume\x12n\n" +
+	"\vListJobRuns\x12\x19.admin.ListJobRunsRequest\x1a\x17.admin.ListJobRunsReply\"+\x82\xd3\xe4\x93\x02%\x12#/admin/crontab/jobs/{job_name}/runsB5Z3github.com/carv-protocol/kratos-ddd/api/admin;adminb\x06proto3"
+
+var (
+	file_crontab_proto_rawDescOnce sync.Once
+	file_crontab_proto_rawDescData []byte
+)
+
+func file_crontab_proto_rawDescGZIP() []byte {
+	file_crontab_proto_rawDescOnce.Do(func() {
+		file_crontab_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_crontab_proto_rawDesc), len(file_crontab_proto_rawDesc)))
+	})
+	return file_crontab_proto_rawDescData
+}
+
+var file_crontab_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_crontab_proto_goTypes = []any{
+	(*Job)(nil),                   // 0: admin.Job
+	(*ListJobsReply)(nil),         // 1: admin.ListJobsReply
+	(*TriggerJobRequest)(nil),     // 2: admin.TriggerJobRequest
+	(*JobNameRequest)(nil),        // 3: admin.JobNameRequest
+	(*ListJobRunsRequest)(nil),    // 4: admin.ListJobRunsRequest
+	(*JobRun)(nil),                // 5: admin.JobRun
+	(*ListJobRunsReply)(nil),      // 6: admin.ListJobRunsReply
+	nil,                           // 7: admin.TriggerJobRequest.ParamsEntry
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
+}
+var file_crontab_proto_depIdxs = []int32{
+	8,  // 0: admin.Job.next_run_at:type_name -> google.protobuf.Timestamp
+	8,  // 1: admin.Job.paused_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: admin.ListJobsReply.jobs:type_name -> admin.Job
+	7,  // 3: admin.TriggerJobRequest.params:type_name -> admin.TriggerJobRequest.ParamsEntry
+	8,  // 4: admin.JobRun.started_at:type_name -> google.protobuf.Timestamp
+	8,  // 5: admin.JobRun.finished_at:type_name -> google.protobuf.Timestamp
+	5,  // 6: admin.ListJobRunsReply.runs:type_name -> admin.JobRun
+	9,  // 7: admin.Crontab.ListJobs:input_type -> google.protobuf.Empty
+	2,  // 8: admin.Crontab.TriggerJob:input_type -> admin.TriggerJobRequest
+	3,  // 9: admin.Crontab.PauseJob:input_type -> admin.JobNameRequest
+	3,  // 10: admin.Crontab.ResumeJob:input_type -> admin.JobNameRequest
+	4,  // 11: admin.Crontab.ListJobRuns:input_type -> admin.ListJobRunsRequest
+	1,  // 12: admin.Crontab.ListJobs:output_type -> admin.ListJobsReply
+	9,  // 13: admin.Crontab.TriggerJob:output_type -> google.protobuf.Empty
+	9,  // 14: admin.Crontab.PauseJob:output_type -> google.protobuf.Empty
+	9,  // 15: admin.Crontab.ResumeJob:output_type -> google.protobuf.Empty
+	6,  // 16: admin.Crontab.ListJobRuns:output_type -> admin.ListJobRunsReply
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_crontab_proto_init() }
+func file_crontab_proto_init() {
+	if File_crontab_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_crontab_proto_rawDesc), len(file_crontab_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_crontab_proto_goTypes,
+		DependencyIndexes: file_crontab_proto_depIdxs,
+		MessageInfos:      file_crontab_proto_msgTypes,
+	}.Build()
+	File_crontab_proto = out.File
+	file_crontab_proto_goTypes = nil
+	file_crontab_proto_depIdxs = nil
+}
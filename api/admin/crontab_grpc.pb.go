@@ -0,0 +1,288 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0
+// source: crontab.proto
+
+package admin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Crontab_ListJobs_FullMethodName    = "/admin.Crontab/ListJobs"
+	Crontab_TriggerJob_FullMethodName  = "/admin.Crontab/TriggerJob"
+	Crontab_PauseJob_FullMethodName    = "/admin.Crontab/PauseJob"
+	Crontab_ResumeJob_FullMethodName   = "/admin.Crontab/ResumeJob"
+	Crontab_ListJobRuns_FullMethodName = "/admin.Crontab/ListJobRuns"
+)
+
+// CrontabClient is the client API for Crontab service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The crontab admin service definition.
+type CrontabClient interface {
+	// 已注册的任务，包含下次执行时间与暂停状态
+	ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListJobsReply, error)
+	// 在接收请求的实例上立即执行一次，忽略暂停状态；加锁规则与定时执行相同，结果见执行记录
+	TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 集群内暂停任务，定时执行时跳过
+	PauseJob(ctx context.Context, in *JobNameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 恢复任务
+	ResumeJob(ctx context.Context, in *JobNameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 任务执行记录，按开始时间倒序
+	ListJobRuns(ctx context.Context, in *ListJobRunsRequest, opts ...grpc.CallOption) (*ListJobRunsReply, error)
+}
+
+type crontabClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCrontabClient(cc grpc.ClientConnInterface) CrontabClient {
+	return &crontabClient{cc}
+}
+
+func (c *crontabClient) ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListJobsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsReply)
+	err := c.cc.Invoke(ctx, Crontab_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crontabClient) TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Crontab_TriggerJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crontabClient) PauseJob(ctx context.Context, in *JobNameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Crontab_PauseJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crontabClient) ResumeJob(ctx context.Context, in *JobNameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Crontab_ResumeJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crontabClient) ListJobRuns(ctx context.Context, in *ListJobRunsRequest, opts ...grpc.CallOption) (*ListJobRunsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobRunsReply)
+	err := c.cc.Invoke(ctx, Crontab_ListJobRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CrontabServer is the server API for Crontab service.
+// All implementations must embed UnimplementedCrontabServer
+// for forward compatibility.
+//
+// The crontab admin service definition.
+type CrontabServer interface {
+	// 已注册的任务，包含下次执行时间与暂停状态
+	ListJobs(context.Context, *emptypb.Empty) (*ListJobsReply, error)
+	// 在接收请求的实例上立即执行一次，忽略暂停状态；加锁规则与定时执行相同，结果见执行记录
+	TriggerJob(context.Context, *TriggerJobRequest) (*emptypb.Empty, error)
+	// 集群内暂停任务，定时执行时跳过
+	PauseJob(context.Context, *JobNameRequest) (*emptypb.Empty, error)
+	// 恢复任务
+	ResumeJob(context.Context, *JobNameRequest) (*emptypb.Empty, error)
+	// 任务执行记录，按开始时间倒序
+	ListJobRuns(context.Context, *ListJobRunsRequest) (*ListJobRunsReply, error)
+	mustEmbedUnimplementedCrontabServer()
+}
+
+// UnimplementedCrontabServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCrontabServer struct{}
+
+func (UnimplementedCrontabServer) ListJobs(context.Context, *emptypb.Empty) (*ListJobsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedCrontabServer) TriggerJob(context.Context, *TriggerJobRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerJob not implemented")
+}
+func (UnimplementedCrontabServer) PauseJob(context.Context, *JobNameRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseJob not implemented")
+}
+func (UnimplementedCrontabServer) ResumeJob(context.Context, *JobNameRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJob not implemented")
+}
+func (UnimplementedCrontabServer) ListJobRuns(context.Context, *ListJobRunsRequest) (*ListJobRunsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobRuns not implemented")
+}
+func (UnimplementedCrontabServer) mustEmbedUnimplementedCrontabServer() {}
+func (UnimplementedCrontabServer) testEmbeddedByValue()                 {}
+
+// UnsafeCrontabServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CrontabServer will
+// result in compilation errors.
+type UnsafeCrontabServer interface {
+	mustEmbedUnimplementedCrontabServer()
+}
+
+func RegisterCrontabServer(s grpc.ServiceRegistrar, srv CrontabServer) {
+	// If the following call pancis, it indicates UnimplementedCrontabServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Crontab_ServiceDesc, srv)
+}
+
+func _Crontab_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrontabServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Crontab_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrontabServer).ListJobs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crontab_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrontabServer).TriggerJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Crontab_TriggerJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrontabServer).TriggerJob(ctx, req.(*TriggerJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crontab_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrontabServer).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Crontab_PauseJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrontabServer).PauseJob(ctx, req.(*JobNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crontab_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrontabServer).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Crontab_ResumeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrontabServer).ResumeJob(ctx, req.(*JobNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crontab_ListJobRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrontabServer).ListJobRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Crontab_ListJobRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrontabServer).ListJobRuns(ctx, req.(*ListJobRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Crontab_ServiceDesc is the grpc.ServiceDesc for Crontab service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Crontab_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "admin.Crontab",
+	HandlerType: (*CrontabServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListJobs",
+			Handler:    _Crontab_ListJobs_Handler,
+		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _Crontab_TriggerJob_Handler,
+		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _Crontab_PauseJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _Crontab_ResumeJob_Handler,
+		},
+		{
+			MethodName: "ListJobRuns",
+			Handler:    _Crontab_ListJobRuns_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "crontab.proto",
+}
@@ -0,0 +1,256 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v6.32.0
+// source: crontab.proto
+
+package admin
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationCrontabListJobRuns = "/admin.Crontab/ListJobRuns"
+const OperationCrontabListJobs = "/admin.Crontab/ListJobs"
+const OperationCrontabPauseJob = "/admin.Crontab/PauseJob"
+const OperationCrontabResumeJob = "/admin.Crontab/ResumeJob"
+const OperationCrontabTriggerJob = "/admin.Crontab/TriggerJob"
+
+type CrontabHTTPServer interface {
+	// ListJobRuns 任务执行记录，按开始时间倒序
+	ListJobRuns(context.Context, *ListJobRunsRequest) (*ListJobRunsReply, error)
+	// ListJobs 已注册的任务，包含下次执行时间与暂停状态
+	ListJobs(context.Context, *emptypb.Empty) (*ListJobsReply, error)
+	// PauseJob 集群内暂停任务，定时执行时跳过
+	PauseJob(context.Context, *JobNameRequest) (*emptypb.Empty, error)
+	// ResumeJob 恢复任务
+	ResumeJob(context.Context, *JobNameRequest) (*emptypb.Empty, error)
+	// TriggerJob 在接收请求的实例上立即执行一次，忽略暂停状态；加锁规则与定时执行相同，结果见执行记录
+	TriggerJob(context.Context, *TriggerJobRequest) (*emptypb.Empty, error)
+}
+
+func RegisterCrontabHTTPServer(s *http.Server, srv CrontabHTTPServer) {
+	r := s.Route("/")
+	r.GET("/admin/crontab/jobs", _Crontab_ListJobs0_HTTP_Handler(srv))
+	r.POST("/admin/crontab/jobs/{job_name}/trigger", _Crontab_TriggerJob0_HTTP_Handler(srv))
+	r.POST("/admin/crontab/jobs/{job_name}/pause", _Crontab_PauseJob0_HTTP_Handler(srv))
+	r.POST("/admin/crontab/jobs/{job_name}/resume", _Crontab_ResumeJob0_HTTP_Handler(srv))
+	r.GET("/admin/crontab/jobs/{job_name}/runs", _Crontab_ListJobRuns0_HTTP_Handler(srv))
+}
+
+func _Crontab_ListJobs0_HTTP_Handler(srv CrontabHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCrontabListJobs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListJobs(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListJobsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Crontab_TriggerJob0_HTTP_Handler(srv CrontabHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TriggerJobRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCrontabTriggerJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TriggerJob(ctx, req.(*TriggerJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Crontab_PauseJob0_HTTP_Handler(srv CrontabHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in JobNameRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCrontabPauseJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PauseJob(ctx, req.(*JobNameRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Crontab_ResumeJob0_HTTP_Handler(srv CrontabHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in JobNameRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCrontabResumeJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResumeJob(ctx, req.(*JobNameRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Crontab_ListJobRuns0_HTTP_Handler(srv CrontabHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListJobRunsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCrontabListJobRuns)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListJobRuns(ctx, req.(*ListJobRunsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListJobRunsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type CrontabHTTPClient interface {
+	// ListJobRuns 任务执行记录，按开始时间倒序
+	ListJobRuns(ctx context.Context, req *ListJobRunsRequest, opts ...http.CallOption) (rsp *ListJobRunsReply, err error)
+	// ListJobs 已注册的任务，包含下次执行时间与暂停状态
+	ListJobs(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *ListJobsReply, err error)
+	// PauseJob 集群内暂停任务，定时执行时跳过
+	PauseJob(ctx context.Context, req *JobNameRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ResumeJob 恢复任务
+	ResumeJob(ctx context.Context, req *JobNameRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// TriggerJob 在接收请求的实例上立即执行一次，忽略暂停状态；加锁规则与定时执行相同，结果见执行记录
+	TriggerJob(ctx context.Context, req *TriggerJobRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+}
+
+type CrontabHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewCrontabHTTPClient(client *http.Client) CrontabHTTPClient {
+	return &CrontabHTTPClientImpl{client}
+}
+
+// ListJobRuns 任务执行记录，按开始时间倒序
+func (c *CrontabHTTPClientImpl) ListJobRuns(ctx context.Context, in *ListJobRunsRequest, opts ...http.CallOption) (*ListJobRunsReply, error) {
+	var out ListJobRunsReply
+	pattern := "/admin/crontab/jobs/{job_name}/runs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCrontabListJobRuns))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListJobs 已注册的任务，包含下次执行时间与暂停状态
+func (c *CrontabHTTPClientImpl) ListJobs(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*ListJobsReply, error) {
+	var out ListJobsReply
+	pattern := "/admin/crontab/jobs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCrontabListJobs))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PauseJob 集群内暂停任务，定时执行时跳过
+func (c *CrontabHTTPClientImpl) PauseJob(ctx context.Context, in *JobNameRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/crontab/jobs/{job_name}/pause"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCrontabPauseJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResumeJob 恢复任务
+func (c *CrontabHTTPClientImpl) ResumeJob(ctx context.Context, in *JobNameRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/crontab/jobs/{job_name}/resume"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCrontabResumeJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// TriggerJob 在接收请求的实例上立即执行一次，忽略暂停状态；加锁规则与定时执行相同，结果见执行记录
+func (c *CrontabHTTPClientImpl) TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/crontab/jobs/{job_name}/trigger"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCrontabTriggerJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
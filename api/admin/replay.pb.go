@@ -0,0 +1,492 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: replay.proto
+
+package admin
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StartEventReplayRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// outbox/login_log
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// 事件名，source为outbox时必填；event.UserLogin只能从login_log重放
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// bus: 重新发布到事件总线；local: 交给本服务的处理器
+	Target string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	From   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// 为空时到当前时间
+	To *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// 只统计和列出样例，不重放
+	DryRun bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// 每秒最多重放的事件数，0不限
+	Rate float64 `protobuf:"fixed64,7,opt,name=rate,proto3" json:"rate,omitempty"`
+	// 每批读取的事件数，默认100
+	BatchSize int32 `protobuf:"varint,8,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// 最多重放的事件数，0不限
+	Limit int32 `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	// target为local时是否通知webhook订阅方，默认只执行本服务的处理器
+	NotifyWebhooks bool `protobuf:"varint,10,opt,name=notify_webhooks,json=notifyWebhooks,proto3" json:"notify_webhooks,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartEventReplayRequest) Reset() {
+	*x = StartEventReplayRequest{}
+	mi := &file_replay_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartEventReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEventReplayRequest) ProtoMessage() {}
+
+func (x *StartEventReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_replay_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEventReplayRequest.ProtoReflect.Descriptor instead.
+func (*StartEventReplayRequest) Descriptor() ([]byte, []int) {
+	return file_replay_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StartEventReplayRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *StartEventReplayRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartEventReplayRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *StartEventReplayRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *StartEventReplayRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *StartEventReplayRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *StartEventReplayRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *StartEventReplayRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *StartEventReplayRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *StartEventReplayRequest) GetNotifyWebhooks() bool {
+	if x != nil {
+		return x.NotifyWebhooks
+	}
+	return false
+}
+
+type EventReplayIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventReplayIdRequest) Reset() {
+	*x = EventReplayIdRequest{}
+	mi := &file_replay_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventReplayIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventReplayIdRequest) ProtoMessage() {}
+
+func (x *EventReplayIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_replay_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventReplayIdRequest.ProtoReflect.Descriptor instead.
+func (*EventReplayIdRequest) Descriptor() ([]byte, []int) {
+	return file_replay_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventReplayIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EventReplaySample struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventReplaySample) Reset() {
+	*x = EventReplaySample{}
+	mi := &file_replay_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventReplaySample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventReplaySample) ProtoMessage() {}
+
+func (x *EventReplaySample) ProtoReflect() protoreflect.Message {
+	mi := &file_replay_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventReplaySample.ProtoReflect.Descriptor instead.
+func (*EventReplaySample) Descriptor() ([]byte, []int) {
+	return file_replay_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventReplaySample) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventReplaySample) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventReplaySample) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EventReplaySample) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type EventReplayProgress struct {
+	state     protoimpl.MessageState   `protogen:"open.v1"`
+	Id        string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Request   *StartEventReplayRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Scanned   int64                    `protobuf:"varint,3,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	Replayed  int64                    `protobuf:"varint,4,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	Failed    int64                    `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	LastError string                   `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// dry-run时的样例事件
+	Samples       []*EventReplaySample   `protobuf:"bytes,7,rep,name=samples,proto3" json:"samples,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Done          bool                   `protobuf:"varint,10,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventReplayProgress) Reset() {
+	*x = EventReplayProgress{}
+	mi := &file_replay_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventReplayProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventReplayProgress) ProtoMessage() {}
+
+func (x *EventReplayProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_replay_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventReplayProgress.ProtoReflect.Descriptor instead.
+func (*EventReplayProgress) Descriptor() ([]byte, []int) {
+	return file_replay_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventReplayProgress) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventReplayProgress) GetRequest() *StartEventReplayRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *EventReplayProgress) GetScanned() int64 {
+	if x != nil {
+		return x.Scanned
+	}
+	return 0
+}
+
+func (x *EventReplayProgress) GetReplayed() int64 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+func (x *EventReplayProgress) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *EventReplayProgress) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *EventReplayProgress) GetSamples() []*EventReplaySample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *EventReplayProgress) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *EventReplayProgress) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *EventReplayProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+var File_replay_proto protoreflect.FileDescriptor
+
+const file_replay_proto_rawDesc = "" +
+	"\n" +
+	"\freplay.proto\x12\x05admin\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaa\x03\n" +
+	"\x17StartEventReplayRequest\x120\n" +
+	"\x06source\x18\x01 \x01(\tB\x18\xfaB\x15r\x13R\x06outboxR\tlogin_logR\x06source\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x04name\x12)\n" +
+	"\x06target\x18\x03 \x01(\tB\x11\xfaB\x0er\fR\x03busR\x05localR\x06target\x128\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12\"\n" +
+	"\x04rate\x18\a \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x04rate\x12)\n" +
+	"\n" +
+	"batch_size\x18\b \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\tbatchSize\x12\x1d\n" +
+	"\x05limit\x18\t \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05limit\x12'\n" +
+	"\x0fnotify_webhooks\x18\n" +
+	" \x01(\bR\x0enotifyWebhooks\"/\n" +
+	"\x14EventReplayIdRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"\x83\x01\n" +
+	"\x11EventReplaySample\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x8c\x03\n" +
+	"\x13EventReplayProgress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
+	"\arequest\x18\x02 \x01(\v2\x1e.admin.StartEventReplayRequestR\arequest\x12\x18\n" +
+	"\ascanned\x18\x03 \x01(\x03R\ascanned\x12\x1a\n" +
+	"\breplayed\x18\x04 \x01(\x03R\breplayed\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x03R\x06failed\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x122\n" +
+	"\asamples\x18\a \x03(\v2\x18.admin.EventReplaySampleR\asamples\x129\n" +
+	"\n" +
+	"started_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12\x12\n" +
+	"\x04done\x18\n" +
+	" \x01(\bR\x04done2\xdc\x02\n" +
+	"\vEventReplay\x12p\n" +
+	"\x10StartEventReplay\x12\x1e.admin.StartEventReplayRequest\x1a\x1a.admin.EventReplayProgress\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/admin/events/replays\x12m\n" +
+	"\x0eGetEventReplay\x12\x1b.admin.EventReplayIdRequest\x1a\x1a.admin.EventReplayProgress\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/admin/events/replays/{id}\x12l\n" +
+	"\x11CancelEventReplay\x12\x1b.admin.EventReplayIdRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/admin/events/replays/{id}B5Z3github.com/carv-protocol/kratos-ddd/api/admin;adminb\x06proto3"
+
+var (
+	file_replay_proto_rawDescOnce sync.Once
+	file_replay_proto_rawDescData []byte
+)
+
+func file_replay_proto_rawDescGZIP() []byte {
+	file_replay_proto_rawDescOnce.Do(func() {
+		file_replay_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_replay_proto_rawDesc), len(file_replay_proto_rawDesc)))
+	})
+	return file_replay_proto_rawDescData
+}
+
+var file_replay_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_replay_proto_goTypes = []any{
+	(*StartEventReplayRequest)(nil), // 0: admin.StartEventReplayRequest
+	(*EventReplayIdRequest)(nil),    // 1: admin.EventReplayIdRequest
+	(*EventReplaySample)(nil),       // 2: admin.EventReplaySample
+	(*EventReplayProgress)(nil),     // 3: admin.EventReplayProgress
+	(*timestamppb.Timestamp)(nil),   // 4: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),           // 5: google.protobuf.Empty
+}
+var file_replay_proto_depIdxs = []int32{
+	4,  // 0: admin.StartEventReplayRequest.from:type_name -> google.protobuf.Timestamp
+	4,  // 1: admin.StartEventReplayRequest.to:type_name -> google.protobuf.Timestamp
+	4,  // 2: admin.EventReplaySample.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 3: admin.EventReplayProgress.request:type_name -> admin.StartEventReplayRequest
+	2,  // 4: admin.EventReplayProgress.samples:type_name -> admin.EventReplaySample
+	4,  // 5: admin.EventReplayProgress.started_at:type_name -> google.protobuf.Timestamp
+	4,  // 6: admin.EventReplayProgress.finished_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: admin.EventReplay.StartEventReplay:input_type -> admin.StartEventReplayRequest
+	1,  // 8: admin.EventReplay.GetEventReplay:input_type -> admin.EventReplayIdRequest
+	1,  // 9: admin.EventReplay.CancelEventReplay:input_type -> admin.EventReplayIdRequest
+	3,  // 10: admin.EventReplay.StartEventReplay:output_type -> admin.EventReplayProgress
+	3,  // 11: admin.EventReplay.GetEventReplay:output_type -> admin.EventReplayProgress
+	5,  // 12: admin.EventReplay.CancelEventReplay:output_type -> google.protobuf.Empty
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_replay_proto_init() }
+func file_replay_proto_init() {
+	if File_replay_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_replay_proto_rawDesc), len(file_replay_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_replay_proto_goTypes,
+		DependencyIndexes: file_replay_proto_depIdxs,
+		MessageInfos:      file_replay_proto_msgTypes,
+	}.Build()
+	File_replay_proto = out.File
+	file_replay_proto_goTypes = nil
+	file_replay_proto_depIdxs = nil
+}
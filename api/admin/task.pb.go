@@ -0,0 +1,839 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: task.proto
+
+package admin
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TaskQueue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 各状态任务总数
+	Size      int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Pending   int64 `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	Active    int64 `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Scheduled int64 `protobuf:"varint,5,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	Retry     int64 `protobuf:"varint,6,opt,name=retry,proto3" json:"retry,omitempty"`
+	Archived  int64 `protobuf:"varint,7,opt,name=archived,proto3" json:"archived,omitempty"`
+	Completed int64 `protobuf:"varint,8,opt,name=completed,proto3" json:"completed,omitempty"`
+	// 当天处理及失败的任务数
+	Processed int64 `protobuf:"varint,9,opt,name=processed,proto3" json:"processed,omitempty"`
+	Failed    int64 `protobuf:"varint,10,opt,name=failed,proto3" json:"failed,omitempty"`
+	// 最早的待处理任务已等待的时长（毫秒）
+	LatencyMs     int64 `protobuf:"varint,11,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Paused        bool  `protobuf:"varint,12,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskQueue) Reset() {
+	*x = TaskQueue{}
+	mi := &file_task_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueue) ProtoMessage() {}
+
+func (x *TaskQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueue.ProtoReflect.Descriptor instead.
+func (*TaskQueue) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TaskQueue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskQueue) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TaskQueue) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *TaskQueue) GetActive() int64 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *TaskQueue) GetScheduled() int64 {
+	if x != nil {
+		return x.Scheduled
+	}
+	return 0
+}
+
+func (x *TaskQueue) GetRetry() int64 {
+	if x != nil {
+		return x.Retry
+	}
+	return 0
+}
+
+func (x *TaskQueue) GetArchived() int64 {
+	if x != nil {
+		return x.Archived
+	}
+	return 0
+}
+
+func (x *TaskQueue) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *TaskQueue) GetProcessed() int64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *TaskQueue) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *TaskQueue) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *TaskQueue) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type ListTaskQueuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queues        []*TaskQueue           `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskQueuesResponse) Reset() {
+	*x = ListTaskQueuesResponse{}
+	mi := &file_task_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskQueuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskQueuesResponse) ProtoMessage() {}
+
+func (x *ListTaskQueuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskQueuesResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskQueuesResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTaskQueuesResponse) GetQueues() []*TaskQueue {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+type ListTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Queue string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	State string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// 从1开始
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// 默认20
+	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_task_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTasksRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type TaskInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Queue string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	// 任务类型，即事件名
+	Type  string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	State string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// 事件ID
+	EventId string `protobuf:"bytes,5,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// 解码后的payload（JSON）
+	Payload string `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	// payload无法解码的原因，此时payload为原始数据的base64
+	DecodeError   string                 `protobuf:"bytes,7,opt,name=decode_error,json=decodeError,proto3" json:"decode_error,omitempty"`
+	MaxRetry      int32                  `protobuf:"varint,8,opt,name=max_retry,json=maxRetry,proto3" json:"max_retry,omitempty"`
+	Retried       int32                  `protobuf:"varint,9,opt,name=retried,proto3" json:"retried,omitempty"`
+	LastError     string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastFailedAt  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_failed_at,json=lastFailedAt,proto3" json:"last_failed_at,omitempty"`
+	NextProcessAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=next_process_at,json=nextProcessAt,proto3" json:"next_process_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskInfo) Reset() {
+	*x = TaskInfo{}
+	mi := &file_task_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInfo) ProtoMessage() {}
+
+func (x *TaskInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInfo.ProtoReflect.Descriptor instead.
+func (*TaskInfo) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TaskInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetDecodeError() string {
+	if x != nil {
+		return x.DecodeError
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetMaxRetry() int32 {
+	if x != nil {
+		return x.MaxRetry
+	}
+	return 0
+}
+
+func (x *TaskInfo) GetRetried() int32 {
+	if x != nil {
+		return x.Retried
+	}
+	return 0
+}
+
+func (x *TaskInfo) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetLastFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFailedAt
+	}
+	return nil
+}
+
+func (x *TaskInfo) GetNextProcessAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextProcessAt
+	}
+	return nil
+}
+
+type ListTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// state下的任务总数
+	Total         int64       `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Tasks         []*TaskInfo `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_task_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTasksResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTasksResponse) GetTasks() []*TaskInfo {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type TaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskRequest) Reset() {
+	*x = TaskRequest{}
+	mi := &file_task_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRequest) ProtoMessage() {}
+
+func (x *TaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRequest.ProtoReflect.Descriptor instead.
+func (*TaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TaskRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *TaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type BatchTasksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Queue  string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Action string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Ids    []string               `protobuf:"bytes,3,rep,name=ids,proto3" json:"ids,omitempty"`
+	// 未指定ids时必填：run支持scheduled/retry/archived，archive支持pending/scheduled/retry，delete支持除active外的状态
+	State         string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchTasksRequest) Reset() {
+	*x = BatchTasksRequest{}
+	mi := &file_task_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTasksRequest) ProtoMessage() {}
+
+func (x *BatchTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchTasksRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *BatchTasksRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *BatchTasksRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchTasksRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type BatchTaskFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchTaskFailure) Reset() {
+	*x = BatchTaskFailure{}
+	mi := &file_task_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTaskFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTaskFailure) ProtoMessage() {}
+
+func (x *BatchTaskFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTaskFailure.ProtoReflect.Descriptor instead.
+func (*BatchTaskFailure) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchTaskFailure) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchTaskFailure) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BatchTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 成功处理的任务数
+	Affected      int64               `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	Failures      []*BatchTaskFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchTasksResponse) Reset() {
+	*x = BatchTasksResponse{}
+	mi := &file_task_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTasksResponse) ProtoMessage() {}
+
+func (x *BatchTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchTasksResponse) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+func (x *BatchTasksResponse) GetFailures() []*BatchTaskFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+var File_task_proto protoreflect.FileDescriptor
+
+const file_task_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"task.proto\x12\x05admin\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc0\x02\n" +
+	"\tTaskQueue\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x18\n" +
+	"\apending\x18\x03 \x01(\x03R\apending\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\x03R\x06active\x12\x1c\n" +
+	"\tscheduled\x18\x05 \x01(\x03R\tscheduled\x12\x14\n" +
+	"\x05retry\x18\x06 \x01(\x03R\x05retry\x12\x1a\n" +
+	"\barchived\x18\a \x01(\x03R\barchived\x12\x1c\n" +
+	"\tcompleted\x18\b \x01(\x03R\tcompleted\x12\x1c\n" +
+	"\tprocessed\x18\t \x01(\x03R\tprocessed\x12\x16\n" +
+	"\x06failed\x18\n" +
+	" \x01(\x03R\x06failed\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\v \x01(\x03R\tlatencyMs\x12\x16\n" +
+	"\x06paused\x18\f \x01(\bR\x06paused\"B\n" +
+	"\x16ListTaskQueuesResponse\x12(\n" +
+	"\x06queues\x18\x01 \x03(\v2\x10.admin.TaskQueueR\x06queues\"\xce\x01\n" +
+	"\x10ListTasksRequest\x12 \n" +
+	"\x05queue\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x05queue\x12S\n" +
+	"\x05state\x18\x02 \x01(\tB=\xfaB:r8R\apendingR\x06activeR\tscheduledR\x05retryR\barchivedR\tcompletedR\x05state\x12\x1b\n" +
+	"\x04page\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12&\n" +
+	"\tpage_size\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\"\x8e\x03\n" +
+	"\bTaskInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x19\n" +
+	"\bevent_id\x18\x05 \x01(\tR\aeventId\x12\x18\n" +
+	"\apayload\x18\x06 \x01(\tR\apayload\x12!\n" +
+	"\fdecode_error\x18\a \x01(\tR\vdecodeError\x12\x1b\n" +
+	"\tmax_retry\x18\b \x01(\x05R\bmaxRetry\x12\x18\n" +
+	"\aretried\x18\t \x01(\x05R\aretried\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tR\tlastError\x12@\n" +
+	"\x0elast_failed_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\flastFailedAt\x12B\n" +
+	"\x0fnext_process_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rnextProcessAt\"P\n" +
+	"\x11ListTasksResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12%\n" +
+	"\x05tasks\x18\x02 \x03(\v2\x0f.admin.TaskInfoR\x05tasks\"K\n" +
+	"\vTaskRequest\x12 \n" +
+	"\x05queue\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x05queue\x12\x1a\n" +
+	"\x02id\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x02id\"\xdc\x01\n" +
+	"\x11BatchTasksRequest\x12 \n" +
+	"\x05queue\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x05queue\x123\n" +
+	"\x06action\x18\x02 \x01(\tB\x1b\xfaB\x18r\x16R\x03runR\aarchiveR\x06deleteR\x06action\x12!\n" +
+	"\x03ids\x18\x03 \x03(\tB\x0f\xfaB\f\x92\x01\t\x10\xe8\a\"\x04r\x02\x10\x01R\x03ids\x12M\n" +
+	"\x05state\x18\x04 \x01(\tB7\xfaB4r2R\x00R\apendingR\tscheduledR\x05retryR\barchivedR\tcompletedR\x05state\"<\n" +
+	"\x10BatchTaskFailure\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"e\n" +
+	"\x12BatchTasksResponse\x12\x1a\n" +
+	"\baffected\x18\x01 \x01(\x03R\baffected\x123\n" +
+	"\bfailures\x18\x02 \x03(\v2\x17.admin.BatchTaskFailureR\bfailures2\x9c\x05\n" +
+	"\x04Task\x12d\n" +
+	"\x0eListTaskQueues\x12\x16.google.protobuf.Empty\x1a\x1d.admin.ListTaskQueuesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/admin/tasks/queues\x12i\n" +
+	"\tListTasks\x12\x17.admin.ListTasksRequest\x1a\x18.admin.ListTasksResponse\")\x82\xd3\xe4\x93\x02#\x12!/admin/tasks/queues/{queue}/tasks\x12l\n" +
+	"\aRunTask\x12\x12.admin.TaskRequest\x1a\x16.google.protobuf.Empty\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/admin/tasks/queues/{queue}/tasks/{id}/run\x12t\n" +
+	"\vArchiveTask\x12\x12.admin.TaskRequest\x1a\x16.google.protobuf.Empty\"9\x82\xd3\xe4\x93\x023:\x01*\"./admin/tasks/queues/{queue}/tasks/{id}/archive\x12h\n" +
+	"\n" +
+	"DeleteTask\x12\x12.admin.TaskRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(*&/admin/tasks/queues/{queue}/tasks/{id}\x12u\n" +
+	"\n" +
+	"BatchTasks\x12\x18.admin.BatchTasksRequest\x1a\x19.admin.BatchTasksResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/admin/tasks/queues/{queue}/tasks/batchB5Z3github.com/carv-protocol/kratos-ddd/api/admin;adminb\x06proto3"
+
+var (
+	file_task_proto_rawDescOnce sync.Once
+	file_task_proto_rawDescData []byte
+)
+
+func file_task_proto_rawDescGZIP() []byte {
+	file_task_proto_rawDescOnce.Do(func() {
+		file_task_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_task_proto_rawDesc), len(file_task_proto_rawDesc)))
+	})
+	return file_task_proto_rawDescData
+}
+
+var file_task_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_task_proto_goTypes = []any{
+	(*TaskQueue)(nil),              // 0: admin.TaskQueue
+	(*ListTaskQueuesResponse)(nil), // 1: admin.ListTaskQueuesResponse
+	(*ListTasksRequest)(nil),       // 2: admin.ListTasksRequest
+	(*TaskInfo)(nil),               // 3: admin.TaskInfo
+	(*ListTasksResponse)(nil),      // 4: admin.ListTasksResponse
+	(*TaskRequest)(nil),            // 5: admin.TaskRequest
+	(*BatchTasksRequest)(nil),      // 6: admin.BatchTasksRequest
+	(*BatchTaskFailure)(nil),       // 7: admin.BatchTaskFailure
+	(*BatchTasksResponse)(nil),     // 8: admin.BatchTasksResponse
+	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),          // 10: google.protobuf.Empty
+}
+var file_task_proto_depIdxs = []int32{
+	0,  // 0: admin.ListTaskQueuesResponse.queues:type_name -> admin.TaskQueue
+	9,  // 1: admin.TaskInfo.last_failed_at:type_name -> google.protobuf.Timestamp
+	9,  // 2: admin.TaskInfo.next_process_at:type_name -> google.protobuf.Timestamp
+	3,  // 3: admin.ListTasksResponse.tasks:type_name -> admin.TaskInfo
+	7,  // 4: admin.BatchTasksResponse.failures:type_name -> admin.BatchTaskFailure
+	10, // 5: admin.Task.ListTaskQueues:input_type -> google.protobuf.Empty
+	2,  // 6: admin.Task.ListTasks:input_type -> admin.ListTasksRequest
+	5,  // 7: admin.Task.RunTask:input_type -> admin.TaskRequest
+	5,  // 8: admin.Task.ArchiveTask:input_type -> admin.TaskRequest
+	5,  // 9: admin.Task.DeleteTask:input_type -> admin.TaskRequest
+	6,  // 10: admin.Task.BatchTasks:input_type -> admin.BatchTasksRequest
+	1,  // 11: admin.Task.ListTaskQueues:output_type -> admin.ListTaskQueuesResponse
+	4,  // 12: admin.Task.ListTasks:output_type -> admin.ListTasksResponse
+	10, // 13: admin.Task.RunTask:output_type -> google.protobuf.Empty
+	10, // 14: admin.Task.ArchiveTask:output_type -> google.protobuf.Empty
+	10, // 15: admin.Task.DeleteTask:output_type -> google.protobuf.Empty
+	8,  // 16: admin.Task.BatchTasks:output_type -> admin.BatchTasksResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_task_proto_init() }
+func file_task_proto_init() {
+	if File_task_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_task_proto_rawDesc), len(file_task_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_task_proto_goTypes,
+		DependencyIndexes: file_task_proto_depIdxs,
+		MessageInfos:      file_task_proto_msgTypes,
+	}.Build()
+	File_task_proto = out.File
+	file_task_proto_goTypes = nil
+	file_task_proto_depIdxs = nil
+}
@@ -26,12 +26,14 @@ const (
 
 // The event message for user login
 type UserLogin struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuthType      string                 `protobuf:"bytes,1,opt,name=auth_type,json=authType,proto3" json:"auth_type,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
-	IssueToken    string                 `protobuf:"bytes,4,opt,name=issue_token,json=issueToken,proto3" json:"issue_token,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	AuthType   string                 `protobuf:"bytes,1,opt,name=auth_type,json=authType,proto3" json:"auth_type,omitempty"`
+	UserId     string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ip         string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	IssueToken string                 `protobuf:"bytes,4,opt,name=issue_token,json=issueToken,proto3" json:"issue_token,omitempty"`
+	Timestamp  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// 从user_login_log重放时为原记录ID，处理时更新该记录而不是新增
+	LogId         int64 `protobuf:"varint,6,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -101,18 +103,26 @@ func (x *UserLogin) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UserLogin) GetLogId() int64 {
+	if x != nil {
+		return x.LogId
+	}
+	return 0
+}
+
 var File_event_auth_proto protoreflect.FileDescriptor
 
 const file_event_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x10event.auth.proto\x12\x05event\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xac\x01\n" +
+	"\x10event.auth.proto\x12\x05event\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x01\n" +
 	"\tUserLogin\x12\x1b\n" +
 	"\tauth_type\x18\x01 \x01(\tR\bauthType\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x0e\n" +
 	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x1f\n" +
 	"\vissue_token\x18\x04 \x01(\tR\n" +
 	"issueToken\x128\n" +
-	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestampB5Z3github.com/carv-protocol/kratos-ddd/api/event;eventb\x06proto3"
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x15\n" +
+	"\x06log_id\x18\x06 \x01(\x03R\x05logIdB5Z3github.com/carv-protocol/kratos-ddd/api/event;eventb\x06proto3"
 
 var (
 	file_event_auth_proto_rawDescOnce sync.Once
@@ -94,6 +94,8 @@ func (m *UserLogin) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for LogId
+
 	if len(errors) > 0 {
 		return UserLoginMultiError(errors)
 	}
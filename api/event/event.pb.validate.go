@@ -56,11 +56,38 @@ func (m *Event) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Id
+	if l := utf8.RuneCountInString(m.GetId()); l < 1 || l > 64 {
+		err := EventValidationError{
+			field:  "Id",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Name
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := EventValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Payload
+	if len(m.GetPayload()) > 1048576 {
+		err := EventValidationError{
+			field:  "Payload",
+			reason: "value length must be at most 1048576 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if all {
 		switch v := interface{}(m.GetTimestamp()).(type) {
@@ -91,6 +118,35 @@ func (m *Event) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetHeaders()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Headers",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Headers",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHeaders()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "Headers",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return EventMultiError(errors)
 	}
@@ -167,3 +223,503 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = EventValidationError{}
+
+// Validate checks the field values on Headers with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Headers) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Headers with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in HeadersMultiError, or nil if none found.
+func (m *Headers) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Headers) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Traceparent
+
+	// no validation rules for Tracestate
+
+	// no validation rules for UserId
+
+	// no validation rules for Source
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for CorrelationId
+
+	if len(errors) > 0 {
+		return HeadersMultiError(errors)
+	}
+
+	return nil
+}
+
+// HeadersMultiError is an error wrapping multiple validation errors returned
+// by Headers.ValidateAll() if the designated constraints aren't met.
+type HeadersMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HeadersMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HeadersMultiError) AllErrors() []error { return m }
+
+// HeadersValidationError is the validation error returned by Headers.Validate
+// if the designated constraints aren't met.
+type HeadersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HeadersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HeadersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HeadersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HeadersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HeadersValidationError) ErrorName() string { return "HeadersValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HeadersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHeaders.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HeadersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HeadersValidationError{}
+
+// Validate checks the field values on HandleEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HandleEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HandleEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HandleEventsRequestMultiError, or nil if none found.
+func (m *HandleEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HandleEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetEvents()); l < 1 || l > 100 {
+		err := HandleEventsRequestValidationError{
+			field:  "Events",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HandleEventsRequestValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HandleEventsRequestValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HandleEventsRequestValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return HandleEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// HandleEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by HandleEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type HandleEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HandleEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HandleEventsRequestMultiError) AllErrors() []error { return m }
+
+// HandleEventsRequestValidationError is the validation error returned by
+// HandleEventsRequest.Validate if the designated constraints aren't met.
+type HandleEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HandleEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HandleEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HandleEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HandleEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HandleEventsRequestValidationError) ErrorName() string {
+	return "HandleEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HandleEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHandleEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HandleEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HandleEventsRequestValidationError{}
+
+// Validate checks the field values on EventResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventResultMultiError, or
+// nil if none found.
+func (m *EventResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Success
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return EventResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventResultMultiError is an error wrapping multiple validation errors
+// returned by EventResult.ValidateAll() if the designated constraints aren't met.
+type EventResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventResultMultiError) AllErrors() []error { return m }
+
+// EventResultValidationError is the validation error returned by
+// EventResult.Validate if the designated constraints aren't met.
+type EventResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventResultValidationError) ErrorName() string { return "EventResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventResultValidationError{}
+
+// Validate checks the field values on HandleEventsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HandleEventsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HandleEventsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HandleEventsReplyMultiError, or nil if none found.
+func (m *HandleEventsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HandleEventsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HandleEventsReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HandleEventsReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HandleEventsReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return HandleEventsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// HandleEventsReplyMultiError is an error wrapping multiple validation errors
+// returned by HandleEventsReply.ValidateAll() if the designated constraints
+// aren't met.
+type HandleEventsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HandleEventsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HandleEventsReplyMultiError) AllErrors() []error { return m }
+
+// HandleEventsReplyValidationError is the validation error returned by
+// HandleEventsReply.Validate if the designated constraints aren't met.
+type HandleEventsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HandleEventsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HandleEventsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HandleEventsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HandleEventsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HandleEventsReplyValidationError) ErrorName() string {
+	return "HandleEventsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HandleEventsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHandleEventsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HandleEventsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HandleEventsReplyValidationError{}
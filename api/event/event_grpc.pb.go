@@ -20,17 +20,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EventHandler_HandleEvent_FullMethodName = "/event.EventHandler/HandleEvent"
+	EventHandler_HandleEvent_FullMethodName  = "/event.EventHandler/HandleEvent"
+	EventHandler_HandleEvents_FullMethodName = "/event.EventHandler/HandleEvents"
 )
 
 // EventHandlerClient is the client API for EventHandler service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// The auth service definition.
+// 事件处理服务，供其他服务通过gRPC直接推送事件（需服务间认证）
 type EventHandlerClient interface {
-	// Login by web3 wallet
+	// 处理单个事件，按配置同步处理或投递到事件总线
 	HandleEvent(ctx context.Context, in *Event, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 批量处理事件，单个事件失败不影响其他事件
+	HandleEvents(ctx context.Context, in *HandleEventsRequest, opts ...grpc.CallOption) (*HandleEventsReply, error)
 }
 
 type eventHandlerClient struct {
@@ -51,14 +54,26 @@ func (c *eventHandlerClient) HandleEvent(ctx context.Context, in *Event, opts ..
 	return out, nil
 }
 
+func (c *eventHandlerClient) HandleEvents(ctx context.Context, in *HandleEventsRequest, opts ...grpc.CallOption) (*HandleEventsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandleEventsReply)
+	err := c.cc.Invoke(ctx, EventHandler_HandleEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventHandlerServer is the server API for EventHandler service.
 // All implementations must embed UnimplementedEventHandlerServer
 // for forward compatibility.
 //
-// The auth service definition.
+// 事件处理服务，供其他服务通过gRPC直接推送事件（需服务间认证）
 type EventHandlerServer interface {
-	// Login by web3 wallet
+	// 处理单个事件，按配置同步处理或投递到事件总线
 	HandleEvent(context.Context, *Event) (*emptypb.Empty, error)
+	// 批量处理事件，单个事件失败不影响其他事件
+	HandleEvents(context.Context, *HandleEventsRequest) (*HandleEventsReply, error)
 	mustEmbedUnimplementedEventHandlerServer()
 }
 
@@ -72,6 +87,9 @@ type UnimplementedEventHandlerServer struct{}
 func (UnimplementedEventHandlerServer) HandleEvent(context.Context, *Event) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleEvent not implemented")
 }
+func (UnimplementedEventHandlerServer) HandleEvents(context.Context, *HandleEventsRequest) (*HandleEventsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleEvents not implemented")
+}
 func (UnimplementedEventHandlerServer) mustEmbedUnimplementedEventHandlerServer() {}
 func (UnimplementedEventHandlerServer) testEmbeddedByValue()                      {}
 
@@ -111,6 +129,24 @@ func _EventHandler_HandleEvent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventHandler_HandleEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventHandlerServer).HandleEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventHandler_HandleEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventHandlerServer).HandleEvents(ctx, req.(*HandleEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventHandler_ServiceDesc is the grpc.ServiceDesc for EventHandler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -122,6 +158,10 @@ var EventHandler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HandleEvent",
 			Handler:    _EventHandler_HandleEvent_Handler,
 		},
+		{
+			MethodName: "HandleEvents",
+			Handler:    _EventHandler_HandleEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "event.proto",
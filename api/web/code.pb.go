@@ -30,11 +30,28 @@ const (
 	ErrorReason_CONTENT_MISSING                   ErrorReason = 10000
 	ErrorReason_AUTH_LOGIN_EXPIRED                ErrorReason = 10001
 	ErrorReason_AUTH_LOGIN_TOKEN_INVALID          ErrorReason = 10005
+	ErrorReason_AUTH_PERMISSION_DENIED            ErrorReason = 10006
 	ErrorReason_AUTH_BLOCK_CHAIN_TYPE_NOT_SUPPORT ErrorReason = 10002
 	ErrorReason_AUTH_SIGNATURE_TEXT_INVALID       ErrorReason = 10003
 	ErrorReason_AUTH_SIGNATURE_TEXT_EXPIRED       ErrorReason = 10004
 	ErrorReason_USER_NOT_FOUND                    ErrorReason = 10101
 	ErrorReason_USER_ALREADY_EXISTS               ErrorReason = 10102
+	ErrorReason_ALARM_SILENCE_NOT_FOUND           ErrorReason = 10201
+	ErrorReason_ALARM_SILENCE_INVALID             ErrorReason = 10202
+	ErrorReason_ALARM_ACK_NOT_FOUND               ErrorReason = 10203
+	ErrorReason_EVENT_UNHANDLED                   ErrorReason = 10301
+	ErrorReason_EVENT_INVALID_PAYLOAD             ErrorReason = 10302
+	ErrorReason_SERVICE_AUTH_FAILED               ErrorReason = 10303
+	ErrorReason_EVENT_REPLAY_INVALID              ErrorReason = 10304
+	ErrorReason_EVENT_REPLAY_NOT_FOUND            ErrorReason = 10305
+	ErrorReason_TASK_QUEUE_NOT_FOUND              ErrorReason = 10401
+	ErrorReason_TASK_NOT_FOUND                    ErrorReason = 10402
+	ErrorReason_TASK_ACTION_INVALID               ErrorReason = 10403
+	ErrorReason_WEBHOOK_SUBSCRIPTION_NOT_FOUND    ErrorReason = 10501
+	ErrorReason_WEBHOOK_SUBSCRIPTION_INVALID      ErrorReason = 10502
+	ErrorReason_WEBHOOK_DELIVERY_NOT_FOUND        ErrorReason = 10503
+	ErrorReason_CRONTAB_JOB_NOT_FOUND             ErrorReason = 10601
+	ErrorReason_ROUTE_DISABLED                    ErrorReason = 10701
 )
 
 // Enum value maps for ErrorReason.
@@ -45,11 +62,28 @@ var (
 		10000: "CONTENT_MISSING",
 		10001: "AUTH_LOGIN_EXPIRED",
 		10005: "AUTH_LOGIN_TOKEN_INVALID",
+		10006: "AUTH_PERMISSION_DENIED",
 		10002: "AUTH_BLOCK_CHAIN_TYPE_NOT_SUPPORT",
 		10003: "AUTH_SIGNATURE_TEXT_INVALID",
 		10004: "AUTH_SIGNATURE_TEXT_EXPIRED",
 		10101: "USER_NOT_FOUND",
 		10102: "USER_ALREADY_EXISTS",
+		10201: "ALARM_SILENCE_NOT_FOUND",
+		10202: "ALARM_SILENCE_INVALID",
+		10203: "ALARM_ACK_NOT_FOUND",
+		10301: "EVENT_UNHANDLED",
+		10302: "EVENT_INVALID_PAYLOAD",
+		10303: "SERVICE_AUTH_FAILED",
+		10304: "EVENT_REPLAY_INVALID",
+		10305: "EVENT_REPLAY_NOT_FOUND",
+		10401: "TASK_QUEUE_NOT_FOUND",
+		10402: "TASK_NOT_FOUND",
+		10403: "TASK_ACTION_INVALID",
+		10501: "WEBHOOK_SUBSCRIPTION_NOT_FOUND",
+		10502: "WEBHOOK_SUBSCRIPTION_INVALID",
+		10503: "WEBHOOK_DELIVERY_NOT_FOUND",
+		10601: "CRONTAB_JOB_NOT_FOUND",
+		10701: "ROUTE_DISABLED",
 	}
 	ErrorReason_value = map[string]int32{
 		"_":                                 0,
@@ -57,11 +91,28 @@ var (
 		"CONTENT_MISSING":                   10000,
 		"AUTH_LOGIN_EXPIRED":                10001,
 		"AUTH_LOGIN_TOKEN_INVALID":          10005,
+		"AUTH_PERMISSION_DENIED":            10006,
 		"AUTH_BLOCK_CHAIN_TYPE_NOT_SUPPORT": 10002,
 		"AUTH_SIGNATURE_TEXT_INVALID":       10003,
 		"AUTH_SIGNATURE_TEXT_EXPIRED":       10004,
 		"USER_NOT_FOUND":                    10101,
 		"USER_ALREADY_EXISTS":               10102,
+		"ALARM_SILENCE_NOT_FOUND":           10201,
+		"ALARM_SILENCE_INVALID":             10202,
+		"ALARM_ACK_NOT_FOUND":               10203,
+		"EVENT_UNHANDLED":                   10301,
+		"EVENT_INVALID_PAYLOAD":             10302,
+		"SERVICE_AUTH_FAILED":               10303,
+		"EVENT_REPLAY_INVALID":              10304,
+		"EVENT_REPLAY_NOT_FOUND":            10305,
+		"TASK_QUEUE_NOT_FOUND":              10401,
+		"TASK_NOT_FOUND":                    10402,
+		"TASK_ACTION_INVALID":               10403,
+		"WEBHOOK_SUBSCRIPTION_NOT_FOUND":    10501,
+		"WEBHOOK_SUBSCRIPTION_INVALID":      10502,
+		"WEBHOOK_DELIVERY_NOT_FOUND":        10503,
+		"CRONTAB_JOB_NOT_FOUND":             10601,
+		"ROUTE_DISABLED":                    10701,
 	}
 )
 
@@ -97,18 +148,35 @@ var File_code_proto protoreflect.FileDescriptor
 const file_code_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"code.proto\x12\x03web\x1a\x13errors/errors.proto*\xce\x02\n" +
+	"code.proto\x12\x03web\x1a\x13errors/errors.proto*\x8d\a\n" +
 	"\vErrorReason\x12\x05\n" +
 	"\x01_\x10\x00\x12\x19\n" +
 	"\x0eINVALID_PARAMS\x10\x90\x03\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
 	"\x0fCONTENT_MISSING\x10\x90N\x1a\x04\xa8E\x90\x03\x12\x1d\n" +
 	"\x12AUTH_LOGIN_EXPIRED\x10\x91N\x1a\x04\xa8E\x91\x03\x12#\n" +
-	"\x18AUTH_LOGIN_TOKEN_INVALID\x10\x95N\x1a\x04\xa8E\x91\x03\x12,\n" +
+	"\x18AUTH_LOGIN_TOKEN_INVALID\x10\x95N\x1a\x04\xa8E\x91\x03\x12!\n" +
+	"\x16AUTH_PERMISSION_DENIED\x10\x96N\x1a\x04\xa8E\x93\x03\x12,\n" +
 	"!AUTH_BLOCK_CHAIN_TYPE_NOT_SUPPORT\x10\x92N\x1a\x04\xa8E\x90\x03\x12&\n" +
 	"\x1bAUTH_SIGNATURE_TEXT_INVALID\x10\x93N\x1a\x04\xa8E\x90\x03\x12&\n" +
 	"\x1bAUTH_SIGNATURE_TEXT_EXPIRED\x10\x94N\x1a\x04\xa8E\x90\x03\x12\x19\n" +
 	"\x0eUSER_NOT_FOUND\x10\xf5N\x1a\x04\xa8E\x94\x03\x12\x1e\n" +
-	"\x13USER_ALREADY_EXISTS\x10\xf6N\x1a\x04\xa8E\x94\x03\x1a\x04\xa0E\xf4\x03B1Z/github.com/carv-protocol/kratos-ddd/api/web;webb\x06proto3"
+	"\x13USER_ALREADY_EXISTS\x10\xf6N\x1a\x04\xa8E\x94\x03\x12\"\n" +
+	"\x17ALARM_SILENCE_NOT_FOUND\x10\xd9O\x1a\x04\xa8E\x94\x03\x12 \n" +
+	"\x15ALARM_SILENCE_INVALID\x10\xdaO\x1a\x04\xa8E\x90\x03\x12\x1e\n" +
+	"\x13ALARM_ACK_NOT_FOUND\x10\xdbO\x1a\x04\xa8E\x94\x03\x12\x1a\n" +
+	"\x0fEVENT_UNHANDLED\x10\xbdP\x1a\x04\xa8E\x90\x03\x12 \n" +
+	"\x15EVENT_INVALID_PAYLOAD\x10\xbeP\x1a\x04\xa8E\x90\x03\x12\x1e\n" +
+	"\x13SERVICE_AUTH_FAILED\x10\xbfP\x1a\x04\xa8E\x91\x03\x12\x1f\n" +
+	"\x14EVENT_REPLAY_INVALID\x10\xc0P\x1a\x04\xa8E\x90\x03\x12!\n" +
+	"\x16EVENT_REPLAY_NOT_FOUND\x10\xc1P\x1a\x04\xa8E\x94\x03\x12\x1f\n" +
+	"\x14TASK_QUEUE_NOT_FOUND\x10\xa1Q\x1a\x04\xa8E\x94\x03\x12\x19\n" +
+	"\x0eTASK_NOT_FOUND\x10\xa2Q\x1a\x04\xa8E\x94\x03\x12\x1e\n" +
+	"\x13TASK_ACTION_INVALID\x10\xa3Q\x1a\x04\xa8E\x90\x03\x12)\n" +
+	"\x1eWEBHOOK_SUBSCRIPTION_NOT_FOUND\x10\x85R\x1a\x04\xa8E\x94\x03\x12'\n" +
+	"\x1cWEBHOOK_SUBSCRIPTION_INVALID\x10\x86R\x1a\x04\xa8E\x90\x03\x12%\n" +
+	"\x1aWEBHOOK_DELIVERY_NOT_FOUND\x10\x87R\x1a\x04\xa8E\x94\x03\x12 \n" +
+	"\x15CRONTAB_JOB_NOT_FOUND\x10\xe9R\x1a\x04\xa8E\x94\x03\x12\x19\n" +
+	"\x0eROUTE_DISABLED\x10\xcdS\x1a\x04\xa8E\xf7\x03\x1a\x04\xa0E\xf4\x03B1Z/github.com/carv-protocol/kratos-ddd/api/web;webb\x06proto3"
 
 var (
 	file_code_proto_rawDescOnce sync.Once
@@ -73,6 +73,18 @@ func ErrorAuthLoginTokenInvalid(format string, args ...interface{}) *errors.Erro
 	return errors.New(401, ErrorReason_AUTH_LOGIN_TOKEN_INVALID.String(), fmt.Sprintf(format, args...))
 }
 
+func IsAuthPermissionDenied(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_AUTH_PERMISSION_DENIED.String() && e.Code == 403
+}
+
+func ErrorAuthPermissionDenied(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_AUTH_PERMISSION_DENIED.String(), fmt.Sprintf(format, args...))
+}
+
 func IsAuthBlockChainTypeNotSupport(err error) bool {
 	if err == nil {
 		return false
@@ -132,3 +144,195 @@ func IsUserAlreadyExists(err error) bool {
 func ErrorUserAlreadyExists(format string, args ...interface{}) *errors.Error {
 	return errors.New(404, ErrorReason_USER_ALREADY_EXISTS.String(), fmt.Sprintf(format, args...))
 }
+
+func IsAlarmSilenceNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ALARM_SILENCE_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorAlarmSilenceNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_ALARM_SILENCE_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsAlarmSilenceInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ALARM_SILENCE_INVALID.String() && e.Code == 400
+}
+
+func ErrorAlarmSilenceInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_ALARM_SILENCE_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+func IsAlarmAckNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ALARM_ACK_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorAlarmAckNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_ALARM_ACK_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsEventUnhandled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EVENT_UNHANDLED.String() && e.Code == 400
+}
+
+func ErrorEventUnhandled(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_EVENT_UNHANDLED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsEventInvalidPayload(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EVENT_INVALID_PAYLOAD.String() && e.Code == 400
+}
+
+func ErrorEventInvalidPayload(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_EVENT_INVALID_PAYLOAD.String(), fmt.Sprintf(format, args...))
+}
+
+func IsServiceAuthFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SERVICE_AUTH_FAILED.String() && e.Code == 401
+}
+
+func ErrorServiceAuthFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_SERVICE_AUTH_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsEventReplayInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EVENT_REPLAY_INVALID.String() && e.Code == 400
+}
+
+func ErrorEventReplayInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_EVENT_REPLAY_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+func IsEventReplayNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EVENT_REPLAY_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorEventReplayNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_EVENT_REPLAY_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTaskQueueNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TASK_QUEUE_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorTaskQueueNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_TASK_QUEUE_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTaskNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TASK_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorTaskNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_TASK_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTaskActionInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TASK_ACTION_INVALID.String() && e.Code == 400
+}
+
+func ErrorTaskActionInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_TASK_ACTION_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+func IsWebhookSubscriptionNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_WEBHOOK_SUBSCRIPTION_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorWebhookSubscriptionNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_WEBHOOK_SUBSCRIPTION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsWebhookSubscriptionInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_WEBHOOK_SUBSCRIPTION_INVALID.String() && e.Code == 400
+}
+
+func ErrorWebhookSubscriptionInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_WEBHOOK_SUBSCRIPTION_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+func IsWebhookDeliveryNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_WEBHOOK_DELIVERY_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorWebhookDeliveryNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_WEBHOOK_DELIVERY_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCrontabJobNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_CRONTAB_JOB_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorCrontabJobNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_CRONTAB_JOB_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsRouteDisabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ROUTE_DISABLED.String() && e.Code == 503
+}
+
+func ErrorRouteDisabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(503, ErrorReason_ROUTE_DISABLED.String(), fmt.Sprintf(format, args...))
+}
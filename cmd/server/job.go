@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/internal/crontab"
+)
+
+// job模式的退出码
+const (
+	jobExitOK     = 0
+	jobExitFailed = 1
+	jobExitUsage  = 2
+)
+
+// jobCommand 执行一次定时任务后退出：暂停或锁被其他实例持有时跳过并正常退出
+type jobCommand struct {
+	register *crontab.JobRegister
+	log      *log.Helper
+}
+
+func newJobCommand(register *crontab.JobRegister, logger log.Logger) *jobCommand {
+	return &jobCommand{register: register, log: log.NewHelper(log.With(logger, "module", "job"))}
+}
+
+func (cmd *jobCommand) Run(name string) int {
+	job, ok := cmd.register.Job(name)
+	if !ok {
+		names := make([]string, 0, len(cmd.register.Jobs()))
+		for _, job := range cmd.register.Jobs() {
+			names = append(names, job.Name())
+		}
+		cmd.log.Errorf("unknown job %q, available jobs: %s", name, strings.Join(names, ", "))
+		return jobExitUsage
+	}
+	// 收到退出信号时取消任务，Kubernetes停止Pod时任务可以及时结束
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	cmd.log.Infof("run job %s once", name)
+	err := job.RunOnce(ctx, nil)
+	switch {
+	case errors.Is(err, crontab.ErrJobSkipped):
+		cmd.log.Infof("job %s: %v", name, err)
+	case err != nil:
+		cmd.log.Errorf("job %s failed: %v", name, err)
+		return jobExitFailed
+	default:
+		cmd.log.Infof("job %s succeeded", name)
+	}
+	return jobExitOK
+}
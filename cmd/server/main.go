@@ -7,10 +7,12 @@ import (
 	"time"
 
 	"github.com/go-kratos/kratos/v2/encoding/json"
+	"github.com/rs/zerolog"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/crontab"
 	"github.com/seanbit/kratos/template/internal/global"
+	"github.com/seanbit/kratos/template/internal/server"
 	"github.com/seanbit/kratos/webkit"
-	"github.com/seanbit/kratos/webkit/transport/asynq"
-	"github.com/seanbit/kratos/webkit/transport/crontab"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/go-kratos/kratos/v2"
@@ -19,6 +21,8 @@ import (
 	"github.com/go-kratos/kratos/v2/transport/http"
 
 	_ "go.uber.org/automaxprocs"
+	// 运行镜像不含时区数据，crontab按时区调度时需要
+	_ "time/tzdata"
 )
 
 // go build -ldflags "-X main.Version=x.y.z"
@@ -33,6 +37,10 @@ var (
 	flagconfsrc string
 	// flagsecretfile is the secret file flag.
 	flagsecretfile string
+	// flagruntype is the run type flag.
+	flagruntype string
+	// flagjobname is the job name flag, used when run type is job.
+	flagjobname string
 
 	id, _ = os.Hostname()
 )
@@ -41,9 +49,13 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 	flag.StringVar(&flagconfsrc, "conf-src", "file", "config source, eg: -conf-src appconfig")
 	flag.StringVar(&flagsecretfile, "secret-file", "", "secret file name, eg: -secret-file secret.yaml")
+	flag.StringVar(&flagruntype, "run-type", runTypeServer, "run type: server, worker or job, eg: -run-type job")
+	flag.StringVar(&flagjobname, "job-name", "", "crontab job to run once when run type is job, eg: -job-name test")
 	flag.Usage = func() {
 		fmt.Fprintf(flag.CommandLine.Output(), "%s Version: %s\n", Name, Version)
 		fmt.Fprintf(flag.CommandLine.Output(), "Usage of %s:\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "  %s replay -h\n\treplay stored events\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "  %s config check|show|diff -h\n\tvalidate, print or compare config directories\n", os.Args[0])
 		flag.PrintDefaults()
 	}
 	json.MarshalOptions = protojson.MarshalOptions{
@@ -52,7 +64,17 @@ func init() {
 	}
 }
 
-func newApp(gs *grpc.Server, hs *http.Server, asynqs *asynq.Server, crontor *crontab.Executor) *kratos.App {
+// 运行方式
+const (
+	// runTypeServer HTTP/gRPC服务、事件订阅、定时任务与后台投递
+	runTypeServer = "server"
+	// runTypeWorker 订阅事件总线处理事件，并投递outbox中的事件与webhook，不提供HTTP/gRPC服务和定时任务
+	runTypeWorker = "worker"
+	// runTypeJob 执行一次-job-name指定的定时任务后退出，用于Kubernetes CronJob
+	runTypeJob = "job"
+)
+
+func newApp(gs *grpc.Server, hs *http.Server, eventbus *server.EventBusServer, crontor *crontab.Server, outboxs *server.OutboxServer, webhooks *server.WebhookServer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -61,23 +83,68 @@ func newApp(gs *grpc.Server, hs *http.Server, asynqs *asynq.Server, crontor *cro
 		kratos.Server(
 			gs,
 			hs,
-			asynqs,
+			eventbus,
 			crontor,
+			outboxs,
+			webhooks,
+		),
+		kratos.StopTimeout(time.Second*300),
+	)
+}
+
+func newWorkerApp(eventbus *server.EventBusServer, outboxs *server.OutboxServer, webhooks *server.WebhookServer) *kratos.App {
+	return kratos.New(
+		kratos.ID(id),
+		kratos.Name(Name),
+		kratos.Version(Version),
+		kratos.Metadata(map[string]string{}),
+		kratos.Server(
+			eventbus,
+			outboxs,
+			webhooks,
 		),
 		kratos.StopTimeout(time.Second*300),
 	)
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "replay" {
+		runReplay(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "config" {
+		runConfig(os.Args[2:])
+		return
+	}
 	flag.Parse()
+	os.Exit(run())
+}
+
+// run 按-run-type启动，返回进程退出码
+func run() int {
+	switch flagruntype {
+	case "", runTypeServer, runTypeWorker, runTypeJob:
+	default:
+		fmt.Fprintf(os.Stderr, "unknown run type: %s\n", flagruntype)
+		return 2
+	}
 
 	// 加载配置
 	cleanconf := global.InitConfig(flagconfsrc, flagconf, flagsecretfile)
 	defer cleanconf()
 	bc := global.GetConfig()
+	global.SetVersion(Version)
 
 	// 初始化logger
 	webkit.InitLogger(Name, Version, int(bc.LogLevel))
+	// 热更新日志级别，LogLevel与zerolog的级别一一对应
+	global.SubscribeConfig("log_level", func(old, next *conf.Bootstrap) error {
+		if old.LogLevel != next.LogLevel {
+			zerolog.SetGlobalLevel(zerolog.Level(next.LogLevel))
+			log.Infof("log level changed from %s to %s", old.LogLevel, next.LogLevel)
+		}
+		return nil
+	})
 
 	// 初始化Metrics
 	if err := webkit.InitMetrics(Name); err != nil {
@@ -99,7 +166,20 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.S3, bc.GeoIp, bc.Alarm, bc.Auth, log.GetLogger())
+	if flagruntype == runTypeJob {
+		cmd, cleanup, err := wireJob(bc.Server, bc.Data, bc.S3, bc.GeoIp, bc.Alarm, bc.Auth, bc.Crontab, log.GetLogger())
+		if err != nil {
+			panic(err)
+		}
+		defer cleanup()
+		return cmd.Run(flagjobname)
+	}
+
+	injector := wireApp
+	if flagruntype == runTypeWorker {
+		injector = wireWorker
+	}
+	app, cleanup, err := injector(bc.Server, bc.Data, bc.S3, bc.GeoIp, bc.Alarm, bc.Auth, bc.Crontab, log.GetLogger())
 	if err != nil {
 		panic(err)
 	}
@@ -109,4 +189,5 @@ func main() {
 	if err := app.Run(); err != nil {
 		panic(err)
 	}
+	return 0
 }
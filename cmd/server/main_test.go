@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/biz/mocks"
+	"github.com/seanbit/kratos/template/internal/crontab"
+	"go.uber.org/mock/gomock"
+)
+
+func TestJobCommand_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	control := mocks.NewMockIJobControlRepo(ctrl)
+	control.EXPECT().IsPaused(gomock.Any(), "paused").Return(true, nil)
+	control.EXPECT().IsPaused(gomock.Any(), gomock.Not("paused")).Return(false, nil).AnyTimes()
+	runs := mocks.NewMockIJobRunRepo(ctrl)
+	runs.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	runs.EXPECT().ListRecentStatuses(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	history := biz.NewJobHistory(runs, mocks.NewMockIAlarmRepo(ctrl))
+	noop := crontab.JobFunc(func(ctx context.Context) error { return nil })
+	register := crontab.RegisterJobs(mocks.NewMockIJobLockRepo(ctrl), control, history, nil, log.DefaultLogger,
+		crontab.NewJobWrap("succeeded", "0 * * * * *", noop),
+		crontab.NewJobWrap("failed", "0 * * * * *", crontab.JobFunc(func(ctx context.Context) error {
+			return errors.New("boom")
+		})),
+		crontab.NewJobWrap("paused", "0 * * * * *", noop),
+	)
+	cmd := newJobCommand(register, log.DefaultLogger)
+
+	cases := map[string]int{
+		"succeeded": jobExitOK,
+		"failed":    jobExitFailed,
+		// 暂停跳过时正常退出，CronJob不会重试
+		"paused":  jobExitOK,
+		"unknown": jobExitUsage,
+		"":        jobExitUsage,
+	}
+	for name, code := range cases {
+		if got := cmd.Run(name); got != code {
+			t.Errorf("job %q: expected exit code %d, got %d", name, code, got)
+		}
+	}
+}
+
+func TestRun_UnknownRunType(t *testing.T) {
+	defer func(runType string) { flagruntype = runType }(flagruntype)
+	flagruntype = "cron"
+	if code := run(); code != 2 {
+		t.Errorf("expected exit code 2, got %d", code)
+	}
+}
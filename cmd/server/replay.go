@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/global"
+	"github.com/seanbit/kratos/template/internal/service"
+	"github.com/seanbit/kratos/webkit"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// replayCommand 事件重放子命令
+type replayCommand struct {
+	replayer *biz.EventReplayer
+	events   *service.EventService
+}
+
+func newReplayCommand(replayer *biz.EventReplayer, events *service.EventService) *replayCommand {
+	return &replayCommand{replayer: replayer, events: events}
+}
+
+// runReplay 重放已保存的事件，例如修复GeoIP后重建登录记录的国家：
+//
+//	server replay -conf ../../configs -source login_log -target local -from 2025-01-01T00:00:00Z -rate 200
+func runReplay(args []string) {
+	var (
+		req      biz.EventReplayRequest
+		from, to string
+	)
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	fs.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	fs.StringVar(&flagconfsrc, "conf-src", "file", "config source, eg: -conf-src appconfig")
+	fs.StringVar(&flagsecretfile, "secret-file", "", "secret file name, eg: -secret-file secret.yaml")
+	fs.StringVar(&req.Source, "source", biz.EventReplaySourceOutbox, "event source: outbox/login_log")
+	fs.StringVar(&req.Name, "name", "", "event name, required by outbox source; replay event.UserLogin from login_log")
+	fs.StringVar(&req.Target, "target", biz.EventReplayTargetLocal, "replay target: bus (re-publish to event bus) / local (handlers of this service)")
+	fs.StringVar(&from, "from", "", "start time (inclusive), RFC3339")
+	fs.StringVar(&to, "to", "", "end time (exclusive), RFC3339, default now")
+	fs.BoolVar(&req.DryRun, "dry-run", false, "only count events and print samples")
+	fs.Float64Var(&req.Rate, "rate", 0, "max events replayed per second, 0 for unlimited")
+	fs.IntVar(&req.BatchSize, "batch-size", 0, "events read per batch, default 100")
+	fs.IntVar(&req.Limit, "limit", 0, "max events to replay, 0 for unlimited")
+	fs.BoolVar(&req.NotifyWebhooks, "notify-webhooks", false, "notify webhook subscribers when replaying to local handlers")
+	_ = fs.Parse(args)
+
+	var err error
+	if req.From, err = time.Parse(time.RFC3339, from); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid -from: %v\n", err)
+		os.Exit(2)
+	}
+	if to != "" {
+		if req.To, err = time.Parse(time.RFC3339, to); err != nil {
+			fmt.Fprintf(os.Stderr, "invalid -to: %v\n", err)
+			os.Exit(2)
+		}
+	}
+
+	cleanconf := global.InitConfig(flagconfsrc, flagconf, flagsecretfile)
+	defer cleanconf()
+	bc := global.GetConfig()
+	webkit.InitLogger(Name, Version, int(bc.LogLevel))
+
+	cmd, cleanup, err := wireReplay(bc.Server, bc.Data, bc.S3, bc.GeoIp, bc.Alarm, bc.Auth, log.GetLogger())
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	// 中断时停止读取，已重放的事件不会撤回
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	progress, err := cmd.replayer.Replay(ctx, req, cmd.events.Consume, func(p *biz.EventReplayProgress) {
+		fmt.Fprintf(os.Stderr, "scanned: %d, replayed: %d, failed: %d\n", p.Scanned, p.Replayed, p.Failed)
+	})
+	if progress != nil {
+		out, _ := protojson.MarshalOptions{Multiline: true}.Marshal(service.ToEventReplayProgressReply(progress))
+		fmt.Println(string(out))
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "replay failed: %v\n", err)
+		os.Exit(1)
+	}
+}
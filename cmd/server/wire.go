@@ -19,6 +19,21 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Data, *conf.S3, *conf.GeoIp, *conf.Alarm, *conf.Auth, log.Logger) (*kratos.App, func(), error) {
+func wireApp(*conf.Server, *conf.Data, *conf.S3, *conf.GeoIp, *conf.Alarm, *conf.Auth, *conf.Crontab, log.Logger) (*kratos.App, func(), error) {
 	panic(wire.Build(infra.ProviderSet, data.ProviderSet, biz.ProviderSet, crontab.ProviderSet, service.ProviderSet, server.ProviderSet, newApp))
 }
+
+// wireReplay init event replay command.
+func wireReplay(*conf.Server, *conf.Data, *conf.S3, *conf.GeoIp, *conf.Alarm, *conf.Auth, log.Logger) (*replayCommand, func(), error) {
+	panic(wire.Build(infra.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, server.NewAsynqClient, newReplayCommand))
+}
+
+// wireWorker init kratos application that only consumes the event bus.
+func wireWorker(*conf.Server, *conf.Data, *conf.S3, *conf.GeoIp, *conf.Alarm, *conf.Auth, *conf.Crontab, log.Logger) (*kratos.App, func(), error) {
+	panic(wire.Build(infra.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, server.ProviderSet, newWorkerApp))
+}
+
+// wireJob init one-shot crontab job command.
+func wireJob(*conf.Server, *conf.Data, *conf.S3, *conf.GeoIp, *conf.Alarm, *conf.Auth, *conf.Crontab, log.Logger) (*jobCommand, func(), error) {
+	panic(wire.Build(infra.ProviderSet, data.ProviderSet, biz.ProviderSet, crontab.ProviderSet, newJobCommand))
+}
@@ -17,17 +17,17 @@ import (
 	"github.com/seanbit/kratos/template/internal/server"
 	"github.com/seanbit/kratos/template/internal/server/middlewares"
 	"github.com/seanbit/kratos/template/internal/service"
-	crontab2 "github.com/seanbit/kratos/webkit/transport/crontab"
 )
 
 import (
 	_ "go.uber.org/automaxprocs"
+	_ "time/tzdata"
 )
 
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, s3 *conf.S3, geoIp *conf.GeoIp, alarm *conf.Alarm, auth *conf.Auth, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, s3 *conf.S3, geoIp *conf.GeoIp, alarm *conf.Alarm, auth *conf.Auth, confCrontab *conf.Crontab, logger log.Logger) (*kratos.App, func(), error) {
 	dataProvider, cleanup, err := infra.NewDataProvider(confData)
 	if err != nil {
 		return nil, nil, err
@@ -35,38 +35,225 @@ func wireApp(confServer *conf.Server, confData *conf.Data, s3 *conf.S3, geoIp *c
 	iHealthRepo := data.NewHealthRepo(dataProvider, dataProvider, logger)
 	probe := biz.NewProbe(iHealthRepo)
 	probeService := service.NewProbeService(probe)
-	grpcServer := server.NewGRPCServer(confServer, probeService, logger)
+	iProcessedEventRepo := data.NewProcessedEventRepo(dataProvider)
+	eventIdempotency := biz.NewEventIdempotency(confData, iProcessedEventRepo)
+	eventRegistry := service.NewEventRegistry(eventIdempotency)
+	iTransaction := data.NewTransaction(dataProvider)
 	iAuthRepo := data.NewAuthRepo(dataProvider, dataProvider)
+	iOutboxRepo := data.NewOutboxRepo(dataProvider)
+	iAuthLogRepo := data.NewAuthLogRepo(dataProvider, dataProvider, iOutboxRepo)
+	client := infra.NewS3Client(s3)
+	iGeoIp, err := data.NewGeoIP(client, geoIp)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	bizAuth := biz.NewAuth(auth, iTransaction, iAuthRepo, iAuthLogRepo, iGeoIp)
+	asynqClient, err := server.NewAsynqClient(confServer)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	iEventBus, cleanup2, err := data.NewEventBus(confServer, asynqClient, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	iEventPublisher := data.NewEventPublisher(iEventBus)
+	iWebhookRepo := data.NewWebhookRepo(dataProvider)
+	iWebhookSender := data.NewWebhookSender(confData)
+	iAlarmMessageRepo := data.NewAlarmMessageRepo(dataProvider, dataProvider, logger)
+	iAlarmSilenceRepo := data.NewAlarmSilenceRepo(dataProvider, dataProvider, logger)
+	iAlarmRepo, cleanup3, err := data.NewAlarm(alarm, iAlarmMessageRepo, iAlarmSilenceRepo)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	webhook := biz.NewWebhook(confData, iWebhookRepo, iWebhookSender, iAlarmRepo)
+	eventService := service.NewEventService(confServer, eventRegistry, bizAuth, iEventPublisher, webhook, logger)
+	adminAuth, cleanup4 := middlewares.NewAdminAuth(auth, bizAuth)
+	grpcServer := server.NewGRPCServer(confServer, probeService, eventService, adminAuth, logger)
+	routePolicy, cleanup5 := middlewares.NewRoutePolicy(confServer)
+	userAuth := middlewares.NewUserAuth(bizAuth)
+	serverErrorAlarm := middlewares.NewServerErrorAlarm(alarm, iAlarmRepo)
+	httpBuilder := middlewares.NewHttpBuilder(routePolicy, userAuth, adminAuth, serverErrorAlarm)
+	authService := service.NewAuthService(bizAuth)
+	iAlarmInspectRepo := data.NewAlarmInspectRepo(iAlarmMessageRepo)
+	alarmAdmin := biz.NewAlarmAdmin(alarm, iAlarmInspectRepo, iAlarmSilenceRepo)
+	alarmService := service.NewAlarmService(alarmAdmin)
+	iTaskInspectRepo, cleanup6, err := data.NewTaskInspectRepo(confServer, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	taskAdmin := biz.NewTaskAdmin(iTaskInspectRepo)
+	taskService := service.NewTaskService(taskAdmin)
+	iEventReplayRepo := data.NewEventReplayRepo(dataProvider)
+	eventReplayer := biz.NewEventReplayer(iEventReplayRepo, iEventPublisher)
+	eventReplayService := service.NewEventReplayService(eventReplayer, eventService)
+	webhookService := service.NewWebhookService(webhook, eventRegistry)
+	iJobLockRepo := data.NewJobLockRepo(dataProvider)
+	iJobControlRepo := data.NewJobControlRepo(dataProvider)
+	iJobRunRepo := data.NewJobRunRepo(dataProvider)
+	jobHistory := biz.NewJobHistory(iJobRunRepo, iAlarmRepo)
+	jobTest := crontab.NewJobTest()
+	jobRunCleanup := crontab.NewJobRunCleanup(jobHistory)
+	jobRegister := crontab.NewJobRegister(confCrontab, iJobLockRepo, iJobControlRepo, jobHistory, logger, jobTest, jobRunCleanup)
+	crontabService := service.NewCrontabService(jobRegister, iJobControlRepo, jobHistory)
+	configService := service.NewConfigService()
+	httpServer := server.NewHTTPServer(confServer, logger, httpBuilder, probeService, iAlarmRepo, authService, alarmService, taskService, eventReplayService, webhookService, crontabService, configService)
+	eventBusServer := server.NewEventBusServer(confServer, iEventBus, eventRegistry, eventService, logger)
+	crontabServer := crontab.NewServer(jobRegister, logger)
+	outboxRelay := biz.NewOutboxRelay(confData, iOutboxRepo, iEventPublisher, iAlarmRepo)
+	outboxServer := server.NewOutboxServer(outboxRelay, logger)
+	webhookServer := server.NewWebhookServer(webhook, logger)
+	app := newApp(grpcServer, httpServer, eventBusServer, crontabServer, outboxServer, webhookServer)
+	return app, func() {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+// wireReplay init event replay command.
+func wireReplay(confServer *conf.Server, confData *conf.Data, s3 *conf.S3, geoIp *conf.GeoIp, alarm *conf.Alarm, auth *conf.Auth, logger log.Logger) (*replayCommand, func(), error) {
+	dataProvider, cleanup, err := infra.NewDataProvider(confData)
+	if err != nil {
+		return nil, nil, err
+	}
+	iEventReplayRepo := data.NewEventReplayRepo(dataProvider)
 	client, err := server.NewAsynqClient(confServer)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
-	iAuthLogRepo := data.NewAuthLogRepo(dataProvider, dataProvider, client)
+	iEventBus, cleanup2, err := data.NewEventBus(confServer, client, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	iEventPublisher := data.NewEventPublisher(iEventBus)
+	eventReplayer := biz.NewEventReplayer(iEventReplayRepo, iEventPublisher)
+	iProcessedEventRepo := data.NewProcessedEventRepo(dataProvider)
+	eventIdempotency := biz.NewEventIdempotency(confData, iProcessedEventRepo)
+	eventRegistry := service.NewEventRegistry(eventIdempotency)
+	iTransaction := data.NewTransaction(dataProvider)
+	iAuthRepo := data.NewAuthRepo(dataProvider, dataProvider)
+	iOutboxRepo := data.NewOutboxRepo(dataProvider)
+	iAuthLogRepo := data.NewAuthLogRepo(dataProvider, dataProvider, iOutboxRepo)
 	s3Client := infra.NewS3Client(s3)
 	iGeoIp, err := data.NewGeoIP(s3Client, geoIp)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	bizAuth := biz.NewAuth(auth, iAuthRepo, iAuthLogRepo, iGeoIp)
-	userAuth := middlewares.NewUserAuth(bizAuth)
-	httpBuilder := middlewares.NewHttpBuilder(userAuth)
+	bizAuth := biz.NewAuth(auth, iTransaction, iAuthRepo, iAuthLogRepo, iGeoIp)
+	iWebhookRepo := data.NewWebhookRepo(dataProvider)
+	iWebhookSender := data.NewWebhookSender(confData)
 	iAlarmMessageRepo := data.NewAlarmMessageRepo(dataProvider, dataProvider, logger)
-	iAlarmRepo, cleanup2, err := data.NewAlarm(alarm, iAlarmMessageRepo)
+	iAlarmSilenceRepo := data.NewAlarmSilenceRepo(dataProvider, dataProvider, logger)
+	iAlarmRepo, cleanup3, err := data.NewAlarm(alarm, iAlarmMessageRepo, iAlarmSilenceRepo)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	authService := service.NewAuthService(bizAuth)
-	httpServer := server.NewHTTPServer(confServer, logger, httpBuilder, probeService, iAlarmRepo, authService)
-	eventHandlerServer := service.NewEventService(bizAuth)
-	asynqServer := server.NewAsynqServer(confServer, logger, eventHandlerServer)
-	jobTest := crontab.NewJobTest()
-	jobRegister := crontab.NewJobRegister(jobTest)
-	executor := crontab2.NewServer(jobRegister)
-	app := newApp(grpcServer, httpServer, asynqServer, executor)
+	webhook := biz.NewWebhook(confData, iWebhookRepo, iWebhookSender, iAlarmRepo)
+	eventService := service.NewEventService(confServer, eventRegistry, bizAuth, iEventPublisher, webhook, logger)
+	mainReplayCommand := newReplayCommand(eventReplayer, eventService)
+	return mainReplayCommand, func() {
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+// wireWorker init kratos application that only consumes the event bus.
+func wireWorker(confServer *conf.Server, confData *conf.Data, s3 *conf.S3, geoIp *conf.GeoIp, alarm *conf.Alarm, auth *conf.Auth, confCrontab *conf.Crontab, logger log.Logger) (*kratos.App, func(), error) {
+	client, err := server.NewAsynqClient(confServer)
+	if err != nil {
+		return nil, nil, err
+	}
+	iEventBus, cleanup, err := data.NewEventBus(confServer, client, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	dataProvider, cleanup2, err := infra.NewDataProvider(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	iProcessedEventRepo := data.NewProcessedEventRepo(dataProvider)
+	eventIdempotency := biz.NewEventIdempotency(confData, iProcessedEventRepo)
+	eventRegistry := service.NewEventRegistry(eventIdempotency)
+	iTransaction := data.NewTransaction(dataProvider)
+	iAuthRepo := data.NewAuthRepo(dataProvider, dataProvider)
+	iOutboxRepo := data.NewOutboxRepo(dataProvider)
+	iAuthLogRepo := data.NewAuthLogRepo(dataProvider, dataProvider, iOutboxRepo)
+	s3Client := infra.NewS3Client(s3)
+	iGeoIp, err := data.NewGeoIP(s3Client, geoIp)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	bizAuth := biz.NewAuth(auth, iTransaction, iAuthRepo, iAuthLogRepo, iGeoIp)
+	iEventPublisher := data.NewEventPublisher(iEventBus)
+	iWebhookRepo := data.NewWebhookRepo(dataProvider)
+	iWebhookSender := data.NewWebhookSender(confData)
+	iAlarmMessageRepo := data.NewAlarmMessageRepo(dataProvider, dataProvider, logger)
+	iAlarmSilenceRepo := data.NewAlarmSilenceRepo(dataProvider, dataProvider, logger)
+	iAlarmRepo, cleanup3, err := data.NewAlarm(alarm, iAlarmMessageRepo, iAlarmSilenceRepo)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	webhook := biz.NewWebhook(confData, iWebhookRepo, iWebhookSender, iAlarmRepo)
+	eventService := service.NewEventService(confServer, eventRegistry, bizAuth, iEventPublisher, webhook, logger)
+	eventBusServer := server.NewEventBusServer(confServer, iEventBus, eventRegistry, eventService, logger)
+	outboxRelay := biz.NewOutboxRelay(confData, iOutboxRepo, iEventPublisher, iAlarmRepo)
+	outboxServer := server.NewOutboxServer(outboxRelay, logger)
+	webhookServer := server.NewWebhookServer(webhook, logger)
+	app := newWorkerApp(eventBusServer, outboxServer, webhookServer)
 	return app, func() {
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+// wireJob init one-shot crontab job command.
+func wireJob(confServer *conf.Server, confData *conf.Data, s3 *conf.S3, geoIp *conf.GeoIp, alarm *conf.Alarm, auth *conf.Auth, confCrontab *conf.Crontab, logger log.Logger) (*jobCommand, func(), error) {
+	dataProvider, cleanup, err := infra.NewDataProvider(confData)
+	if err != nil {
+		return nil, nil, err
+	}
+	iJobLockRepo := data.NewJobLockRepo(dataProvider)
+	iJobControlRepo := data.NewJobControlRepo(dataProvider)
+	iJobRunRepo := data.NewJobRunRepo(dataProvider)
+	iAlarmMessageRepo := data.NewAlarmMessageRepo(dataProvider, dataProvider, logger)
+	iAlarmSilenceRepo := data.NewAlarmSilenceRepo(dataProvider, dataProvider, logger)
+	iAlarmRepo, cleanup2, err := data.NewAlarm(alarm, iAlarmMessageRepo, iAlarmSilenceRepo)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	jobHistory := biz.NewJobHistory(iJobRunRepo, iAlarmRepo)
+	jobTest := crontab.NewJobTest()
+	jobRunCleanup := crontab.NewJobRunCleanup(jobHistory)
+	jobRegister := crontab.NewJobRegister(confCrontab, iJobLockRepo, iJobControlRepo, jobHistory, logger, jobTest, jobRunCleanup)
+	mainJobCommand := newJobCommand(jobRegister, logger)
+	return mainJobCommand, func() {
 		cleanup2()
 		cleanup()
 	}, nil
@@ -0,0 +1,219 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/seanbit/kratos/template/internal/conf"
+)
+
+const defaultAlarmDelayedLimit = 100
+
+// AlarmQueueMessage 队列中的告警消息
+type AlarmQueueMessage struct {
+	Platform  string
+	Title     string
+	Info      string
+	TraceId   string
+	Retry     int
+	MaxRetry  int
+	ExecuteAt time.Time // 延迟消息的预计执行时间
+}
+
+// FusedAlarmMessage 熔断中的告警消息
+type FusedAlarmMessage struct {
+	Fingerprint string
+	Info        string
+	TTL         time.Duration
+}
+
+// AlarmQueueStats 告警队列状态
+type AlarmQueueStats struct {
+	QueueLength     int64
+	DelayedSize     int64
+	DelayedMessages []*AlarmQueueMessage
+	FusedMessages   []*FusedAlarmMessage
+}
+
+// IAlarmInspectRepo 告警队列查询（由data层实现）
+type IAlarmInspectRepo interface {
+	GetQueueStats(ctx context.Context, delayedLimit int) (*AlarmQueueStats, error)
+}
+
+type AlarmAdmin struct {
+	config      *conf.Alarm
+	inspectRepo IAlarmInspectRepo
+	silenceRepo IAlarmSilenceRepo
+}
+
+func NewAlarmAdmin(config *conf.Alarm, inspectRepo IAlarmInspectRepo, silenceRepo IAlarmSilenceRepo) *AlarmAdmin {
+	return &AlarmAdmin{config: config, inspectRepo: inspectRepo, silenceRepo: silenceRepo}
+}
+
+// GetQueueStats 获取告警队列深度、延迟队列内容及熔断中的消息
+func (a *AlarmAdmin) GetQueueStats(ctx context.Context, delayedLimit int) (*AlarmQueueStats, error) {
+	if delayedLimit <= 0 {
+		delayedLimit = defaultAlarmDelayedLimit
+	}
+	return a.inspectRepo.GetQueueStats(ctx, delayedLimit)
+}
+
+// 静默规则可匹配的告警标签
+const (
+	AlarmLabelPlatform  = "platform"
+	AlarmLabelService   = "service"
+	AlarmLabelEnv       = "env"
+	AlarmLabelHost      = "host"
+	AlarmLabelOperation = "operation"
+	AlarmLabelPath      = "path"
+	AlarmLabelUserId    = "user_id"
+)
+
+// AlarmSilence 告警静默规则：[StartsAt, EndsAt) 内匹配的告警不发送
+type AlarmSilence struct {
+	ID           int64
+	TitlePattern string            // 标题正则，为空时匹配所有
+	InfoPattern  string            // 内容正则，为空时匹配所有
+	Labels       map[string]string // 标签名 -> 正则，所有标签都匹配时生效
+	Creator      string
+	Comment      string
+	StartsAt     time.Time
+	EndsAt       time.Time
+	CreatedAt    time.Time
+}
+
+// IsActive 静默规则在now时是否生效
+func (s *AlarmSilence) IsActive(now time.Time) bool {
+	return !now.Before(s.StartsAt) && now.Before(s.EndsAt)
+}
+
+// Remaining 静默规则的剩余时长
+func (s *AlarmSilence) Remaining(now time.Time) time.Duration {
+	return max(0, s.EndsAt.Sub(now))
+}
+
+// AlarmSilenceMatcher 预编译的静默规则
+type AlarmSilenceMatcher struct {
+	Silence *AlarmSilence
+	title   *regexp.Regexp
+	info    *regexp.Regexp
+	labels  map[string]*regexp.Regexp
+}
+
+func NewAlarmSilenceMatcher(silence *AlarmSilence) (*AlarmSilenceMatcher, error) {
+	var err error
+	matcher := &AlarmSilenceMatcher{Silence: silence, labels: make(map[string]*regexp.Regexp, len(silence.Labels))}
+	if matcher.title, err = compileAlarmPattern(silence.TitlePattern); err != nil {
+		return nil, fmt.Errorf("title pattern: %w", err)
+	}
+	if matcher.info, err = compileAlarmPattern(silence.InfoPattern); err != nil {
+		return nil, fmt.Errorf("info pattern: %w", err)
+	}
+	for label, pattern := range silence.Labels {
+		if matcher.labels[label], err = compileAlarmPattern(pattern); err != nil {
+			return nil, fmt.Errorf("label %s pattern: %w", label, err)
+		}
+	}
+	return matcher, nil
+}
+
+func compileAlarmPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	return regexp.Compile(pattern)
+}
+
+// Matches 告警是否匹配静默规则（不判断生效时间）
+func (m *AlarmSilenceMatcher) Matches(title, info string, labels map[string]string) bool {
+	if m.title != nil && !m.title.MatchString(title) {
+		return false
+	}
+	if m.info != nil && !m.info.MatchString(info) {
+		return false
+	}
+	for label, re := range m.labels {
+		if re != nil && !re.MatchString(labels[label]) {
+			return false
+		}
+	}
+	return true
+}
+
+// AlarmAck 告警确认：确认后同一指纹的告警不再通知，直到持续ResolveTimeout未触发
+type AlarmAck struct {
+	Fingerprint string
+	Creator     string
+	Comment     string
+	AckedAt     time.Time
+	TTL         time.Duration // 距离视为恢复的剩余时长
+}
+
+// IAlarmSilenceRepo 告警静默与确认（由data层实现）
+//
+//go:generate mockgen -source=alarm.go -destination=./mocks/alarm.go -package=mocks
+type IAlarmSilenceRepo interface {
+	CreateSilence(ctx context.Context, silence *AlarmSilence) error
+	ListSilences(ctx context.Context, includeExpired bool) ([]*AlarmSilence, error)
+	ExpireSilence(ctx context.Context, id int64) error
+	// MatchSilence 返回匹配告警的生效中的静默规则，未匹配返回nil
+	MatchSilence(ctx context.Context, title, info string, labels map[string]string) (*AlarmSilence, error)
+
+	AckAlarm(ctx context.Context, ack *AlarmAck, resolveTimeout time.Duration) error
+	UnackAlarm(ctx context.Context, fingerprint string) error
+	ListAcks(ctx context.Context) ([]*AlarmAck, error)
+	// TouchAck 指纹已被确认时刷新其恢复时间并返回true
+	TouchAck(ctx context.Context, fingerprint string, resolveTimeout time.Duration) (bool, error)
+}
+
+// AlarmAckResolveTimeout ack的恢复时长，默认1h
+func AlarmAckResolveTimeout(config *conf.Alarm) time.Duration {
+	if timeout := config.GetAckResolveTimeout().AsDuration(); timeout > 0 {
+		return timeout
+	}
+	return time.Hour
+}
+
+// CreateSilence 创建静默规则，StartsAt为空时立即生效
+func (a *AlarmAdmin) CreateSilence(ctx context.Context, silence *AlarmSilence) error {
+	now := time.Now()
+	if silence.StartsAt.IsZero() {
+		silence.StartsAt = now
+	}
+	if !silence.EndsAt.After(silence.StartsAt) || !silence.EndsAt.After(now) {
+		return ErrAlarmSilenceInvalid.WithCause(fmt.Errorf("ends_at must be after starts_at and now"))
+	}
+	if silence.TitlePattern == "" && silence.InfoPattern == "" && len(silence.Labels) == 0 {
+		return ErrAlarmSilenceInvalid.WithCause(fmt.Errorf("at least one matcher is required"))
+	}
+	if _, err := NewAlarmSilenceMatcher(silence); err != nil {
+		return ErrAlarmSilenceInvalid.WithCause(err)
+	}
+	return a.silenceRepo.CreateSilence(ctx, silence)
+}
+
+func (a *AlarmAdmin) ListSilences(ctx context.Context, includeExpired bool) ([]*AlarmSilence, error) {
+	return a.silenceRepo.ListSilences(ctx, includeExpired)
+}
+
+// ExpireSilence 立即结束静默规则
+func (a *AlarmAdmin) ExpireSilence(ctx context.Context, id int64) error {
+	return a.silenceRepo.ExpireSilence(ctx, id)
+}
+
+// AckAlarm 确认告警，同一指纹的告警在恢复前不再通知
+func (a *AlarmAdmin) AckAlarm(ctx context.Context, ack *AlarmAck) error {
+	ack.AckedAt = time.Now()
+	ack.TTL = AlarmAckResolveTimeout(a.config)
+	return a.silenceRepo.AckAlarm(ctx, ack, ack.TTL)
+}
+
+func (a *AlarmAdmin) UnackAlarm(ctx context.Context, fingerprint string) error {
+	return a.silenceRepo.UnackAlarm(ctx, fingerprint)
+}
+
+func (a *AlarmAdmin) ListAcks(ctx context.Context) ([]*AlarmAck, error) {
+	return a.silenceRepo.ListAcks(ctx)
+}
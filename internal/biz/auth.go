@@ -33,6 +33,7 @@ type IAuthRepo interface {
 }
 
 type UserLoginLog struct {
+	Id         int64 // 重放时为已有记录的ID
 	UserId     string
 	AuthType   string
 	LoginIp    string
@@ -46,6 +47,7 @@ type IAuthLogRepo interface {
 }
 
 type Auth struct {
+	tx          ITransaction
 	authRepo    IAuthRepo
 	authLogRepo IAuthLogRepo
 	geoIp       IGeoIp
@@ -56,12 +58,13 @@ type Auth struct {
 	config *conf.Auth
 }
 
-func NewAuth(config *conf.Auth, authRepo IAuthRepo, authLogRepo IAuthLogRepo, geoIp IGeoIp) *Auth {
+func NewAuth(config *conf.Auth, tx ITransaction, authRepo IAuthRepo, authLogRepo IAuthLogRepo, geoIp IGeoIp) *Auth {
 	privateKey, publicKey, err := web3.LoadEd25519Keys(config.JwtKey_25519, web3.Ed25519KeyPairEncodeHex)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to load keys: %v\n", err))
 	}
 	return &Auth{
+		tx:          tx,
 		authRepo:    authRepo,
 		authLogRepo: authLogRepo,
 		geoIp:       geoIp,
@@ -103,15 +106,13 @@ func (biz *Auth) LoginByWallet(ctx context.Context, blockchainType, originText,
 	if err != nil {
 		return nil, err
 	}
-	if userAuthInfo == nil {
+	newUser := userAuthInfo == nil
+	if newUser {
 		userAuthInfo = &model.UserAuthInfo{
 			UserID:   ksuid.New().String(),
 			AuthType: authType,
 			AuthInfo: address,
 		}
-		if err := biz.authRepo.SetUserAuthInfo(ctx, userAuthInfo); err != nil {
-			return nil, err
-		}
 	}
 	loginInfo := &LoginInfo{
 		UserInfo: &webkit.UserInfo{
@@ -132,8 +133,18 @@ func (biz *Auth) LoginByWallet(ctx context.Context, blockchainType, originText,
 		LoginTime:  loginTime,
 		IssueToken: cryptos.MD5EncodeStringToHex(loginInfo.Token),
 	}
-	if err := biz.authLogRepo.PublishUserLoginEvent(ctx, loginLog); err != nil {
-		log.Context(ctx).Errorf("Failed to publish user login event: %v", err)
+	// 用户信息与登录事件在同一事务中写入，事件由OutboxRelay在提交后投递
+	err = biz.tx.InTx(ctx, func(ctx context.Context) error {
+		if newUser {
+			if err := biz.authRepo.SetUserAuthInfo(ctx, userAuthInfo); err != nil {
+				return err
+			}
+		}
+		return biz.authLogRepo.PublishUserLoginEvent(ctx, loginLog)
+	})
+	if err != nil {
+		log.Context(ctx).Errorf("Failed to save user login: %v", err)
+		return nil, err
 	}
 	return loginInfo, nil
 }
@@ -255,6 +266,7 @@ func (biz *Auth) SaveUserLoginLog(ctx context.Context, userLoginLog *UserLoginLo
 		log.Context(ctx).Errorf("get country by ip: %s error: %v", userLoginLog.LoginIp, err)
 	}
 	return biz.authLogRepo.SaveUserLoginLog(ctx, &model.UserLoginLog{
+		ID:          userLoginLog.Id,
 		UserID:      userLoginLog.UserId,
 		AuthType:    userLoginLog.AuthType,
 		IssueToken:  userLoginLog.IssueToken,
@@ -5,5 +5,12 @@ import "github.com/google/wire"
 // ProviderSet is biz providers.
 var ProviderSet = wire.NewSet(
 	NewProbe,
+	NewAlarmAdmin,
+	NewTaskAdmin,
 	NewAuth,
+	NewOutboxRelay,
+	NewEventIdempotency,
+	NewEventReplayer,
+	NewWebhook,
+	NewJobHistory,
 )
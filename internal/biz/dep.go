@@ -7,9 +7,20 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// AlarmDetail 告警详情，请求上下文（operation、path、user、trace）由告警实现从ctx中补全
+type AlarmDetail struct {
+	Platform string
+	Title    string
+	Info     string
+	Panic    string // panic值
+	Stack    string // 裁剪后的调用栈
+	Error    string // 错误链
+}
+
 type IAlarmRepo interface {
 	SendBizMessage(ctx context.Context, title, info string)
 	SendMessage(ctx context.Context, platform, title, info string)
+	SendDetailMessage(ctx context.Context, detail *AlarmDetail)
 }
 
 type GeoCountry struct {
@@ -12,4 +12,21 @@ var (
 	ErrLoginTokenInvalid          = web.ErrorAuthLoginTokenInvalid("login token invalid")
 
 	ErrUserNotFound = web.ErrorUserNotFound("user not found")
+
+	ErrAlarmSilenceNotFound = web.ErrorAlarmSilenceNotFound("alarm silence not found")
+	ErrAlarmSilenceInvalid  = web.ErrorAlarmSilenceInvalid("invalid alarm silence")
+	ErrAlarmAckNotFound     = web.ErrorAlarmAckNotFound("alarm ack not found")
+
+	ErrEventReplayInvalid  = web.ErrorEventReplayInvalid("invalid event replay")
+	ErrEventReplayNotFound = web.ErrorEventReplayNotFound("event replay not found")
+
+	ErrTaskQueueNotFound = web.ErrorTaskQueueNotFound("task queue not found")
+	ErrTaskNotFound      = web.ErrorTaskNotFound("task not found")
+	ErrTaskActionInvalid = web.ErrorTaskActionInvalid("invalid task action")
+
+	ErrWebhookSubscriptionNotFound = web.ErrorWebhookSubscriptionNotFound("webhook subscription not found")
+	ErrWebhookSubscriptionInvalid  = web.ErrorWebhookSubscriptionInvalid("invalid webhook subscription")
+	ErrWebhookDeliveryNotFound     = web.ErrorWebhookDeliveryNotFound("webhook delivery not found")
+
+	ErrCrontabJobNotFound = web.ErrorCrontabJobNotFound("crontab job not found")
 )
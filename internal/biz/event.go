@@ -0,0 +1,141 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/internal/conf"
+)
+
+// EventHeaders 事件头，随事件一起投递
+type EventHeaders struct {
+	Traceparent   string `json:"traceparent,omitempty"`
+	Tracestate    string `json:"tracestate,omitempty"`
+	UserId        string `json:"user_id,omitempty"`
+	Source        string `json:"source,omitempty"`
+	SchemaVersion int32  `json:"schema_version,omitempty"`
+	CorrelationId string `json:"correlation_id,omitempty"`
+}
+
+type eventHeadersKey struct{}
+
+// NewEventHeadersContext 消费事件时保存事件头，处理中产生的事件沿用其关联ID
+func NewEventHeadersContext(ctx context.Context, headers *EventHeaders) context.Context {
+	return context.WithValue(ctx, eventHeadersKey{}, headers)
+}
+
+// EventHeadersFromContext 当前正在处理的事件头，不在事件处理中时返回nil
+func EventHeadersFromContext(ctx context.Context) *EventHeaders {
+	headers, _ := ctx.Value(eventHeadersKey{}).(*EventHeaders)
+	return headers
+}
+
+type dispatchHooksDisabledKey struct{}
+
+// NewDispatchHooksDisabledContext 处理事件时不调用dispatch hook，用于本地重放时不重复通知webhook等外部系统
+func NewDispatchHooksDisabledContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dispatchHooksDisabledKey{}, true)
+}
+
+// DispatchHooksDisabled 是否不调用dispatch hook
+func DispatchHooksDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(dispatchHooksDisabledKey{}).(bool)
+	return disabled
+}
+
+// 事件处理状态
+type EventProcessState int
+
+const (
+	// EventProcessClaimed 已取得处理权
+	EventProcessClaimed EventProcessState = iota
+	// EventProcessCompleted 事件已处理完成
+	EventProcessCompleted
+	// EventProcessInProgress 事件正在被其他实例处理
+	EventProcessInProgress
+)
+
+// ErrEventInProgress 事件正在被其他实例处理，稍后重试
+var ErrEventInProgress = errors.New("event is being processed")
+
+const (
+	defaultEventIdempotencyTTL   = 7 * 24 * time.Hour
+	defaultEventIdempotencyLease = 2 * time.Minute
+)
+
+// EventProcessRecord 事件的处理记录
+type EventProcessRecord struct {
+	EventId   string
+	Name      string
+	Status    string // processing/completed/failed
+	Error     string
+	Attempts  int
+	UpdatedAt time.Time
+}
+
+// IProcessedEventRepo 已处理事件的记录（由data层实现）
+//
+//go:generate mockgen -source=event.go -destination=./mocks/event.go -package=mocks
+type IProcessedEventRepo interface {
+	// Claim 取得事件的处理权，lease内未完成视为处理者已退出；处理失败的事件可重新取得
+	Claim(ctx context.Context, eventId, name string, lease time.Duration) (EventProcessState, error)
+	// Complete 记录事件处理完成，ttl内重复的事件直接跳过
+	Complete(ctx context.Context, eventId string, ttl time.Duration) error
+	// Fail 记录事件处理失败并释放处理权
+	Fail(ctx context.Context, eventId string, cause error, ttl time.Duration) error
+	Get(ctx context.Context, eventId string) (*EventProcessRecord, error)
+}
+
+// EventIdempotency 按事件ID保证处理器只成功执行一次
+type EventIdempotency struct {
+	config *conf.Data_EventIdempotency
+	repo   IProcessedEventRepo
+}
+
+func NewEventIdempotency(config *conf.Data, repo IProcessedEventRepo) *EventIdempotency {
+	return &EventIdempotency{config: config.GetEventIdempotency(), repo: repo}
+}
+
+func (i *EventIdempotency) ttl() time.Duration {
+	if ttl := i.config.GetTtl().AsDuration(); ttl > 0 {
+		return ttl
+	}
+	return defaultEventIdempotencyTTL
+}
+
+func (i *EventIdempotency) lease() time.Duration {
+	if lease := i.config.GetLease().AsDuration(); lease > 0 {
+		return lease
+	}
+	return defaultEventIdempotencyLease
+}
+
+// Run 事件未处理过时执行fn并记录结果；已完成的事件直接返回nil，正在处理的事件返回 ErrEventInProgress
+func (i *EventIdempotency) Run(ctx context.Context, eventId, name string, fn func(ctx context.Context) error) error {
+	state, err := i.repo.Claim(ctx, eventId, name, i.lease())
+	if err != nil {
+		return err
+	}
+	switch state {
+	case EventProcessCompleted:
+		log.Context(ctx).Infof("Event %s(%s) already processed, skipped", name, eventId)
+		return nil
+	case EventProcessInProgress:
+		return fmt.Errorf("%w: %s(%s)", ErrEventInProgress, name, eventId)
+	}
+
+	if err = fn(ctx); err != nil {
+		if failErr := i.repo.Fail(ctx, eventId, err, i.ttl()); failErr != nil {
+			log.Context(ctx).Errorf("Record event %s(%s) failure: %v", name, eventId, failErr)
+		}
+		return err
+	}
+	if err = i.repo.Complete(ctx, eventId, i.ttl()); err != nil {
+		// 处理已成功，记录失败时重复投递依赖lease过期后重新处理
+		log.Context(ctx).Errorf("Record event %s(%s) completion: %v", name, eventId, err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/api/event"
+	"github.com/seanbit/kratos/template/api/web"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 事件总线实现
+const (
+	EventBusDriverAsynq = "asynq"
+	EventBusDriverNats  = "nats"
+	EventBusDriverKafka = "kafka"
+)
+
+// ErrUnhandledEvent 没有注册处理器的事件，由传输层转入归档/死信而不是重试
+var ErrUnhandledEvent = web.ErrorEventUnhandled("unhandled event")
+
+// ErrInvalidEventPayload 事件payload无法解码，重试不会成功
+var ErrInvalidEventPayload = web.ErrorEventInvalidPayload("invalid event payload")
+
+// IsPermanentEventError 重试不会成功的事件处理错误
+func IsPermanentEventError(err error) bool {
+	return errors.Is(err, ErrUnhandledEvent) || errors.Is(err, ErrInvalidEventPayload)
+}
+
+// Event 事件总线上传递的事件
+type Event struct {
+	Id        string
+	Name      string // payload的proto全名
+	Key       string // 排序键，同一Key的事件按发布顺序投递（asynq不保证）
+	Payload   []byte
+	Headers   *EventHeaders
+	Timestamp time.Time
+}
+
+// NewEventEnvelope 转换为 event.Event 信封，事件在总线上传递及分发给处理器时使用
+func NewEventEnvelope(e *Event) *event.Event {
+	envelope := &event.Event{
+		Id:        e.Id,
+		Name:      e.Name,
+		Payload:   e.Payload,
+		Timestamp: timestamppb.New(e.Timestamp),
+	}
+	if headers := e.Headers; headers != nil {
+		envelope.Headers = &event.Headers{
+			Traceparent:   headers.Traceparent,
+			Tracestate:    headers.Tracestate,
+			UserId:        headers.UserId,
+			Source:        headers.Source,
+			SchemaVersion: headers.SchemaVersion,
+			CorrelationId: headers.CorrelationId,
+		}
+	}
+	return envelope
+}
+
+// FromEventEnvelope 将 event.Event 信封转换为事件，没有时间戳或事件头时对应字段为零值
+func FromEventEnvelope(envelope *event.Event) *Event {
+	e := &Event{
+		Id:      envelope.GetId(),
+		Name:    envelope.GetName(),
+		Payload: envelope.GetPayload(),
+	}
+	if envelope.GetTimestamp() != nil {
+		e.Timestamp = envelope.GetTimestamp().AsTime()
+	}
+	if headers := envelope.GetHeaders(); headers != nil {
+		e.Headers = &EventHeaders{
+			Traceparent:   headers.GetTraceparent(),
+			Tracestate:    headers.GetTracestate(),
+			UserId:        headers.GetUserId(),
+			Source:        headers.GetSource(),
+			SchemaVersion: headers.GetSchemaVersion(),
+			CorrelationId: headers.GetCorrelationId(),
+		}
+	}
+	return e
+}
+
+// EventHandler 订阅者的事件处理函数，返回错误时按实现重试
+type EventHandler func(ctx context.Context, e *Event) error
+
+// IEventPublisher 发布事件（由data层实现），重复发布同一事件ID需幂等
+//
+//go:generate mockgen -source=event_bus.go -destination=./mocks/event_bus.go -package=mocks
+type IEventPublisher interface {
+	Publish(ctx context.Context, e *Event) error
+}
+
+// IEventBus 事件总线（由data层实现）
+type IEventBus interface {
+	IEventPublisher
+	// Subscribe 以group订阅names中的事件，阻塞至ctx结束；
+	// 同一group的订阅者分摊事件，不同group各自收到全部事件
+	Subscribe(ctx context.Context, group string, names []string, handler EventHandler) error
+	Driver() string
+}
+
+// IEventScheduler 定时/延迟发布事件（由data层实现）：asynq使用ProcessAt，其他总线由Redis延迟队列到期后发布
+type IEventScheduler interface {
+	// ScheduleAt 在at时发布事件；key唯一标识一次调度，同一key尚未发布时重复调度会被合并，返回false
+	ScheduleAt(ctx context.Context, key string, e *Event, at time.Time) (bool, error)
+	// ScheduleIn 在delay后发布事件，同ScheduleAt
+	ScheduleIn(ctx context.Context, key string, e *Event, delay time.Duration) (bool, error)
+	// Cancel 取消尚未发布的调度，不存在时返回false
+	Cancel(ctx context.Context, key string) (bool, error)
+}
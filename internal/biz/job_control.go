@@ -0,0 +1,23 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// JobPause 任务暂停状态
+type JobPause struct {
+	Operator string
+	PausedAt time.Time
+}
+
+// IJobControlRepo 定时任务的集群级暂停标记（由data层实现）
+//
+//go:generate mockgen -source=job_control.go -destination=./mocks/job_control.go -package=mocks
+type IJobControlRepo interface {
+	Pause(ctx context.Context, name string, pause *JobPause) error
+	Resume(ctx context.Context, name string) error
+	IsPaused(ctx context.Context, name string) (bool, error)
+	// ListPaused 所有已暂停的任务
+	ListPaused(ctx context.Context) (map[string]*JobPause, error)
+}
@@ -0,0 +1,23 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// IJobLockRepo 定时任务的分布式锁（由data层实现）
+//
+// 每次加锁返回单调递增的fencing token，锁过期后被其他实例取得时token更大，
+// 任务写外部资源时可携带token，由资源方拒绝较旧token的写入
+//
+//go:generate mockgen -source=job_lock.go -destination=./mocks/job_lock.go -package=mocks
+type IJobLockRepo interface {
+	// Acquire 加锁，已被其他实例持有时ok为false
+	Acquire(ctx context.Context, key string, ttl time.Duration) (token int64, ok bool, err error)
+	// Renew 续期，锁已过期或被其他实例取得时返回false
+	Renew(ctx context.Context, key string, token int64, ttl time.Duration) (bool, error)
+	// Release 释放，只释放token对应的锁
+	Release(ctx context.Context, key string, token int64) error
+	// Claim 一次性认领key，不生成fencing token，ttl后过期；已被认领时返回false
+	Claim(ctx context.Context, key string, ttl time.Duration) (bool, error)
+}
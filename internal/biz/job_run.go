@@ -0,0 +1,155 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 任务执行状态
+const (
+	JobRunRunning   = "running"
+	JobRunSucceeded = "succeeded"
+	JobRunFailed    = "failed"
+	// JobRunCancelled 锁丢失或服务停止导致任务被取消
+	JobRunCancelled = "cancelled"
+)
+
+const (
+	// defaultJobFailureAlarmThreshold 连续失败多少次后告警
+	defaultJobFailureAlarmThreshold = 3
+	// defaultJobRunRetention 执行记录的默认保留时长
+	defaultJobRunRetention = 30 * 24 * time.Hour
+	// jobRunCleanupBatchSize 每次清理的最大行数
+	jobRunCleanupBatchSize = 1000
+)
+
+// JobRun 一次任务执行记录
+type JobRun struct {
+	Id           int64
+	JobName      string
+	Shard        int
+	Shards       int
+	InstanceId   string
+	FencingToken int64
+	Status       string
+	Error        string
+	Duration     time.Duration
+	StartedAt    time.Time
+	FinishedAt   time.Time
+}
+
+// IJobRunRepo 任务执行记录（由data层实现）
+//
+//go:generate mockgen -source=job_run.go -destination=./mocks/job_run.go -package=mocks
+type IJobRunRepo interface {
+	Create(ctx context.Context, run *JobRun) error
+	Finish(ctx context.Context, run *JobRun) error
+	// ListRuns 按开始时间倒序分页，jobName为空时不过滤，page从1开始
+	ListRuns(ctx context.Context, jobName string, page, pageSize int) ([]*JobRun, int64, error)
+	// ListRecentStatuses 任务最近limit次成功或失败的执行状态（不含执行中和已取消），按开始时间倒序
+	ListRecentStatuses(ctx context.Context, jobName string, limit int) ([]string, error)
+	// DeleteStartedBefore 删除开始时间早于before的记录（包括实例退出后遗留的执行中记录），每次最多limit条，返回删除的数量
+	DeleteStartedBefore(ctx context.Context, before time.Time, limit int) (int64, error)
+}
+
+// JobHistory 记录任务执行并在连续失败时告警；记录失败只输出日志，不影响任务执行
+type JobHistory struct {
+	repo  IJobRunRepo
+	alarm IAlarmRepo
+}
+
+func NewJobHistory(repo IJobRunRepo, alarm IAlarmRepo) *JobHistory {
+	return &JobHistory{repo: repo, alarm: alarm}
+}
+
+// Start 记录任务开始执行
+func (h *JobHistory) Start(ctx context.Context, run *JobRun) {
+	run.Status = JobRunRunning
+	if run.StartedAt.IsZero() {
+		run.StartedAt = time.Now()
+	}
+	if err := h.repo.Create(ctx, run); err != nil {
+		log.Context(ctx).Errorf("record job %s run start failed: %v", run.JobName, err)
+	}
+}
+
+// Finish 记录执行结果，连续失败达到threshold次时告警一次（<=0时使用默认值3）
+func (h *JobHistory) Finish(ctx context.Context, run *JobRun, status string, runErr error, threshold int) {
+	run.Status = status
+	run.FinishedAt = time.Now()
+	run.Duration = run.FinishedAt.Sub(run.StartedAt)
+	if runErr != nil {
+		run.Error = runErr.Error()
+	}
+	if run.Id != 0 {
+		if err := h.repo.Finish(ctx, run); err != nil {
+			log.Context(ctx).Errorf("record job %s run finish failed: %v", run.JobName, err)
+		}
+	}
+	if status != JobRunFailed {
+		return
+	}
+	if threshold <= 0 {
+		threshold = defaultJobFailureAlarmThreshold
+	}
+	statuses, err := h.repo.ListRecentStatuses(ctx, run.JobName, threshold+1)
+	if err != nil {
+		log.Context(ctx).Errorf("list job %s recent runs failed: %v", run.JobName, err)
+		return
+	}
+	if consecutiveJobFailures(statuses) != threshold {
+		return
+	}
+	h.alarm.SendBizMessage(ctx, "crontab job failing",
+		fmt.Sprintf("crontab job %s failed %d times in a row, last error on %s: %s",
+			run.JobName, threshold, run.InstanceId, run.Error))
+}
+
+// ReportPanic 任务panic时输出错误日志（上报sentry）并告警
+func (h *JobHistory) ReportPanic(ctx context.Context, run *JobRun, recovered any, stack []byte) {
+	log.Context(ctx).Errorf("crontab job %s panic on %s: %v\n%s", run.JobName, run.InstanceId, recovered, stack)
+	h.alarm.SendBizMessage(ctx, "crontab job panic",
+		fmt.Sprintf("crontab job %s panic on %s: %v", run.JobName, run.InstanceId, recovered))
+}
+
+// Cleanup 删除超过保留时长的执行记录，返回删除的数量；retention<=0时使用默认值30天
+func (h *JobHistory) Cleanup(ctx context.Context, retention time.Duration) (int64, error) {
+	if retention <= 0 {
+		retention = defaultJobRunRetention
+	}
+	before := time.Now().Add(-retention)
+	var total int64
+	for {
+		deleted, err := h.repo.DeleteStartedBefore(ctx, before, jobRunCleanupBatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+		if deleted < jobRunCleanupBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// consecutiveJobFailures 从最近一次开始连续失败的次数
+func consecutiveJobFailures(statuses []string) int {
+	for i, status := range statuses {
+		if status != JobRunFailed {
+			return i
+		}
+	}
+	return len(statuses)
+}
+
+func (h *JobHistory) ListRuns(ctx context.Context, jobName string, page, pageSize int) ([]*JobRun, int64, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultTaskPageSize
+	}
+	return h.repo.ListRuns(ctx, jobName, page, min(pageSize, maxTaskPageSize))
+}
@@ -0,0 +1,198 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: alarm.go
+//
+// Generated by this command:
+//
+//	mockgen -source=alarm.go -destination=./mocks/alarm.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	biz "github.com/seanbit/kratos/template/internal/biz"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAlarmInspectRepo is a mock of IAlarmInspectRepo interface.
+type MockIAlarmInspectRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAlarmInspectRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockIAlarmInspectRepoMockRecorder is the mock recorder for MockIAlarmInspectRepo.
+type MockIAlarmInspectRepoMockRecorder struct {
+	mock *MockIAlarmInspectRepo
+}
+
+// NewMockIAlarmInspectRepo creates a new mock instance.
+func NewMockIAlarmInspectRepo(ctrl *gomock.Controller) *MockIAlarmInspectRepo {
+	mock := &MockIAlarmInspectRepo{ctrl: ctrl}
+	mock.recorder = &MockIAlarmInspectRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAlarmInspectRepo) EXPECT() *MockIAlarmInspectRepoMockRecorder {
+	return m.recorder
+}
+
+// GetQueueStats mocks base method.
+func (m *MockIAlarmInspectRepo) GetQueueStats(ctx context.Context, delayedLimit int) (*biz.AlarmQueueStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueueStats", ctx, delayedLimit)
+	ret0, _ := ret[0].(*biz.AlarmQueueStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueueStats indicates an expected call of GetQueueStats.
+func (mr *MockIAlarmInspectRepoMockRecorder) GetQueueStats(ctx, delayedLimit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueStats", reflect.TypeOf((*MockIAlarmInspectRepo)(nil).GetQueueStats), ctx, delayedLimit)
+}
+
+// MockIAlarmSilenceRepo is a mock of IAlarmSilenceRepo interface.
+type MockIAlarmSilenceRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAlarmSilenceRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockIAlarmSilenceRepoMockRecorder is the mock recorder for MockIAlarmSilenceRepo.
+type MockIAlarmSilenceRepoMockRecorder struct {
+	mock *MockIAlarmSilenceRepo
+}
+
+// NewMockIAlarmSilenceRepo creates a new mock instance.
+func NewMockIAlarmSilenceRepo(ctrl *gomock.Controller) *MockIAlarmSilenceRepo {
+	mock := &MockIAlarmSilenceRepo{ctrl: ctrl}
+	mock.recorder = &MockIAlarmSilenceRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAlarmSilenceRepo) EXPECT() *MockIAlarmSilenceRepoMockRecorder {
+	return m.recorder
+}
+
+// AckAlarm mocks base method.
+func (m *MockIAlarmSilenceRepo) AckAlarm(ctx context.Context, ack *biz.AlarmAck, resolveTimeout time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AckAlarm", ctx, ack, resolveTimeout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AckAlarm indicates an expected call of AckAlarm.
+func (mr *MockIAlarmSilenceRepoMockRecorder) AckAlarm(ctx, ack, resolveTimeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AckAlarm", reflect.TypeOf((*MockIAlarmSilenceRepo)(nil).AckAlarm), ctx, ack, resolveTimeout)
+}
+
+// CreateSilence mocks base method.
+func (m *MockIAlarmSilenceRepo) CreateSilence(ctx context.Context, silence *biz.AlarmSilence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSilence", ctx, silence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSilence indicates an expected call of CreateSilence.
+func (mr *MockIAlarmSilenceRepoMockRecorder) CreateSilence(ctx, silence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSilence", reflect.TypeOf((*MockIAlarmSilenceRepo)(nil).CreateSilence), ctx, silence)
+}
+
+// ExpireSilence mocks base method.
+func (m *MockIAlarmSilenceRepo) ExpireSilence(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireSilence", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireSilence indicates an expected call of ExpireSilence.
+func (mr *MockIAlarmSilenceRepoMockRecorder) ExpireSilence(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireSilence", reflect.TypeOf((*MockIAlarmSilenceRepo)(nil).ExpireSilence), ctx, id)
+}
+
+// ListAcks mocks base method.
+func (m *MockIAlarmSilenceRepo) ListAcks(ctx context.Context) ([]*biz.AlarmAck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAcks", ctx)
+	ret0, _ := ret[0].([]*biz.AlarmAck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAcks indicates an expected call of ListAcks.
+func (mr *MockIAlarmSilenceRepoMockRecorder) ListAcks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAcks", reflect.TypeOf((*MockIAlarmSilenceRepo)(nil).ListAcks), ctx)
+}
+
+// ListSilences mocks base method.
+func (m *MockIAlarmSilenceRepo) ListSilences(ctx context.Context, includeExpired bool) ([]*biz.AlarmSilence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSilences", ctx, includeExpired)
+	ret0, _ := ret[0].([]*biz.AlarmSilence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSilences indicates an expected call of ListSilences.
+func (mr *MockIAlarmSilenceRepoMockRecorder) ListSilences(ctx, includeExpired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSilences", reflect.TypeOf((*MockIAlarmSilenceRepo)(nil).ListSilences), ctx, includeExpired)
+}
+
+// MatchSilence mocks base method.
+func (m *MockIAlarmSilenceRepo) MatchSilence(ctx context.Context, title, info string, labels map[string]string) (*biz.AlarmSilence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MatchSilence", ctx, title, info, labels)
+	ret0, _ := ret[0].(*biz.AlarmSilence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MatchSilence indicates an expected call of MatchSilence.
+func (mr *MockIAlarmSilenceRepoMockRecorder) MatchSilence(ctx, title, info, labels any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchSilence", reflect.TypeOf((*MockIAlarmSilenceRepo)(nil).MatchSilence), ctx, title, info, labels)
+}
+
+// TouchAck mocks base method.
+func (m *MockIAlarmSilenceRepo) TouchAck(ctx context.Context, fingerprint string, resolveTimeout time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAck", ctx, fingerprint, resolveTimeout)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TouchAck indicates an expected call of TouchAck.
+func (mr *MockIAlarmSilenceRepoMockRecorder) TouchAck(ctx, fingerprint, resolveTimeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAck", reflect.TypeOf((*MockIAlarmSilenceRepo)(nil).TouchAck), ctx, fingerprint, resolveTimeout)
+}
+
+// UnackAlarm mocks base method.
+func (m *MockIAlarmSilenceRepo) UnackAlarm(ctx context.Context, fingerprint string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnackAlarm", ctx, fingerprint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnackAlarm indicates an expected call of UnackAlarm.
+func (mr *MockIAlarmSilenceRepoMockRecorder) UnackAlarm(ctx, fingerprint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnackAlarm", reflect.TypeOf((*MockIAlarmSilenceRepo)(nil).UnackAlarm), ctx, fingerprint)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: event.go
+//
+// Generated by this command:
+//
+//	mockgen -source=event.go -destination=./mocks/event.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	biz "github.com/seanbit/kratos/template/internal/biz"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProcessedEventRepo is a mock of IProcessedEventRepo interface.
+type MockIProcessedEventRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProcessedEventRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockIProcessedEventRepoMockRecorder is the mock recorder for MockIProcessedEventRepo.
+type MockIProcessedEventRepoMockRecorder struct {
+	mock *MockIProcessedEventRepo
+}
+
+// NewMockIProcessedEventRepo creates a new mock instance.
+func NewMockIProcessedEventRepo(ctrl *gomock.Controller) *MockIProcessedEventRepo {
+	mock := &MockIProcessedEventRepo{ctrl: ctrl}
+	mock.recorder = &MockIProcessedEventRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProcessedEventRepo) EXPECT() *MockIProcessedEventRepoMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockIProcessedEventRepo) Claim(ctx context.Context, eventId, name string, lease time.Duration) (biz.EventProcessState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, eventId, name, lease)
+	ret0, _ := ret[0].(biz.EventProcessState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockIProcessedEventRepoMockRecorder) Claim(ctx, eventId, name, lease any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockIProcessedEventRepo)(nil).Claim), ctx, eventId, name, lease)
+}
+
+// Complete mocks base method.
+func (m *MockIProcessedEventRepo) Complete(ctx context.Context, eventId string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, eventId, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIProcessedEventRepoMockRecorder) Complete(ctx, eventId, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIProcessedEventRepo)(nil).Complete), ctx, eventId, ttl)
+}
+
+// Fail mocks base method.
+func (m *MockIProcessedEventRepo) Fail(ctx context.Context, eventId string, cause error, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fail", ctx, eventId, cause, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Fail indicates an expected call of Fail.
+func (mr *MockIProcessedEventRepoMockRecorder) Fail(ctx, eventId, cause, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fail", reflect.TypeOf((*MockIProcessedEventRepo)(nil).Fail), ctx, eventId, cause, ttl)
+}
+
+// Get mocks base method.
+func (m *MockIProcessedEventRepo) Get(ctx context.Context, eventId string) (*biz.EventProcessRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, eventId)
+	ret0, _ := ret[0].(*biz.EventProcessRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIProcessedEventRepoMockRecorder) Get(ctx, eventId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIProcessedEventRepo)(nil).Get), ctx, eventId)
+}
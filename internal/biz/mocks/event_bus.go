@@ -0,0 +1,192 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: event_bus.go
+//
+// Generated by this command:
+//
+//	mockgen -source=event_bus.go -destination=./mocks/event_bus.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	biz "github.com/seanbit/kratos/template/internal/biz"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIEventPublisher is a mock of IEventPublisher interface.
+type MockIEventPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEventPublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockIEventPublisherMockRecorder is the mock recorder for MockIEventPublisher.
+type MockIEventPublisherMockRecorder struct {
+	mock *MockIEventPublisher
+}
+
+// NewMockIEventPublisher creates a new mock instance.
+func NewMockIEventPublisher(ctrl *gomock.Controller) *MockIEventPublisher {
+	mock := &MockIEventPublisher{ctrl: ctrl}
+	mock.recorder = &MockIEventPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEventPublisher) EXPECT() *MockIEventPublisherMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockIEventPublisher) Publish(ctx context.Context, e *biz.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockIEventPublisherMockRecorder) Publish(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockIEventPublisher)(nil).Publish), ctx, e)
+}
+
+// MockIEventBus is a mock of IEventBus interface.
+type MockIEventBus struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEventBusMockRecorder
+	isgomock struct{}
+}
+
+// MockIEventBusMockRecorder is the mock recorder for MockIEventBus.
+type MockIEventBusMockRecorder struct {
+	mock *MockIEventBus
+}
+
+// NewMockIEventBus creates a new mock instance.
+func NewMockIEventBus(ctrl *gomock.Controller) *MockIEventBus {
+	mock := &MockIEventBus{ctrl: ctrl}
+	mock.recorder = &MockIEventBusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEventBus) EXPECT() *MockIEventBusMockRecorder {
+	return m.recorder
+}
+
+// Driver mocks base method.
+func (m *MockIEventBus) Driver() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Driver")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Driver indicates an expected call of Driver.
+func (mr *MockIEventBusMockRecorder) Driver() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Driver", reflect.TypeOf((*MockIEventBus)(nil).Driver))
+}
+
+// Publish mocks base method.
+func (m *MockIEventBus) Publish(ctx context.Context, e *biz.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockIEventBusMockRecorder) Publish(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockIEventBus)(nil).Publish), ctx, e)
+}
+
+// Subscribe mocks base method.
+func (m *MockIEventBus) Subscribe(ctx context.Context, group string, names []string, handler biz.EventHandler) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, group, names, handler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockIEventBusMockRecorder) Subscribe(ctx, group, names, handler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockIEventBus)(nil).Subscribe), ctx, group, names, handler)
+}
+
+// MockIEventScheduler is a mock of IEventScheduler interface.
+type MockIEventScheduler struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEventSchedulerMockRecorder
+	isgomock struct{}
+}
+
+// MockIEventSchedulerMockRecorder is the mock recorder for MockIEventScheduler.
+type MockIEventSchedulerMockRecorder struct {
+	mock *MockIEventScheduler
+}
+
+// NewMockIEventScheduler creates a new mock instance.
+func NewMockIEventScheduler(ctrl *gomock.Controller) *MockIEventScheduler {
+	mock := &MockIEventScheduler{ctrl: ctrl}
+	mock.recorder = &MockIEventSchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEventScheduler) EXPECT() *MockIEventSchedulerMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockIEventScheduler) Cancel(ctx context.Context, key string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockIEventSchedulerMockRecorder) Cancel(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockIEventScheduler)(nil).Cancel), ctx, key)
+}
+
+// ScheduleAt mocks base method.
+func (m *MockIEventScheduler) ScheduleAt(ctx context.Context, key string, e *biz.Event, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleAt", ctx, key, e, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleAt indicates an expected call of ScheduleAt.
+func (mr *MockIEventSchedulerMockRecorder) ScheduleAt(ctx, key, e, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleAt", reflect.TypeOf((*MockIEventScheduler)(nil).ScheduleAt), ctx, key, e, at)
+}
+
+// ScheduleIn mocks base method.
+func (m *MockIEventScheduler) ScheduleIn(ctx context.Context, key string, e *biz.Event, delay time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleIn", ctx, key, e, delay)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleIn indicates an expected call of ScheduleIn.
+func (mr *MockIEventSchedulerMockRecorder) ScheduleIn(ctx, key, e, delay any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleIn", reflect.TypeOf((*MockIEventScheduler)(nil).ScheduleIn), ctx, key, e, delay)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: job_control.go
+//
+// Generated by this command:
+//
+//	mockgen -source=job_control.go -destination=./mocks/job_control.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	biz "github.com/seanbit/kratos/template/internal/biz"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIJobControlRepo is a mock of IJobControlRepo interface.
+type MockIJobControlRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIJobControlRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockIJobControlRepoMockRecorder is the mock recorder for MockIJobControlRepo.
+type MockIJobControlRepoMockRecorder struct {
+	mock *MockIJobControlRepo
+}
+
+// NewMockIJobControlRepo creates a new mock instance.
+func NewMockIJobControlRepo(ctrl *gomock.Controller) *MockIJobControlRepo {
+	mock := &MockIJobControlRepo{ctrl: ctrl}
+	mock.recorder = &MockIJobControlRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIJobControlRepo) EXPECT() *MockIJobControlRepoMockRecorder {
+	return m.recorder
+}
+
+// IsPaused mocks base method.
+func (m *MockIJobControlRepo) IsPaused(ctx context.Context, name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPaused", ctx, name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPaused indicates an expected call of IsPaused.
+func (mr *MockIJobControlRepoMockRecorder) IsPaused(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPaused", reflect.TypeOf((*MockIJobControlRepo)(nil).IsPaused), ctx, name)
+}
+
+// ListPaused mocks base method.
+func (m *MockIJobControlRepo) ListPaused(ctx context.Context) (map[string]*biz.JobPause, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaused", ctx)
+	ret0, _ := ret[0].(map[string]*biz.JobPause)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaused indicates an expected call of ListPaused.
+func (mr *MockIJobControlRepoMockRecorder) ListPaused(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaused", reflect.TypeOf((*MockIJobControlRepo)(nil).ListPaused), ctx)
+}
+
+// Pause mocks base method.
+func (m *MockIJobControlRepo) Pause(ctx context.Context, name string, pause *biz.JobPause) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", ctx, name, pause)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockIJobControlRepoMockRecorder) Pause(ctx, name, pause any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockIJobControlRepo)(nil).Pause), ctx, name, pause)
+}
+
+// Resume mocks base method.
+func (m *MockIJobControlRepo) Resume(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockIJobControlRepoMockRecorder) Resume(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockIJobControlRepo)(nil).Resume), ctx, name)
+}
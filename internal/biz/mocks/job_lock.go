@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: job_lock.go
+//
+// Generated by this command:
+//
+//	mockgen -source=job_lock.go -destination=./mocks/job_lock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIJobLockRepo is a mock of IJobLockRepo interface.
+type MockIJobLockRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIJobLockRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockIJobLockRepoMockRecorder is the mock recorder for MockIJobLockRepo.
+type MockIJobLockRepoMockRecorder struct {
+	mock *MockIJobLockRepo
+}
+
+// NewMockIJobLockRepo creates a new mock instance.
+func NewMockIJobLockRepo(ctrl *gomock.Controller) *MockIJobLockRepo {
+	mock := &MockIJobLockRepo{ctrl: ctrl}
+	mock.recorder = &MockIJobLockRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIJobLockRepo) EXPECT() *MockIJobLockRepoMockRecorder {
+	return m.recorder
+}
+
+// Acquire mocks base method.
+func (m *MockIJobLockRepo) Acquire(ctx context.Context, key string, ttl time.Duration) (int64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Acquire", ctx, key, ttl)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Acquire indicates an expected call of Acquire.
+func (mr *MockIJobLockRepoMockRecorder) Acquire(ctx, key, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Acquire", reflect.TypeOf((*MockIJobLockRepo)(nil).Acquire), ctx, key, ttl)
+}
+
+// Claim mocks base method.
+func (m *MockIJobLockRepo) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, key, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockIJobLockRepoMockRecorder) Claim(ctx, key, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockIJobLockRepo)(nil).Claim), ctx, key, ttl)
+}
+
+// Release mocks base method.
+func (m *MockIJobLockRepo) Release(ctx context.Context, key string, token int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, key, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIJobLockRepoMockRecorder) Release(ctx, key, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIJobLockRepo)(nil).Release), ctx, key, token)
+}
+
+// Renew mocks base method.
+func (m *MockIJobLockRepo) Renew(ctx context.Context, key string, token int64, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Renew", ctx, key, token, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Renew indicates an expected call of Renew.
+func (mr *MockIJobLockRepoMockRecorder) Renew(ctx, key, token, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Renew", reflect.TypeOf((*MockIJobLockRepo)(nil).Renew), ctx, key, token, ttl)
+}
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: job_run.go
+//
+// Generated by this command:
+//
+//	mockgen -source=job_run.go -destination=./mocks/job_run.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	biz "github.com/seanbit/kratos/template/internal/biz"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIJobRunRepo is a mock of IJobRunRepo interface.
+type MockIJobRunRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIJobRunRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockIJobRunRepoMockRecorder is the mock recorder for MockIJobRunRepo.
+type MockIJobRunRepoMockRecorder struct {
+	mock *MockIJobRunRepo
+}
+
+// NewMockIJobRunRepo creates a new mock instance.
+func NewMockIJobRunRepo(ctrl *gomock.Controller) *MockIJobRunRepo {
+	mock := &MockIJobRunRepo{ctrl: ctrl}
+	mock.recorder = &MockIJobRunRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIJobRunRepo) EXPECT() *MockIJobRunRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIJobRunRepo) Create(ctx context.Context, run *biz.JobRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIJobRunRepoMockRecorder) Create(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIJobRunRepo)(nil).Create), ctx, run)
+}
+
+// DeleteStartedBefore mocks base method.
+func (m *MockIJobRunRepo) DeleteStartedBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStartedBefore", ctx, before, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStartedBefore indicates an expected call of DeleteStartedBefore.
+func (mr *MockIJobRunRepoMockRecorder) DeleteStartedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStartedBefore", reflect.TypeOf((*MockIJobRunRepo)(nil).DeleteStartedBefore), ctx, before, limit)
+}
+
+// Finish mocks base method.
+func (m *MockIJobRunRepo) Finish(ctx context.Context, run *biz.JobRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finish", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Finish indicates an expected call of Finish.
+func (mr *MockIJobRunRepoMockRecorder) Finish(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finish", reflect.TypeOf((*MockIJobRunRepo)(nil).Finish), ctx, run)
+}
+
+// ListRecentStatuses mocks base method.
+func (m *MockIJobRunRepo) ListRecentStatuses(ctx context.Context, jobName string, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecentStatuses", ctx, jobName, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecentStatuses indicates an expected call of ListRecentStatuses.
+func (mr *MockIJobRunRepoMockRecorder) ListRecentStatuses(ctx, jobName, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecentStatuses", reflect.TypeOf((*MockIJobRunRepo)(nil).ListRecentStatuses), ctx, jobName, limit)
+}
+
+// ListRuns mocks base method.
+func (m *MockIJobRunRepo) ListRuns(ctx context.Context, jobName string, page, pageSize int) ([]*biz.JobRun, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuns", ctx, jobName, page, pageSize)
+	ret0, _ := ret[0].([]*biz.JobRun)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRuns indicates an expected call of ListRuns.
+func (mr *MockIJobRunRepoMockRecorder) ListRuns(ctx, jobName, page, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuns", reflect.TypeOf((*MockIJobRunRepo)(nil).ListRuns), ctx, jobName, page, pageSize)
+}
@@ -53,6 +53,18 @@ func (mr *MockIAlarmRepoMockRecorder) SendBizMessage(ctx, title, info any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendBizMessage", reflect.TypeOf((*MockIAlarmRepo)(nil).SendBizMessage), ctx, title, info)
 }
 
+// SendDetailMessage mocks base method.
+func (m *MockIAlarmRepo) SendDetailMessage(ctx context.Context, detail *biz.AlarmDetail) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SendDetailMessage", ctx, detail)
+}
+
+// SendDetailMessage indicates an expected call of SendDetailMessage.
+func (mr *MockIAlarmRepoMockRecorder) SendDetailMessage(ctx, detail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDetailMessage", reflect.TypeOf((*MockIAlarmRepo)(nil).SendDetailMessage), ctx, detail)
+}
+
 // SendMessage mocks base method.
 func (m *MockIAlarmRepo) SendMessage(ctx context.Context, platform, title, info string) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,172 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: outbox.go
+//
+// Generated by this command:
+//
+//	mockgen -source=outbox.go -destination=./mocks/outbox.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	biz "github.com/seanbit/kratos/template/internal/biz"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITransaction is a mock of ITransaction interface.
+type MockITransaction struct {
+	ctrl     *gomock.Controller
+	recorder *MockITransactionMockRecorder
+	isgomock struct{}
+}
+
+// MockITransactionMockRecorder is the mock recorder for MockITransaction.
+type MockITransactionMockRecorder struct {
+	mock *MockITransaction
+}
+
+// NewMockITransaction creates a new mock instance.
+func NewMockITransaction(ctrl *gomock.Controller) *MockITransaction {
+	mock := &MockITransaction{ctrl: ctrl}
+	mock.recorder = &MockITransactionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITransaction) EXPECT() *MockITransactionMockRecorder {
+	return m.recorder
+}
+
+// InTx mocks base method.
+func (m *MockITransaction) InTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InTx indicates an expected call of InTx.
+func (mr *MockITransactionMockRecorder) InTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InTx", reflect.TypeOf((*MockITransaction)(nil).InTx), ctx, fn)
+}
+
+// MockIOutboxRepo is a mock of IOutboxRepo interface.
+type MockIOutboxRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOutboxRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockIOutboxRepoMockRecorder is the mock recorder for MockIOutboxRepo.
+type MockIOutboxRepoMockRecorder struct {
+	mock *MockIOutboxRepo
+}
+
+// NewMockIOutboxRepo creates a new mock instance.
+func NewMockIOutboxRepo(ctrl *gomock.Controller) *MockIOutboxRepo {
+	mock := &MockIOutboxRepo{ctrl: ctrl}
+	mock.recorder = &MockIOutboxRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOutboxRepo) EXPECT() *MockIOutboxRepoMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockIOutboxRepo) Add(ctx context.Context, events ...*biz.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Add", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockIOutboxRepoMockRecorder) Add(ctx any, events ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, events...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockIOutboxRepo)(nil).Add), varargs...)
+}
+
+// ClaimPending mocks base method.
+func (m *MockIOutboxRepo) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*biz.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPending", ctx, limit, lease)
+	ret0, _ := ret[0].([]*biz.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPending indicates an expected call of ClaimPending.
+func (mr *MockIOutboxRepoMockRecorder) ClaimPending(ctx, limit, lease any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPending", reflect.TypeOf((*MockIOutboxRepo)(nil).ClaimPending), ctx, limit, lease)
+}
+
+// DeletePublishedBefore mocks base method.
+func (m *MockIOutboxRepo) DeletePublishedBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePublishedBefore", ctx, before, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePublishedBefore indicates an expected call of DeletePublishedBefore.
+func (mr *MockIOutboxRepoMockRecorder) DeletePublishedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePublishedBefore", reflect.TypeOf((*MockIOutboxRepo)(nil).DeletePublishedBefore), ctx, before, limit)
+}
+
+// MarkFailed mocks base method.
+func (m *MockIOutboxRepo) MarkFailed(ctx context.Context, id int64, attempts int, lastErr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, id, attempts, lastErr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockIOutboxRepoMockRecorder) MarkFailed(ctx, id, attempts, lastErr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockIOutboxRepo)(nil).MarkFailed), ctx, id, attempts, lastErr)
+}
+
+// MarkPublished mocks base method.
+func (m *MockIOutboxRepo) MarkPublished(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPublished", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPublished indicates an expected call of MarkPublished.
+func (mr *MockIOutboxRepoMockRecorder) MarkPublished(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPublished", reflect.TypeOf((*MockIOutboxRepo)(nil).MarkPublished), ctx, id)
+}
+
+// MarkRetry mocks base method.
+func (m *MockIOutboxRepo) MarkRetry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, lastErr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRetry", ctx, id, attempts, nextAttemptAt, lastErr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRetry indicates an expected call of MarkRetry.
+func (mr *MockIOutboxRepoMockRecorder) MarkRetry(ctx, id, attempts, nextAttemptAt, lastErr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRetry", reflect.TypeOf((*MockIOutboxRepo)(nil).MarkRetry), ctx, id, attempts, nextAttemptAt, lastErr)
+}
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: replay.go
+//
+// Generated by this command:
+//
+//	mockgen -source=replay.go -destination=./mocks/replay.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	biz "github.com/seanbit/kratos/template/internal/biz"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIEventReplayRepo is a mock of IEventReplayRepo interface.
+type MockIEventReplayRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEventReplayRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockIEventReplayRepoMockRecorder is the mock recorder for MockIEventReplayRepo.
+type MockIEventReplayRepoMockRecorder struct {
+	mock *MockIEventReplayRepo
+}
+
+// NewMockIEventReplayRepo creates a new mock instance.
+func NewMockIEventReplayRepo(ctrl *gomock.Controller) *MockIEventReplayRepo {
+	mock := &MockIEventReplayRepo{ctrl: ctrl}
+	mock.recorder = &MockIEventReplayRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEventReplayRepo) EXPECT() *MockIEventReplayRepoMockRecorder {
+	return m.recorder
+}
+
+// ListEvents mocks base method.
+func (m *MockIEventReplayRepo) ListEvents(ctx context.Context, source, name string, from, to time.Time, cursor int64, limit int) ([]*biz.Event, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, source, name, from, to, cursor, limit)
+	ret0, _ := ret[0].([]*biz.Event)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockIEventReplayRepoMockRecorder) ListEvents(ctx, source, name, from, to, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockIEventReplayRepo)(nil).ListEvents), ctx, source, name, from, to, cursor, limit)
+}
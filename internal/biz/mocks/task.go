@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: task.go
+//
+// Generated by this command:
+//
+//	mockgen -source=task.go -destination=./mocks/task.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	biz "github.com/seanbit/kratos/template/internal/biz"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITaskInspectRepo is a mock of ITaskInspectRepo interface.
+type MockITaskInspectRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockITaskInspectRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockITaskInspectRepoMockRecorder is the mock recorder for MockITaskInspectRepo.
+type MockITaskInspectRepoMockRecorder struct {
+	mock *MockITaskInspectRepo
+}
+
+// NewMockITaskInspectRepo creates a new mock instance.
+func NewMockITaskInspectRepo(ctrl *gomock.Controller) *MockITaskInspectRepo {
+	mock := &MockITaskInspectRepo{ctrl: ctrl}
+	mock.recorder = &MockITaskInspectRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITaskInspectRepo) EXPECT() *MockITaskInspectRepoMockRecorder {
+	return m.recorder
+}
+
+// ApplyAction mocks base method.
+func (m *MockITaskInspectRepo) ApplyAction(ctx context.Context, action, queue, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyAction", ctx, action, queue, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyAction indicates an expected call of ApplyAction.
+func (mr *MockITaskInspectRepoMockRecorder) ApplyAction(ctx, action, queue, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyAction", reflect.TypeOf((*MockITaskInspectRepo)(nil).ApplyAction), ctx, action, queue, id)
+}
+
+// ApplyActionAll mocks base method.
+func (m *MockITaskInspectRepo) ApplyActionAll(ctx context.Context, action, queue, state string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyActionAll", ctx, action, queue, state)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyActionAll indicates an expected call of ApplyActionAll.
+func (mr *MockITaskInspectRepoMockRecorder) ApplyActionAll(ctx, action, queue, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyActionAll", reflect.TypeOf((*MockITaskInspectRepo)(nil).ApplyActionAll), ctx, action, queue, state)
+}
+
+// GetQueue mocks base method.
+func (m *MockITaskInspectRepo) GetQueue(ctx context.Context, queue string) (*biz.TaskQueueInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueue", ctx, queue)
+	ret0, _ := ret[0].(*biz.TaskQueueInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueue indicates an expected call of GetQueue.
+func (mr *MockITaskInspectRepoMockRecorder) GetQueue(ctx, queue any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueue", reflect.TypeOf((*MockITaskInspectRepo)(nil).GetQueue), ctx, queue)
+}
+
+// ListQueues mocks base method.
+func (m *MockITaskInspectRepo) ListQueues(ctx context.Context) ([]*biz.TaskQueueInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueues", ctx)
+	ret0, _ := ret[0].([]*biz.TaskQueueInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueues indicates an expected call of ListQueues.
+func (mr *MockITaskInspectRepoMockRecorder) ListQueues(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockITaskInspectRepo)(nil).ListQueues), ctx)
+}
+
+// ListTasks mocks base method.
+func (m *MockITaskInspectRepo) ListTasks(ctx context.Context, queue, state string, page, pageSize int) ([]*biz.TaskInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTasks", ctx, queue, state, page, pageSize)
+	ret0, _ := ret[0].([]*biz.TaskInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTasks indicates an expected call of ListTasks.
+func (mr *MockITaskInspectRepoMockRecorder) ListTasks(ctx, queue, state, page, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockITaskInspectRepo)(nil).ListTasks), ctx, queue, state, page, pageSize)
+}
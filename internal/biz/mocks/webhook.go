@@ -0,0 +1,291 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook.go
+//
+// Generated by this command:
+//
+//	mockgen -source=webhook.go -destination=./mocks/webhook.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	http "net/http"
+	reflect "reflect"
+	time "time"
+
+	biz "github.com/seanbit/kratos/template/internal/biz"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIWebhookRepo is a mock of IWebhookRepo interface.
+type MockIWebhookRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWebhookRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockIWebhookRepoMockRecorder is the mock recorder for MockIWebhookRepo.
+type MockIWebhookRepoMockRecorder struct {
+	mock *MockIWebhookRepo
+}
+
+// NewMockIWebhookRepo creates a new mock instance.
+func NewMockIWebhookRepo(ctrl *gomock.Controller) *MockIWebhookRepo {
+	mock := &MockIWebhookRepo{ctrl: ctrl}
+	mock.recorder = &MockIWebhookRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWebhookRepo) EXPECT() *MockIWebhookRepoMockRecorder {
+	return m.recorder
+}
+
+// AddDeliveries mocks base method.
+func (m *MockIWebhookRepo) AddDeliveries(ctx context.Context, deliveries ...*biz.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range deliveries {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddDeliveries", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDeliveries indicates an expected call of AddDeliveries.
+func (mr *MockIWebhookRepoMockRecorder) AddDeliveries(ctx any, deliveries ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, deliveries...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeliveries", reflect.TypeOf((*MockIWebhookRepo)(nil).AddDeliveries), varargs...)
+}
+
+// ClaimDue mocks base method.
+func (m *MockIWebhookRepo) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*biz.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, limit, lease)
+	ret0, _ := ret[0].([]*biz.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockIWebhookRepoMockRecorder) ClaimDue(ctx, limit, lease any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockIWebhookRepo)(nil).ClaimDue), ctx, limit, lease)
+}
+
+// CreateSubscription mocks base method.
+func (m *MockIWebhookRepo) CreateSubscription(ctx context.Context, subscription *biz.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockIWebhookRepoMockRecorder) CreateSubscription(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockIWebhookRepo)(nil).CreateSubscription), ctx, subscription)
+}
+
+// DeleteFinishedBefore mocks base method.
+func (m *MockIWebhookRepo) DeleteFinishedBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFinishedBefore", ctx, before, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFinishedBefore indicates an expected call of DeleteFinishedBefore.
+func (mr *MockIWebhookRepoMockRecorder) DeleteFinishedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFinishedBefore", reflect.TypeOf((*MockIWebhookRepo)(nil).DeleteFinishedBefore), ctx, before, limit)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockIWebhookRepo) DeleteSubscription(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockIWebhookRepoMockRecorder) DeleteSubscription(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockIWebhookRepo)(nil).DeleteSubscription), ctx, id)
+}
+
+// GetSubscription mocks base method.
+func (m *MockIWebhookRepo) GetSubscription(ctx context.Context, id int64) (*biz.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscription", ctx, id)
+	ret0, _ := ret[0].(*biz.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscription indicates an expected call of GetSubscription.
+func (mr *MockIWebhookRepoMockRecorder) GetSubscription(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockIWebhookRepo)(nil).GetSubscription), ctx, id)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockIWebhookRepo) ListDeliveries(ctx context.Context, subscriptionId int64, status string, page, pageSize int) ([]*biz.WebhookDelivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, subscriptionId, status, page, pageSize)
+	ret0, _ := ret[0].([]*biz.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockIWebhookRepoMockRecorder) ListDeliveries(ctx, subscriptionId, status, page, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockIWebhookRepo)(nil).ListDeliveries), ctx, subscriptionId, status, page, pageSize)
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockIWebhookRepo) ListSubscriptions(ctx context.Context) ([]*biz.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx)
+	ret0, _ := ret[0].([]*biz.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockIWebhookRepoMockRecorder) ListSubscriptions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockIWebhookRepo)(nil).ListSubscriptions), ctx)
+}
+
+// ListSubscriptionsByEvent mocks base method.
+func (m *MockIWebhookRepo) ListSubscriptionsByEvent(ctx context.Context, name string) ([]*biz.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptionsByEvent", ctx, name)
+	ret0, _ := ret[0].([]*biz.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptionsByEvent indicates an expected call of ListSubscriptionsByEvent.
+func (mr *MockIWebhookRepoMockRecorder) ListSubscriptionsByEvent(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptionsByEvent", reflect.TypeOf((*MockIWebhookRepo)(nil).ListSubscriptionsByEvent), ctx, name)
+}
+
+// MarkFailed mocks base method.
+func (m *MockIWebhookRepo) MarkFailed(ctx context.Context, id int64, attempts int, resp *biz.WebhookResponse, lastErr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, id, attempts, resp, lastErr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockIWebhookRepoMockRecorder) MarkFailed(ctx, id, attempts, resp, lastErr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockIWebhookRepo)(nil).MarkFailed), ctx, id, attempts, resp, lastErr)
+}
+
+// MarkRetry mocks base method.
+func (m *MockIWebhookRepo) MarkRetry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, resp *biz.WebhookResponse, lastErr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRetry", ctx, id, attempts, nextAttemptAt, resp, lastErr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRetry indicates an expected call of MarkRetry.
+func (mr *MockIWebhookRepoMockRecorder) MarkRetry(ctx, id, attempts, nextAttemptAt, resp, lastErr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRetry", reflect.TypeOf((*MockIWebhookRepo)(nil).MarkRetry), ctx, id, attempts, nextAttemptAt, resp, lastErr)
+}
+
+// MarkSucceeded mocks base method.
+func (m *MockIWebhookRepo) MarkSucceeded(ctx context.Context, id int64, attempts int, resp *biz.WebhookResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSucceeded", ctx, id, attempts, resp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSucceeded indicates an expected call of MarkSucceeded.
+func (mr *MockIWebhookRepoMockRecorder) MarkSucceeded(ctx, id, attempts, resp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSucceeded", reflect.TypeOf((*MockIWebhookRepo)(nil).MarkSucceeded), ctx, id, attempts, resp)
+}
+
+// ResetDelivery mocks base method.
+func (m *MockIWebhookRepo) ResetDelivery(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetDelivery", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetDelivery indicates an expected call of ResetDelivery.
+func (mr *MockIWebhookRepoMockRecorder) ResetDelivery(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetDelivery", reflect.TypeOf((*MockIWebhookRepo)(nil).ResetDelivery), ctx, id)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockIWebhookRepo) UpdateSubscription(ctx context.Context, subscription *biz.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockIWebhookRepoMockRecorder) UpdateSubscription(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockIWebhookRepo)(nil).UpdateSubscription), ctx, subscription)
+}
+
+// MockIWebhookSender is a mock of IWebhookSender interface.
+type MockIWebhookSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWebhookSenderMockRecorder
+	isgomock struct{}
+}
+
+// MockIWebhookSenderMockRecorder is the mock recorder for MockIWebhookSender.
+type MockIWebhookSenderMockRecorder struct {
+	mock *MockIWebhookSender
+}
+
+// NewMockIWebhookSender creates a new mock instance.
+func NewMockIWebhookSender(ctrl *gomock.Controller) *MockIWebhookSender {
+	mock := &MockIWebhookSender{ctrl: ctrl}
+	mock.recorder = &MockIWebhookSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWebhookSender) EXPECT() *MockIWebhookSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockIWebhookSender) Send(ctx context.Context, url string, header http.Header, body []byte, timeout time.Duration) (*biz.WebhookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, url, header, body, timeout)
+	ret0, _ := ret[0].(*biz.WebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockIWebhookSenderMockRecorder) Send(ctx, url, header, body, timeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockIWebhookSender)(nil).Send), ctx, url, header, body, timeout)
+}
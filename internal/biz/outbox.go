@@ -0,0 +1,164 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/internal/conf"
+)
+
+const (
+	defaultOutboxPollInterval = time.Second
+	defaultOutboxBatchSize    = 100
+	defaultOutboxMaxAttempts  = 20
+	defaultOutboxRetention    = 7 * 24 * time.Hour
+	// outboxMaxBackoff 投递失败后重试的最大间隔
+	outboxMaxBackoff = 5 * time.Minute
+	// outboxCleanupBatchSize 每次清理的最大行数
+	outboxCleanupBatchSize = 1000
+	// outboxClaimLease 取出的事件在lease内未标记结果时（如进程退出）可被重新取出
+	outboxClaimLease = time.Minute
+)
+
+// 聚合类型
+const (
+	AggregateTypeUser = "user"
+)
+
+// OutboxEvent 待投递的领域事件，与业务数据在同一事务中写入
+type OutboxEvent struct {
+	ID            int64
+	EventId       string
+	Name          string // 事件类型：payload的proto全名
+	AggregateType string // 聚合类型，同一聚合的事件按写入顺序投递
+	AggregateId   string
+	Payload       []byte
+	Headers       *EventHeaders
+	Attempts      int
+	CreatedAt     time.Time
+}
+
+// Event 转换为事件总线上的事件，以聚合作为排序键
+func (e *OutboxEvent) Event() *Event {
+	return &Event{
+		Id:        e.EventId,
+		Name:      e.Name,
+		Key:       e.AggregateType + ":" + e.AggregateId,
+		Payload:   e.Payload,
+		Headers:   e.Headers,
+		Timestamp: e.CreatedAt,
+	}
+}
+
+// ITransaction 数据库事务，fn中使用的repo通过ctx共享同一事务
+//
+//go:generate mockgen -source=outbox.go -destination=./mocks/outbox.go -package=mocks
+type ITransaction interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// IOutboxRepo outbox表（由data层实现）
+type IOutboxRepo interface {
+	Add(ctx context.Context, events ...*OutboxEvent) error
+	// ClaimPending 取出到期的待投递事件，每个聚合只返回最早的一条；取出时attempts加1并将下次投递时间推迟lease，
+	// 避免被其他实例重复取出，返回的Attempts为本次投递的次数
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*OutboxEvent, error)
+	MarkPublished(ctx context.Context, id int64) error
+	MarkRetry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, lastErr string) error
+	MarkFailed(ctx context.Context, id int64, attempts int, lastErr string) error
+	DeletePublishedBefore(ctx context.Context, before time.Time, limit int) (int64, error)
+}
+
+// OutboxRelay 将outbox中已提交的事件发布到事件总线
+type OutboxRelay struct {
+	config    *conf.Data_Outbox
+	repo      IOutboxRepo
+	publisher IEventPublisher
+	alarm     IAlarmRepo
+}
+
+func NewOutboxRelay(config *conf.Data, repo IOutboxRepo, publisher IEventPublisher, alarm IAlarmRepo) *OutboxRelay {
+	return &OutboxRelay{config: config.GetOutbox(), repo: repo, publisher: publisher, alarm: alarm}
+}
+
+func (r *OutboxRelay) PollInterval() time.Duration {
+	if interval := r.config.GetPollInterval().AsDuration(); interval > 0 {
+		return interval
+	}
+	return defaultOutboxPollInterval
+}
+
+func (r *OutboxRelay) BatchSize() int {
+	if size := r.config.GetBatchSize(); size > 0 {
+		return int(size)
+	}
+	return defaultOutboxBatchSize
+}
+
+func (r *OutboxRelay) maxAttempts() int {
+	if attempts := r.config.GetMaxAttempts(); attempts > 0 {
+		return int(attempts)
+	}
+	return defaultOutboxMaxAttempts
+}
+
+func (r *OutboxRelay) retention() time.Duration {
+	if retention := r.config.GetRetention().AsDuration(); retention > 0 {
+		return retention
+	}
+	return defaultOutboxRetention
+}
+
+// RelayOnce 投递一批到期的事件，返回本批处理的事件数量
+// 先取出事件再在事务外投递，多副本时不会重复投递；同一聚合的后续事件在前一条投递成功前不会被取出
+func (r *OutboxRelay) RelayOnce(ctx context.Context) (int, error) {
+	events, err := r.repo.ClaimPending(ctx, r.BatchSize(), outboxClaimLease)
+	if err != nil {
+		return 0, err
+	}
+	for i, event := range events {
+		if err = r.publish(ctx, event); err != nil {
+			return i, err
+		}
+	}
+	return len(events), nil
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, event *OutboxEvent) error {
+	pubErr := r.publisher.Publish(ctx, event.Event())
+	if pubErr == nil {
+		return r.repo.MarkPublished(ctx, event.ID)
+	}
+
+	attempts := event.Attempts
+	if attempts >= r.maxAttempts() {
+		log.Context(ctx).Errorf("Outbox event %s(%s) failed after %d attempts: %v", event.Name, event.EventId, attempts, pubErr)
+		r.alarm.SendBizMessage(ctx, "outbox event publish failed",
+			fmt.Sprintf("event %s(%s) of %s/%s failed after %d attempts: %v",
+				event.Name, event.EventId, event.AggregateType, event.AggregateId, attempts, pubErr))
+		return r.repo.MarkFailed(ctx, event.ID, attempts, pubErr.Error())
+	}
+	// 指数退避：1s, 2s, 4s ... 最大5分钟
+	backoff := min(time.Duration(1<<min(attempts-1, 16))*time.Second, outboxMaxBackoff)
+	log.Context(ctx).Warnf("Outbox event %s(%s) publish failed (attempt %d), retry after %s: %v",
+		event.Name, event.EventId, attempts, backoff, pubErr)
+	return r.repo.MarkRetry(ctx, event.ID, attempts, time.Now().Add(backoff), pubErr.Error())
+}
+
+// Cleanup 删除超过保留时长的已投递事件，返回删除的数量
+func (r *OutboxRelay) Cleanup(ctx context.Context) (int64, error) {
+	before := time.Now().Add(-r.retention())
+	var total int64
+	for {
+		deleted, err := r.repo.DeletePublishedBefore(ctx, before, outboxCleanupBatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+		if deleted < outboxCleanupBatchSize {
+			return total, nil
+		}
+	}
+}
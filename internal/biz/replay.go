@@ -0,0 +1,298 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/api/event"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
+)
+
+// 可重放的事件来源
+const (
+	// EventReplaySourceOutbox 已投递或投递失败的outbox事件（保留期内）
+	EventReplaySourceOutbox = "outbox"
+	// EventReplaySourceLoginLog 由user_login_log重建的登录事件
+	EventReplaySourceLoginLog = "login_log"
+)
+
+// userLoginEventName outbox中的 event.UserLogin 没有log_id，重放会重复写入登录记录，需从login_log重放
+var userLoginEventName = string(proto.MessageName(&event.UserLogin{}))
+
+// 重放目标
+const (
+	// EventReplayTargetBus 重新发布到事件总线，所有订阅组都会收到
+	EventReplayTargetBus = "bus"
+	// EventReplayTargetLocal 直接交给本服务的事件处理器
+	EventReplayTargetLocal = "local"
+)
+
+const (
+	defaultEventReplayBatchSize = 100
+	// eventReplaySampleSize dry-run时返回的样例事件数
+	eventReplaySampleSize = 10
+	// eventReplayKeep 已结束的重放任务在内存中保留的数量
+	eventReplayKeep = 50
+)
+
+// EventReplayRequest 重放[From, To)内名为Name的事件
+type EventReplayRequest struct {
+	Source    string
+	Name      string
+	Target    string
+	From      time.Time
+	To        time.Time
+	DryRun    bool    // 只统计和列出样例，不重放
+	Rate      float64 // 每秒最多重放的事件数，<=0时不限
+	BatchSize int     // 每批读取的事件数，默认100
+	Limit     int     // 最多重放的事件数，<=0时不限
+	// 重放到本服务时是否调用dispatch hook（如webhook通知），默认只执行处理器
+	NotifyWebhooks bool
+}
+
+// EventReplayProgress 重放进度
+type EventReplayProgress struct {
+	Id         string
+	Request    EventReplayRequest
+	Scanned    int
+	Replayed   int
+	Failed     int
+	LastError  string
+	Samples    []*Event // dry-run时的样例事件
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Done       bool
+}
+
+// IEventReplayRepo 读取可重放的事件（由data层实现）
+//
+//go:generate mockgen -source=replay.go -destination=./mocks/replay.go -package=mocks
+type IEventReplayRepo interface {
+	// ListEvents 按游标分批读取[from, to)内名为name的事件，cursor首批为0，返回下一批的游标；返回空时读取完毕
+	ListEvents(ctx context.Context, source, name string, from, to time.Time, cursor int64, limit int) ([]*Event, int64, error)
+}
+
+// EventReplayer 重放已保存的事件，用于修复处理逻辑后重新驱动消费者
+type EventReplayer struct {
+	repo      IEventReplayRepo
+	publisher IEventPublisher
+
+	mu      sync.Mutex
+	runs    map[string]*eventReplayRun
+	counter int
+}
+
+type eventReplayRun struct {
+	progress EventReplayProgress
+	cancel   context.CancelFunc
+}
+
+func NewEventReplayer(repo IEventReplayRepo, publisher IEventPublisher) *EventReplayer {
+	return &EventReplayer{repo: repo, publisher: publisher, runs: make(map[string]*eventReplayRun)}
+}
+
+func (r *EventReplayer) validate(req *EventReplayRequest, local EventHandler) error {
+	switch req.Source {
+	case EventReplaySourceOutbox, EventReplaySourceLoginLog:
+	default:
+		return fmt.Errorf("unknown replay source: %s", req.Source)
+	}
+	switch req.Target {
+	case EventReplayTargetBus:
+	case EventReplayTargetLocal:
+		if local == nil {
+			return fmt.Errorf("local event handler is not available")
+		}
+	default:
+		return fmt.Errorf("unknown replay target: %s", req.Target)
+	}
+	if req.Source == EventReplaySourceOutbox && req.Name == "" {
+		return fmt.Errorf("event name is required when replaying from outbox")
+	}
+	if req.Source == EventReplaySourceOutbox && req.Name == userLoginEventName {
+		return fmt.Errorf("%s can not be replayed from outbox, use source %s", userLoginEventName, EventReplaySourceLoginLog)
+	}
+	if req.To.IsZero() {
+		req.To = time.Now()
+	}
+	if !req.To.After(req.From) {
+		return fmt.Errorf("replay time range is empty")
+	}
+	if req.BatchSize <= 0 {
+		req.BatchSize = defaultEventReplayBatchSize
+	}
+	return nil
+}
+
+// Replay 同步重放，每处理完一批调用report报告进度；local为target=local时的事件处理器
+func (r *EventReplayer) Replay(ctx context.Context, req EventReplayRequest, local EventHandler, report func(*EventReplayProgress)) (*EventReplayProgress, error) {
+	if err := r.validate(&req, local); err != nil {
+		return nil, ErrEventReplayInvalid.WithCause(err)
+	}
+	progress := &EventReplayProgress{Id: r.nextId(), Request: req, StartedAt: time.Now()}
+	err := r.run(ctx, progress, local, func(p *EventReplayProgress) {
+		if report != nil {
+			report(p)
+		}
+	})
+	return progress, err
+}
+
+// Start 在后台重放，通过Get查询进度；进度只保存在当前实例的内存中
+func (r *EventReplayer) Start(req EventReplayRequest, local EventHandler) (*EventReplayProgress, error) {
+	if err := r.validate(&req, local); err != nil {
+		return nil, ErrEventReplayInvalid.WithCause(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	run := &eventReplayRun{
+		progress: EventReplayProgress{Id: r.nextId(), Request: req, StartedAt: time.Now()},
+		cancel:   cancel,
+	}
+	r.mu.Lock()
+	r.runs[run.progress.Id] = run
+	r.pruneLocked()
+	snapshot := run.progress
+	r.mu.Unlock()
+
+	go func() {
+		defer cancel()
+		progress := snapshot
+		err := r.run(ctx, &progress, local, func(p *EventReplayProgress) {
+			r.mu.Lock()
+			run.progress = *p
+			r.mu.Unlock()
+		})
+		if err != nil {
+			log.Errorf("event replay %s stopped: %v", progress.Id, err)
+		}
+	}()
+	return &snapshot, nil
+}
+
+// Get 查询后台重放的进度
+func (r *EventReplayer) Get(id string) (*EventReplayProgress, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	run, ok := r.runs[id]
+	if !ok {
+		return nil, false
+	}
+	progress := run.progress
+	return &progress, true
+}
+
+// Cancel 取消后台重放，已重放的事件不会撤回
+func (r *EventReplayer) Cancel(id string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	run, ok := r.runs[id]
+	if ok {
+		run.cancel()
+	}
+	return ok
+}
+
+func (r *EventReplayer) run(ctx context.Context, progress *EventReplayProgress, local EventHandler, report func(*EventReplayProgress)) error {
+	req := progress.Request
+	limiter := rate.NewLimiter(rate.Inf, 1)
+	if req.Rate > 0 {
+		limiter = rate.NewLimiter(rate.Limit(req.Rate), 1)
+	}
+	handler := local
+	if req.Target == EventReplayTargetBus {
+		handler = r.publisher.Publish
+	} else if !req.NotifyWebhooks {
+		handler = func(ctx context.Context, e *Event) error {
+			return local(NewDispatchHooksDisabledContext(ctx), e)
+		}
+	}
+	defer func() {
+		progress.Done = true
+		progress.FinishedAt = time.Now()
+		report(progress)
+	}()
+
+	var cursor int64
+	for {
+		batchSize := req.BatchSize
+		if req.Limit > 0 {
+			batchSize = min(batchSize, req.Limit-progress.Scanned)
+			if batchSize <= 0 {
+				return nil
+			}
+		}
+		events, next, err := r.repo.ListEvents(ctx, req.Source, req.Name, req.From, req.To, cursor, batchSize)
+		if err != nil {
+			progress.LastError = err.Error()
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		cursor = next
+		for _, e := range events {
+			progress.Scanned++
+			if req.DryRun {
+				if len(progress.Samples) < eventReplaySampleSize {
+					progress.Samples = append(progress.Samples, e)
+				}
+				continue
+			}
+			if err = limiter.Wait(ctx); err != nil {
+				progress.LastError = err.Error()
+				return err
+			}
+			if err = handler(ctx, newReplayEvent(e, progress.Id)); err != nil {
+				progress.Failed++
+				progress.LastError = fmt.Sprintf("event %s(%s): %v", e.Name, e.Id, err)
+				log.Context(ctx).Warnf("Replay %s: %s", progress.Id, progress.LastError)
+				continue
+			}
+			progress.Replayed++
+		}
+		log.Context(ctx).Infof("Replay %s progress: scanned %d, replayed %d, failed %d",
+			progress.Id, progress.Scanned, progress.Replayed, progress.Failed)
+		report(progress)
+	}
+}
+
+// newReplayEvent 重放的事件使用新的事件ID，避免被传输层和消费方按原ID去重
+func newReplayEvent(e *Event, replayId string) *Event {
+	replayed := *e
+	replayed.Id = fmt.Sprintf("%s:replay:%s", e.Id, replayId)
+	if replayed.Timestamp.IsZero() {
+		replayed.Timestamp = time.Now()
+	}
+	return &replayed
+}
+
+func (r *EventReplayer) nextId() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.counter++
+	return fmt.Sprintf("%d-%d", time.Now().Unix(), r.counter)
+}
+
+// pruneLocked 只保留最近的已结束重放任务
+func (r *EventReplayer) pruneLocked() {
+	var finished []*eventReplayRun
+	for _, run := range r.runs {
+		if run.progress.Done {
+			finished = append(finished, run)
+		}
+	}
+	if len(finished) <= eventReplayKeep {
+		return
+	}
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].progress.StartedAt.Before(finished[j].progress.StartedAt)
+	})
+	for _, run := range finished[:len(finished)-eventReplayKeep] {
+		delete(r.runs, run.progress.Id)
+	}
+}
@@ -0,0 +1,172 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// asynq任务状态
+const (
+	TaskStatePending   = "pending"
+	TaskStateActive    = "active"
+	TaskStateScheduled = "scheduled"
+	TaskStateRetry     = "retry"
+	TaskStateArchived  = "archived"
+	TaskStateCompleted = "completed"
+)
+
+// 任务操作
+const (
+	TaskActionRun     = "run"
+	TaskActionArchive = "archive"
+	TaskActionDelete  = "delete"
+)
+
+const (
+	defaultTaskPageSize = 20
+	maxTaskPageSize     = 100
+)
+
+// TaskQueueInfo asynq队列状态
+type TaskQueueInfo struct {
+	Queue     string
+	Size      int
+	Pending   int
+	Active    int
+	Scheduled int
+	Retry     int
+	Archived  int
+	Completed int
+	Processed int // 当天处理的任务数
+	Failed    int // 当天失败的任务数
+	Latency   time.Duration
+	Paused    bool
+}
+
+// StateSize state下的任务数
+func (q *TaskQueueInfo) StateSize(state string) int {
+	switch state {
+	case TaskStatePending:
+		return q.Pending
+	case TaskStateActive:
+		return q.Active
+	case TaskStateScheduled:
+		return q.Scheduled
+	case TaskStateRetry:
+		return q.Retry
+	case TaskStateArchived:
+		return q.Archived
+	case TaskStateCompleted:
+		return q.Completed
+	}
+	return 0
+}
+
+// TaskInfo 队列中的任务
+type TaskInfo struct {
+	Id            string
+	Queue         string
+	Type          string
+	State         string
+	EventId       string
+	Payload       string // 解码后的payload（JSON）
+	DecodeError   string // payload无法解码时Payload为原始数据的base64
+	MaxRetry      int
+	Retried       int
+	LastErr       string
+	LastFailedAt  time.Time
+	NextProcessAt time.Time
+}
+
+// TaskActionFailure 批量操作中失败的任务
+type TaskActionFailure struct {
+	Id  string
+	Err error
+}
+
+// ITaskInspectRepo asynq任务查询与操作（由data层实现）
+//
+//go:generate mockgen -source=task.go -destination=./mocks/task.go -package=mocks
+type ITaskInspectRepo interface {
+	ListQueues(ctx context.Context) ([]*TaskQueueInfo, error)
+	GetQueue(ctx context.Context, queue string) (*TaskQueueInfo, error)
+	// ListTasks 分页列出state下的任务，page从1开始
+	ListTasks(ctx context.Context, queue, state string, page, pageSize int) ([]*TaskInfo, error)
+	// ApplyAction 对单个任务执行run/archive/delete
+	ApplyAction(ctx context.Context, action, queue, id string) error
+	// ApplyActionAll 对state下的全部任务执行run/archive/delete，返回处理的任务数
+	ApplyActionAll(ctx context.Context, action, queue, state string) (int, error)
+}
+
+type TaskAdmin struct {
+	repo ITaskInspectRepo
+}
+
+func NewTaskAdmin(repo ITaskInspectRepo) *TaskAdmin {
+	return &TaskAdmin{repo: repo}
+}
+
+func (a *TaskAdmin) ListQueues(ctx context.Context) ([]*TaskQueueInfo, error) {
+	return a.repo.ListQueues(ctx)
+}
+
+// ListTasks 分页列出任务，同时返回state下的任务总数
+func (a *TaskAdmin) ListTasks(ctx context.Context, queue, state string, page, pageSize int) ([]*TaskInfo, int, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultTaskPageSize
+	}
+	pageSize = min(pageSize, maxTaskPageSize)
+	info, err := a.repo.GetQueue(ctx, queue)
+	if err != nil {
+		return nil, 0, err
+	}
+	tasks, err := a.repo.ListTasks(ctx, queue, state, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	return tasks, info.StateSize(state), nil
+}
+
+func (a *TaskAdmin) ApplyAction(ctx context.Context, action, queue, id string) error {
+	return a.repo.ApplyAction(ctx, action, queue, id)
+}
+
+// BatchApplyAction 指定ids时逐个处理，单个任务失败不影响其他任务；否则处理state下的全部任务
+func (a *TaskAdmin) BatchApplyAction(ctx context.Context, action, queue, state string, ids []string) (int, []*TaskActionFailure, error) {
+	if len(ids) == 0 {
+		if !taskActionSupportsAll(action, state) {
+			return 0, nil, ErrTaskActionInvalid.WithCause(fmt.Errorf("%s all %s tasks is not supported", action, state))
+		}
+		affected, err := a.repo.ApplyActionAll(ctx, action, queue, state)
+		return affected, nil, err
+	}
+	var (
+		affected int
+		failures []*TaskActionFailure
+	)
+	for _, id := range ids {
+		if err := a.repo.ApplyAction(ctx, action, queue, id); err != nil {
+			failures = append(failures, &TaskActionFailure{Id: id, Err: err})
+			continue
+		}
+		affected++
+	}
+	return affected, failures, nil
+}
+
+// taskActionSupportsAll asynq支持的批量操作
+func taskActionSupportsAll(action, state string) bool {
+	switch action {
+	case TaskActionRun:
+		return state == TaskStateScheduled || state == TaskStateRetry || state == TaskStateArchived
+	case TaskActionArchive:
+		return state == TaskStatePending || state == TaskStateScheduled || state == TaskStateRetry
+	case TaskActionDelete:
+		return state != "" && state != TaskStateActive
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/biz/mocks"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAlarmSilenceMatcher(t *testing.T) {
+	matcher, err := biz.NewAlarmSilenceMatcher(&biz.AlarmSilence{
+		TitlePattern: "^server error: 50[0-9]",
+		Labels:       map[string]string{biz.AlarmLabelOperation: "^/web\\.Auth/"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	labels := map[string]string{biz.AlarmLabelOperation: "/web.Auth/LoginByWallet"}
+	if !matcher.Matches("server error: 503 UNAVAILABLE", "db timeout", labels) {
+		t.Error("expected silence to match")
+	}
+	if matcher.Matches("panic error", "db timeout", labels) {
+		t.Error("expected title mismatch")
+	}
+	if matcher.Matches("server error: 503 UNAVAILABLE", "db timeout", map[string]string{biz.AlarmLabelOperation: "/web.Probe/HealthReady"}) {
+		t.Error("expected label mismatch")
+	}
+}
+
+func TestAlarmAdmin_CreateSilence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	silenceRepo := mocks.NewMockIAlarmSilenceRepo(ctrl)
+	silenceRepo.EXPECT().CreateSilence(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	admin := biz.NewAlarmAdmin(&conf.Alarm{}, nil, silenceRepo)
+	ctx := context.TODO()
+
+	t.Run("Valid", func(t *testing.T) {
+		silence := &biz.AlarmSilence{InfoPattern: "timeout", EndsAt: time.Now().Add(time.Hour)}
+		if err := admin.CreateSilence(ctx, silence); err != nil {
+			t.Fatal(err)
+		}
+		if silence.StartsAt.IsZero() {
+			t.Error("expected starts_at defaults to now")
+		}
+	})
+	t.Run("InvalidPattern", func(t *testing.T) {
+		silence := &biz.AlarmSilence{InfoPattern: "(", EndsAt: time.Now().Add(time.Hour)}
+		if err := admin.CreateSilence(ctx, silence); err == nil {
+			t.Error("expected invalid pattern error")
+		}
+	})
+	t.Run("Ended", func(t *testing.T) {
+		silence := &biz.AlarmSilence{InfoPattern: "timeout", EndsAt: time.Now().Add(-time.Minute)}
+		if err := admin.CreateSilence(ctx, silence); err == nil {
+			t.Error("expected ends_at error")
+		}
+	})
+	t.Run("NoMatcher", func(t *testing.T) {
+		silence := &biz.AlarmSilence{EndsAt: time.Now().Add(time.Hour)}
+		if err := admin.CreateSilence(ctx, silence); err == nil {
+			t.Error("expected matcher required error")
+		}
+	})
+}
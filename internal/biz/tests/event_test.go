@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seanbit/kratos/template/api/event"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/biz/mocks"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEventIdempotency_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockIProcessedEventRepo(ctrl)
+	idempotency := biz.NewEventIdempotency(&conf.Data{}, repo)
+	ctx := context.Background()
+
+	calls := 0
+	handler := func(ctx context.Context) error {
+		calls++
+		return nil
+	}
+
+	repo.EXPECT().Claim(gomock.Any(), "e1", "event.UserLogin", gomock.Any()).Return(biz.EventProcessClaimed, nil)
+	repo.EXPECT().Complete(gomock.Any(), "e1", gomock.Any()).Return(nil)
+	if err := idempotency.Run(ctx, "e1", "event.UserLogin", handler); err != nil {
+		t.Fatal(err)
+	}
+
+	repo.EXPECT().Claim(gomock.Any(), "e1", "event.UserLogin", gomock.Any()).Return(biz.EventProcessCompleted, nil)
+	if err := idempotency.Run(ctx, "e1", "event.UserLogin", handler); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Errorf("expected handler to run once, got %d", calls)
+	}
+
+	repo.EXPECT().Claim(gomock.Any(), "e2", "event.UserLogin", gomock.Any()).Return(biz.EventProcessInProgress, nil)
+	if err := idempotency.Run(ctx, "e2", "event.UserLogin", handler); !errors.Is(err, biz.ErrEventInProgress) {
+		t.Errorf("expected ErrEventInProgress, got %v", err)
+	}
+
+	handleErr := errors.New("db down")
+	repo.EXPECT().Claim(gomock.Any(), "e3", "event.UserLogin", gomock.Any()).Return(biz.EventProcessClaimed, nil)
+	repo.EXPECT().Fail(gomock.Any(), "e3", handleErr, gomock.Any()).Return(nil)
+	if err := idempotency.Run(ctx, "e3", "event.UserLogin", func(ctx context.Context) error { return handleErr }); !errors.Is(err, handleErr) {
+		t.Errorf("expected handler error, got %v", err)
+	}
+}
+
+func TestEventEnvelope(t *testing.T) {
+	e := &biz.Event{
+		Id:        "1",
+		Name:      "event.UserLogin",
+		Payload:   []byte("payload"),
+		Timestamp: time.UnixMilli(1700000000000),
+		Headers: &biz.EventHeaders{
+			Traceparent:   "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			UserId:        "user",
+			Source:        "upstream",
+			SchemaVersion: 2,
+			CorrelationId: "correlation",
+		},
+	}
+	back := biz.FromEventEnvelope(biz.NewEventEnvelope(e))
+	if back.Id != e.Id || back.Name != e.Name || string(back.Payload) != string(e.Payload) ||
+		!back.Timestamp.Equal(e.Timestamp) || *back.Headers != *e.Headers {
+		t.Errorf("envelope round trip mismatch: %+v", back)
+	}
+	// 没有时间戳和事件头的事件
+	if back = biz.FromEventEnvelope(&event.Event{Id: "2"}); !back.Timestamp.IsZero() || back.Headers != nil {
+		t.Errorf("unexpected event: %+v", back)
+	}
+}
@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/biz/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestJobHistory_Cleanup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockIJobRunRepo(ctrl)
+	history := biz.NewJobHistory(repo, mocks.NewMockIAlarmRepo(ctrl))
+	ctx := context.Background()
+
+	// 一批删满时继续删除
+	beforeDay := func(days int) gomock.Matcher {
+		return gomock.Cond(func(before time.Time) bool {
+			expected := time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+			return before.Sub(expected).Abs() < time.Minute
+		})
+	}
+	gomock.InOrder(
+		repo.EXPECT().DeleteStartedBefore(gomock.Any(), beforeDay(7), 1000).Return(int64(1000), nil),
+		repo.EXPECT().DeleteStartedBefore(gomock.Any(), beforeDay(7), 1000).Return(int64(3), nil),
+	)
+	if deleted, err := history.Cleanup(ctx, 7*24*time.Hour); err != nil || deleted != 1003 {
+		t.Errorf("expected 1003 deleted, got %d, %v", deleted, err)
+	}
+	// 未配置时保留30天
+	repo.EXPECT().DeleteStartedBefore(gomock.Any(), beforeDay(30), 1000).Return(int64(0), nil)
+	if _, err := history.Cleanup(ctx, 0); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/biz/mocks"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOutboxRelay_RelayOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockIOutboxRepo(ctrl)
+	publisher := mocks.NewMockIEventPublisher(ctrl)
+	alarm := mocks.NewMockIAlarmRepo(ctrl)
+	relay := biz.NewOutboxRelay(&conf.Data{Outbox: &conf.Data_Outbox{MaxAttempts: 3}}, repo, publisher, alarm)
+
+	published := &biz.OutboxEvent{ID: 1, EventId: "e1"}
+	// 取出时attempts已加1
+	retry := &biz.OutboxEvent{ID: 2, EventId: "e2", Attempts: 1}
+	failed := &biz.OutboxEvent{ID: 3, EventId: "e3", Attempts: 3}
+	repo.EXPECT().ClaimPending(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*biz.OutboxEvent{published, retry, failed}, nil)
+	publisher.EXPECT().Publish(gomock.Any(), eventWithId(published.EventId)).Return(nil)
+	publisher.EXPECT().Publish(gomock.Any(), eventWithId(retry.EventId)).Return(errors.New("redis down"))
+	publisher.EXPECT().Publish(gomock.Any(), eventWithId(failed.EventId)).Return(errors.New("redis down"))
+
+	repo.EXPECT().MarkPublished(gomock.Any(), int64(1)).Return(nil)
+	start := time.Now()
+	repo.EXPECT().MarkRetry(gomock.Any(), int64(2), 1, gomock.Any(), "redis down").
+		DoAndReturn(func(_ context.Context, _ int64, _ int, nextAttemptAt time.Time, _ string) error {
+			if backoff := nextAttemptAt.Sub(start); backoff < time.Second || backoff > 2*time.Second {
+				t.Errorf("unexpected backoff %s", backoff)
+			}
+			return nil
+		})
+	repo.EXPECT().MarkFailed(gomock.Any(), int64(3), 3, "redis down").Return(nil)
+	alarm.EXPECT().SendBizMessage(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+
+	count, err := relay.RelayOnce(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Errorf("expected 3 handled events, got %d", count)
+	}
+}
+
+func eventWithId(id string) gomock.Matcher {
+	return gomock.Cond(func(e *biz.Event) bool { return e.Id == id })
+}
@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/biz/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func replayEvents(ids ...int) []*biz.Event {
+	events := make([]*biz.Event, 0, len(ids))
+	for _, id := range ids {
+		events = append(events, &biz.Event{Id: fmt.Sprintf("e%d", id), Name: "event.UserLogin", Timestamp: time.Now()})
+	}
+	return events
+}
+
+func TestEventReplayer_Replay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockIEventReplayRepo(ctrl)
+	publisher := mocks.NewMockIEventPublisher(ctrl)
+	replayer := biz.NewEventReplayer(repo, publisher)
+	ctx := context.Background()
+	req := biz.EventReplayRequest{
+		Source:    biz.EventReplaySourceOutbox,
+		Name:      "event.OrderPaid",
+		Target:    biz.EventReplayTargetBus,
+		From:      time.Now().Add(-time.Hour),
+		BatchSize: 2,
+	}
+
+	// 按游标分批读取，重放的事件使用新的事件ID
+	gomock.InOrder(
+		repo.EXPECT().ListEvents(gomock.Any(), req.Source, req.Name, req.From, gomock.Any(), int64(0), 2).Return(replayEvents(1, 2), int64(2), nil),
+		repo.EXPECT().ListEvents(gomock.Any(), req.Source, req.Name, req.From, gomock.Any(), int64(2), 2).Return(replayEvents(3), int64(3), nil),
+		repo.EXPECT().ListEvents(gomock.Any(), req.Source, req.Name, req.From, gomock.Any(), int64(3), 2).Return(nil, int64(3), nil),
+	)
+	var published []string
+	publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, e *biz.Event) error {
+		published = append(published, e.Id)
+		return nil
+	}).Times(3)
+	reports := 0
+	progress, err := replayer.Replay(ctx, req, nil, func(*biz.EventReplayProgress) { reports++ })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if progress.Scanned != 3 || progress.Replayed != 3 || !progress.Done || reports != 3 {
+		t.Errorf("unexpected progress: %+v, reports %d", progress, reports)
+	}
+	for _, id := range published {
+		if !strings.Contains(id, ":replay:"+progress.Id) {
+			t.Errorf("replayed event id %s should contain replay id", id)
+		}
+	}
+
+	// dry-run只统计，limit限制读取的事件数
+	req.DryRun, req.Limit = true, 1
+	repo.EXPECT().ListEvents(gomock.Any(), req.Source, req.Name, req.From, gomock.Any(), int64(0), 1).Return(replayEvents(1), int64(1), nil)
+	if progress, err = replayer.Replay(ctx, req, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if progress.Scanned != 1 || progress.Replayed != 0 || len(progress.Samples) != 1 {
+		t.Errorf("unexpected dry-run progress: %+v", progress)
+	}
+
+	// 重放到本服务时需要处理器
+	req.Target = biz.EventReplayTargetLocal
+	if _, err = replayer.Replay(ctx, req, nil, nil); err == nil {
+		t.Error("expected error without local handler")
+	}
+}
+
+// outbox中的登录事件没有log_id，重放会重复写入登录记录，任何目标都拒绝
+func TestEventReplayer_RejectOutboxUserLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 不读取事件，也不调用处理器
+	replayer := biz.NewEventReplayer(mocks.NewMockIEventReplayRepo(ctrl), mocks.NewMockIEventPublisher(ctrl))
+	local := func(ctx context.Context, e *biz.Event) error {
+		t.Errorf("local handler should not be called for %s", e.Id)
+		return nil
+	}
+	for _, target := range []string{biz.EventReplayTargetLocal, biz.EventReplayTargetBus} {
+		req := biz.EventReplayRequest{
+			Source: biz.EventReplaySourceOutbox,
+			Name:   "event.UserLogin",
+			Target: target,
+			From:   time.Now().Add(-time.Hour),
+		}
+		if _, err := replayer.Replay(context.Background(), req, local, nil); !errors.Is(err, biz.ErrEventReplayInvalid) {
+			t.Errorf("target %s: expected invalid replay error, got %v", target, err)
+		}
+		if _, err := replayer.Start(req, local); !errors.Is(err, biz.ErrEventReplayInvalid) {
+			t.Errorf("target %s: expected invalid replay error, got %v", target, err)
+		}
+	}
+}
+
+// 重放到本服务时默认不调用dispatch hook，NotifyWebhooks时调用
+func TestEventReplayer_ReplayLocalHooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockIEventReplayRepo(ctrl)
+	replayer := biz.NewEventReplayer(repo, mocks.NewMockIEventPublisher(ctrl))
+	req := biz.EventReplayRequest{
+		Source: biz.EventReplaySourceLoginLog,
+		Target: biz.EventReplayTargetLocal,
+		From:   time.Now().Add(-time.Hour),
+	}
+	repo.EXPECT().ListEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), int64(0), gomock.Any()).Return(replayEvents(1), int64(1), nil).Times(2)
+	repo.EXPECT().ListEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), int64(1), gomock.Any()).Return(nil, int64(1), nil).Times(2)
+
+	var disabled []bool
+	local := func(ctx context.Context, e *biz.Event) error {
+		disabled = append(disabled, biz.DispatchHooksDisabled(ctx))
+		return nil
+	}
+	for _, notify := range []bool{false, true} {
+		req.NotifyWebhooks = notify
+		if _, err := replayer.Replay(context.Background(), req, local, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(disabled) != 2 || !disabled[0] || disabled[1] {
+		t.Errorf("unexpected dispatch hooks disabled: %v", disabled)
+	}
+}
@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/biz/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskAdmin_BatchApplyAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockITaskInspectRepo(ctrl)
+	admin := biz.NewTaskAdmin(repo)
+	ctx := context.Background()
+
+	// 指定ids时逐个处理，单个失败不影响其他任务
+	repo.EXPECT().ApplyAction(gomock.Any(), biz.TaskActionRun, "default", "t1").Return(nil)
+	repo.EXPECT().ApplyAction(gomock.Any(), biz.TaskActionRun, "default", "t2").Return(biz.ErrTaskNotFound)
+	repo.EXPECT().ApplyAction(gomock.Any(), biz.TaskActionRun, "default", "t3").Return(nil)
+	affected, failures, err := admin.BatchApplyAction(ctx, biz.TaskActionRun, "default", "", []string{"t1", "t2", "t3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if affected != 2 || len(failures) != 1 || failures[0].Id != "t2" {
+		t.Errorf("unexpected result: affected %d, failures %+v", affected, failures)
+	}
+
+	// 未指定ids时处理state下的全部任务
+	repo.EXPECT().ApplyActionAll(gomock.Any(), biz.TaskActionRun, "default", biz.TaskStateArchived).Return(5, nil)
+	if affected, _, err = admin.BatchApplyAction(ctx, biz.TaskActionRun, "default", biz.TaskStateArchived, nil); err != nil || affected != 5 {
+		t.Errorf("run all archived: affected %d, err %v", affected, err)
+	}
+
+	// asynq不支持的批量操作
+	if _, _, err = admin.BatchApplyAction(ctx, biz.TaskActionRun, "default", biz.TaskStatePending, nil); !errors.Is(err, biz.ErrTaskActionInvalid) {
+		t.Errorf("expected ErrTaskActionInvalid, got %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/biz/mocks"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSignWebhookPayload(t *testing.T) {
+	// echo -n '1700000000.{"id":"1"}' | openssl dgst -sha256 -hmac secret
+	signature := biz.SignWebhookPayload("secret", 1700000000, []byte(`{"id":"1"}`))
+	if signature != "sha256=086f6aff7bd084c98679825129c5a64dbad88c760016d6d2c0fb123f27951d54" {
+		t.Errorf("unexpected signature: %s", signature)
+	}
+	if signature == biz.SignWebhookPayload("other", 1700000000, []byte(`{"id":"1"}`)) {
+		t.Error("signature should depend on secret")
+	}
+	if signature == biz.SignWebhookPayload("secret", 1700000001, []byte(`{"id":"1"}`)) {
+		t.Error("signature should depend on timestamp")
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	cases := map[int]time.Duration{1: 10 * time.Second, 2: 20 * time.Second, 4: 80 * time.Second, 20: time.Hour}
+	for attempts, expected := range cases {
+		if backoff := biz.WebhookBackoff(attempts); backoff != expected {
+			t.Errorf("attempts %d: expected %s, got %s", attempts, expected, backoff)
+		}
+	}
+}
+
+func TestWebhook_DeliverOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockIWebhookRepo(ctrl)
+	sender := mocks.NewMockIWebhookSender(ctrl)
+	alarm := mocks.NewMockIAlarmRepo(ctrl)
+	webhook := biz.NewWebhook(&conf.Data{Webhook: &conf.Data_Webhook{MaxAttempts: 3}}, repo, sender, alarm)
+	ctx := context.Background()
+
+	subscription := &biz.WebhookSubscription{Id: 1, Name: "crm", Url: "https://example.com/hook", Secret: "secret", Enabled: true}
+	deliveries := []*biz.WebhookDelivery{
+		{Id: 10, SubscriptionId: 1, EventId: "e1", EventName: "event.UserLogin", Payload: []byte(`{"id":"e1"}`)},
+		{Id: 11, SubscriptionId: 1, EventId: "e2", EventName: "event.UserLogin", Payload: []byte(`{"id":"e2"}`), Attempts: 2},
+		{Id: 12, SubscriptionId: 2, EventId: "e3", EventName: "event.UserLogin", Payload: []byte(`{"id":"e3"}`)},
+	}
+	repo.EXPECT().ClaimDue(gomock.Any(), 50, gomock.Any()).Return(deliveries, nil)
+	repo.EXPECT().GetSubscription(gomock.Any(), int64(1)).Return(subscription, nil)
+	repo.EXPECT().GetSubscription(gomock.Any(), int64(2)).Return(nil, biz.ErrWebhookSubscriptionNotFound)
+
+	// e1 签名正确，订阅方返回500，按退避重试
+	sender.EXPECT().Send(gomock.Any(), subscription.Url, gomock.Any(), []byte(`{"id":"e1"}`), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, url string, header http.Header, body []byte, timeout time.Duration) (*biz.WebhookResponse, error) {
+			if header.Get(biz.WebhookHeaderId) != "e1" || header.Get(biz.WebhookHeaderEvent) != "event.UserLogin" {
+				t.Errorf("unexpected headers: %v", header)
+			}
+			ts, _ := strconv.ParseInt(header.Get(biz.WebhookHeaderTimestamp), 10, 64)
+			if header.Get(biz.WebhookHeaderSignature) != biz.SignWebhookPayload("secret", ts, body) {
+				t.Errorf("unexpected signature: %s", header.Get(biz.WebhookHeaderSignature))
+			}
+			return &biz.WebhookResponse{Code: http.StatusInternalServerError}, nil
+		})
+	repo.EXPECT().MarkRetry(gomock.Any(), int64(10), 1, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, resp *biz.WebhookResponse, lastErr string) error {
+			if backoff := time.Until(nextAttemptAt); backoff < 9*time.Second || backoff > 10*time.Second {
+				t.Errorf("unexpected backoff %s", backoff)
+			}
+			return nil
+		})
+
+	// e2 达到最大投递次数，标记失败并告警
+	sender.EXPECT().Send(gomock.Any(), subscription.Url, gomock.Any(), []byte(`{"id":"e2"}`), gomock.Any()).
+		Return(&biz.WebhookResponse{Code: http.StatusBadGateway}, nil)
+	alarm.EXPECT().SendBizMessage(gomock.Any(), gomock.Any(), gomock.Any())
+	repo.EXPECT().MarkFailed(gomock.Any(), int64(11), 3, gomock.Any(), gomock.Any()).Return(nil)
+
+	// e3 的订阅已删除，不再投递
+	repo.EXPECT().MarkFailed(gomock.Any(), int64(12), 0, nil, gomock.Any()).Return(nil)
+
+	handled, err := webhook.DeliverOnce(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if handled != 3 {
+		t.Errorf("expected 3 deliveries handled, got %d", handled)
+	}
+}
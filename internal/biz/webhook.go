@@ -0,0 +1,362 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	defaultWebhookPollInterval = time.Second
+	defaultWebhookBatchSize    = 50
+	defaultWebhookMaxAttempts  = 10
+	defaultWebhookTimeout      = 10 * time.Second
+	defaultWebhookRetention    = 30 * 24 * time.Hour
+	// webhookBaseBackoff 第一次失败后的重试间隔，之后每次翻倍
+	webhookBaseBackoff = 10 * time.Second
+	// webhookMaxBackoff 投递失败后重试的最大间隔
+	webhookMaxBackoff = time.Hour
+	// webhookConcurrency 一批中同时投递的数量
+	webhookConcurrency = 10
+	// webhookCleanupBatchSize 每次清理的最大行数
+	webhookCleanupBatchSize = 1000
+	// webhookSecretBytes 自动生成的签名密钥长度
+	webhookSecretBytes = 32
+)
+
+// webhook投递状态
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// webhook请求头
+const (
+	WebhookHeaderId        = "X-Webhook-Id"
+	WebhookHeaderEvent     = "X-Webhook-Event"
+	WebhookHeaderTimestamp = "X-Webhook-Timestamp"
+	// WebhookHeaderSignature sha256=hex(HMAC-SHA256(secret, "<timestamp>.<body>"))
+	WebhookHeaderSignature = "X-Webhook-Signature"
+)
+
+// WebhookSubscription 订阅方：订阅的事件发生时向URL推送
+type WebhookSubscription struct {
+	Id          int64
+	Name        string
+	Url         string
+	EventTypes  []string // 订阅的事件名
+	Secret      string   // 签名密钥
+	Enabled     bool
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// WebhookDelivery 一次推送及其投递记录
+type WebhookDelivery struct {
+	Id             int64
+	SubscriptionId int64
+	EventId        string
+	EventName      string
+	Payload        []byte // 请求body
+	Status         string
+	Attempts       int
+	ResponseCode   int
+	LastError      string
+	Duration       time.Duration
+	NextAttemptAt  time.Time
+	DeliveredAt    time.Time
+	CreatedAt      time.Time
+}
+
+// WebhookPayload 推送的body
+type WebhookPayload struct {
+	Id        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// WebhookResponse 订阅方的响应，不保存响应body，避免通过投递记录读取订阅地址返回的内容
+type WebhookResponse struct {
+	Code     int
+	Duration time.Duration
+}
+
+// IWebhookRepo webhook订阅与投递记录（由data层实现）
+//
+//go:generate mockgen -source=webhook.go -destination=./mocks/webhook.go -package=mocks
+type IWebhookRepo interface {
+	CreateSubscription(ctx context.Context, subscription *WebhookSubscription) error
+	UpdateSubscription(ctx context.Context, subscription *WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, id int64) error
+	GetSubscription(ctx context.Context, id int64) (*WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context) ([]*WebhookSubscription, error)
+	// ListSubscriptionsByEvent 订阅了事件name的启用中的订阅
+	ListSubscriptionsByEvent(ctx context.Context, name string) ([]*WebhookSubscription, error)
+
+	// AddDeliveries 同一订阅的同一事件只保存一次
+	AddDeliveries(ctx context.Context, deliveries ...*WebhookDelivery) error
+	// ClaimDue 取出到期的待投递记录，并将其下次投递时间推迟lease，避免被其他实例重复取出
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*WebhookDelivery, error)
+	MarkSucceeded(ctx context.Context, id int64, attempts int, resp *WebhookResponse) error
+	MarkRetry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, resp *WebhookResponse, lastErr string) error
+	MarkFailed(ctx context.Context, id int64, attempts int, resp *WebhookResponse, lastErr string) error
+	// ResetDelivery 重新投递：状态改为待投递，重置投递次数
+	ResetDelivery(ctx context.Context, id int64) error
+	// ListDeliveries 按创建时间倒序分页，status为空时不过滤，page从1开始
+	ListDeliveries(ctx context.Context, subscriptionId int64, status string, page, pageSize int) ([]*WebhookDelivery, int64, error)
+	DeleteFinishedBefore(ctx context.Context, before time.Time, limit int) (int64, error)
+}
+
+// IWebhookSender 发送webhook请求（由data层实现），非2xx响应也返回resp
+type IWebhookSender interface {
+	Send(ctx context.Context, url string, header http.Header, body []byte, timeout time.Duration) (*WebhookResponse, error)
+}
+
+// Webhook 订阅的事件发生时向订阅方推送签名的payload，失败时指数退避重试
+type Webhook struct {
+	config *conf.Data_Webhook
+	repo   IWebhookRepo
+	sender IWebhookSender
+	alarm  IAlarmRepo
+}
+
+func NewWebhook(config *conf.Data, repo IWebhookRepo, sender IWebhookSender, alarm IAlarmRepo) *Webhook {
+	return &Webhook{config: config.GetWebhook(), repo: repo, sender: sender, alarm: alarm}
+}
+
+func (w *Webhook) PollInterval() time.Duration {
+	if interval := w.config.GetPollInterval().AsDuration(); interval > 0 {
+		return interval
+	}
+	return defaultWebhookPollInterval
+}
+
+func (w *Webhook) BatchSize() int {
+	if size := w.config.GetBatchSize(); size > 0 {
+		return int(size)
+	}
+	return defaultWebhookBatchSize
+}
+
+func (w *Webhook) maxAttempts() int {
+	if attempts := w.config.GetMaxAttempts(); attempts > 0 {
+		return int(attempts)
+	}
+	return defaultWebhookMaxAttempts
+}
+
+func (w *Webhook) timeout() time.Duration {
+	if timeout := w.config.GetTimeout().AsDuration(); timeout > 0 {
+		return timeout
+	}
+	return defaultWebhookTimeout
+}
+
+func (w *Webhook) retention() time.Duration {
+	if retention := w.config.GetRetention().AsDuration(); retention > 0 {
+		return retention
+	}
+	return defaultWebhookRetention
+}
+
+// SignWebhookPayload 签名内容为 "<timestamp>.<body>"，订阅方应校验时间戳防止重放
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Notify 为订阅了该事件的订阅方生成投递记录，由投递循环异步推送；重复通知同一事件不会重复推送
+func (w *Webhook) Notify(ctx context.Context, payload *WebhookPayload) error {
+	subscriptions, err := w.repo.ListSubscriptionsByEvent(ctx, payload.Type)
+	if err != nil || len(subscriptions) == 0 {
+		return err
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal webhook payload: %w", err)
+	}
+	now := time.Now()
+	deliveries := make([]*WebhookDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		deliveries = append(deliveries, &WebhookDelivery{
+			SubscriptionId: subscription.Id,
+			EventId:        payload.Id,
+			EventName:      payload.Type,
+			Payload:        body,
+			Status:         WebhookDeliveryPending,
+			NextAttemptAt:  now,
+		})
+	}
+	return w.repo.AddDeliveries(ctx, deliveries...)
+}
+
+// DeliverOnce 投递一批到期的记录，返回本批处理的数量
+func (w *Webhook) DeliverOnce(ctx context.Context) (int, error) {
+	// 投递中的记录在请求超时前不会被再次取出
+	deliveries, err := w.repo.ClaimDue(ctx, w.BatchSize(), 2*w.timeout())
+	if err != nil || len(deliveries) == 0 {
+		return 0, err
+	}
+	subscriptions := make(map[int64]*WebhookSubscription)
+	for _, delivery := range deliveries {
+		if _, ok := subscriptions[delivery.SubscriptionId]; ok {
+			continue
+		}
+		if subscriptions[delivery.SubscriptionId], err = w.repo.GetSubscription(ctx, delivery.SubscriptionId); err != nil && !ErrWebhookSubscriptionNotFound.Is(err) {
+			return 0, err
+		}
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(webhookConcurrency)
+	for _, delivery := range deliveries {
+		subscription := subscriptions[delivery.SubscriptionId]
+		group.Go(func() error {
+			return w.deliver(ctx, subscription, delivery)
+		})
+	}
+	return len(deliveries), group.Wait()
+}
+
+func (w *Webhook) deliver(ctx context.Context, subscription *WebhookSubscription, delivery *WebhookDelivery) error {
+	attempts := delivery.Attempts + 1
+	if subscription == nil || !subscription.Enabled {
+		// 订阅已删除或停用，不再投递
+		return w.repo.MarkFailed(ctx, delivery.Id, delivery.Attempts, nil, "subscription deleted or disabled")
+	}
+	timestamp := time.Now().Unix()
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set(WebhookHeaderId, delivery.EventId)
+	header.Set(WebhookHeaderEvent, delivery.EventName)
+	header.Set(WebhookHeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	header.Set(WebhookHeaderSignature, SignWebhookPayload(subscription.Secret, timestamp, delivery.Payload))
+
+	resp, sendErr := w.sender.Send(ctx, subscription.Url, header, delivery.Payload, w.timeout())
+	if sendErr == nil && (resp.Code < http.StatusOK || resp.Code >= http.StatusMultipleChoices) {
+		sendErr = fmt.Errorf("unexpected status code %d", resp.Code)
+	}
+	if sendErr == nil {
+		return w.repo.MarkSucceeded(ctx, delivery.Id, attempts, resp)
+	}
+
+	if attempts >= w.maxAttempts() {
+		log.Context(ctx).Errorf("Webhook %s(%d) delivery of %s(%s) failed after %d attempts: %v",
+			subscription.Name, subscription.Id, delivery.EventName, delivery.EventId, attempts, sendErr)
+		w.alarm.SendBizMessage(ctx, "webhook delivery failed",
+			fmt.Sprintf("webhook %s(%d) delivery %d of %s(%s) failed after %d attempts: %v",
+				subscription.Name, subscription.Id, delivery.Id, delivery.EventName, delivery.EventId, attempts, sendErr))
+		return w.repo.MarkFailed(ctx, delivery.Id, attempts, resp, sendErr.Error())
+	}
+	backoff := WebhookBackoff(attempts)
+	log.Context(ctx).Warnf("Webhook %s(%d) delivery of %s(%s) failed (attempt %d), retry after %s: %v",
+		subscription.Name, subscription.Id, delivery.EventName, delivery.EventId, attempts, backoff, sendErr)
+	return w.repo.MarkRetry(ctx, delivery.Id, attempts, time.Now().Add(backoff), resp, sendErr.Error())
+}
+
+// WebhookBackoff 第attempts次失败后的重试间隔：10s, 20s, 40s ... 最大1小时
+func WebhookBackoff(attempts int) time.Duration {
+	return min(webhookBaseBackoff<<min(max(attempts-1, 0), 16), webhookMaxBackoff)
+}
+
+// Cleanup 删除超过保留时长的已结束投递记录，返回删除的数量
+func (w *Webhook) Cleanup(ctx context.Context) (int64, error) {
+	before := time.Now().Add(-w.retention())
+	var total int64
+	for {
+		deleted, err := w.repo.DeleteFinishedBefore(ctx, before, webhookCleanupBatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+		if deleted < webhookCleanupBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// CreateSubscription 未指定密钥时自动生成
+func (w *Webhook) CreateSubscription(ctx context.Context, subscription *WebhookSubscription) error {
+	if err := validateWebhookUrl(subscription.Url); err != nil {
+		return ErrWebhookSubscriptionInvalid.WithCause(err)
+	}
+	if subscription.Secret == "" {
+		secret := make([]byte, webhookSecretBytes)
+		if _, err := rand.Read(secret); err != nil {
+			return fmt.Errorf("generate webhook secret: %w", err)
+		}
+		subscription.Secret = hex.EncodeToString(secret)
+	}
+	return w.repo.CreateSubscription(ctx, subscription)
+}
+
+// UpdateSubscription 密钥为空时保持不变
+func (w *Webhook) UpdateSubscription(ctx context.Context, subscription *WebhookSubscription) error {
+	if err := validateWebhookUrl(subscription.Url); err != nil {
+		return ErrWebhookSubscriptionInvalid.WithCause(err)
+	}
+	existing, err := w.repo.GetSubscription(ctx, subscription.Id)
+	if err != nil {
+		return err
+	}
+	if subscription.Secret == "" {
+		subscription.Secret = existing.Secret
+	}
+	subscription.CreatedAt = existing.CreatedAt
+	return w.repo.UpdateSubscription(ctx, subscription)
+}
+
+func (w *Webhook) DeleteSubscription(ctx context.Context, id int64) error {
+	return w.repo.DeleteSubscription(ctx, id)
+}
+
+func (w *Webhook) ListSubscriptions(ctx context.Context) ([]*WebhookSubscription, error) {
+	return w.repo.ListSubscriptions(ctx)
+}
+
+func (w *Webhook) ListDeliveries(ctx context.Context, subscriptionId int64, status string, page, pageSize int) ([]*WebhookDelivery, int64, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultTaskPageSize
+	}
+	return w.repo.ListDeliveries(ctx, subscriptionId, status, page, min(pageSize, maxTaskPageSize))
+}
+
+// Redeliver 重新投递，失败或成功的记录都可以重新投递
+func (w *Webhook) Redeliver(ctx context.Context, id int64) error {
+	return w.repo.ResetDelivery(ctx, id)
+}
+
+func validateWebhookUrl(rawUrl string) error {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("unsupported url scheme: %s", u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("url host is required")
+	}
+	return nil
+}
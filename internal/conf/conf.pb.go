@@ -7,8 +7,10 @@
 package conf
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
@@ -137,19 +139,22 @@ func (LogLevel) EnumDescriptor() ([]byte, []int) {
 	return file_conf_conf_proto_rawDescGZIP(), []int{1}
 }
 
+// 校验规则由protovalidate执行，见global.ValidateConfig
 type Bootstrap struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
-	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Env           Env                    `protobuf:"varint,3,opt,name=env,proto3,enum=kratos.api.Env" json:"env,omitempty"`
-	LogLevel      LogLevel               `protobuf:"varint,4,opt,name=log_level,json=logLevel,proto3,enum=kratos.api.LogLevel" json:"log_level,omitempty"`
-	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Sentry        *Sentry                `protobuf:"bytes,6,opt,name=sentry,proto3" json:"sentry,omitempty"`
-	Tracing       *Tracing               `protobuf:"bytes,7,opt,name=tracing,proto3" json:"tracing,omitempty"`
-	Alarm         *Alarm                 `protobuf:"bytes,8,opt,name=alarm,proto3" json:"alarm,omitempty"`
-	Auth          *Auth                  `protobuf:"bytes,9,opt,name=auth,proto3" json:"auth,omitempty"`
-	S3            *S3                    `protobuf:"bytes,10,opt,name=s3,proto3" json:"s3,omitempty"`
-	GeoIp         *GeoIp                 `protobuf:"bytes,11,opt,name=geo_ip,json=geoIp,proto3" json:"geo_ip,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Server   *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Data     *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Env      Env                    `protobuf:"varint,3,opt,name=env,proto3,enum=kratos.api.Env" json:"env,omitempty"`
+	LogLevel LogLevel               `protobuf:"varint,4,opt,name=log_level,json=logLevel,proto3,enum=kratos.api.LogLevel" json:"log_level,omitempty"`
+	// 服务名
+	Name          string   `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Sentry        *Sentry  `protobuf:"bytes,6,opt,name=sentry,proto3" json:"sentry,omitempty"`
+	Tracing       *Tracing `protobuf:"bytes,7,opt,name=tracing,proto3" json:"tracing,omitempty"`
+	Alarm         *Alarm   `protobuf:"bytes,8,opt,name=alarm,proto3" json:"alarm,omitempty"`
+	Auth          *Auth    `protobuf:"bytes,9,opt,name=auth,proto3" json:"auth,omitempty"`
+	S3            *S3      `protobuf:"bytes,10,opt,name=s3,proto3" json:"s3,omitempty"`
+	GeoIp         *GeoIp   `protobuf:"bytes,11,opt,name=geo_ip,json=geoIp,proto3" json:"geo_ip,omitempty"`
+	Crontab       *Crontab `protobuf:"bytes,12,opt,name=crontab,proto3" json:"crontab,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -261,18 +266,92 @@ func (x *Bootstrap) GetGeoIp() *GeoIp {
 	return nil
 }
 
+func (x *Bootstrap) GetCrontab() *Crontab {
+	if x != nil {
+		return x.Crontab
+	}
+	return nil
+}
+
+// 定时任务配置，按任务名覆盖代码中的设置
+type Crontab struct {
+	state protoimpl.MessageState  `protogen:"open.v1"`
+	Jobs  map[string]*Crontab_Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 所有任务spec的默认时区，为空时使用本地时区
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// 任务执行记录的保留时长，由job_run_cleanup任务清理，默认30天
+	RunRetention  *durationpb.Duration `protobuf:"bytes,3,opt,name=run_retention,json=runRetention,proto3" json:"run_retention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Crontab) Reset() {
+	*x = Crontab{}
+	mi := &file_conf_conf_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Crontab) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Crontab) ProtoMessage() {}
+
+func (x *Crontab) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Crontab.ProtoReflect.Descriptor instead.
+func (*Crontab) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Crontab) GetJobs() map[string]*Crontab_Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *Crontab) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Crontab) GetRunRetention() *durationpb.Duration {
+	if x != nil {
+		return x.RunRetention
+	}
+	return nil
+}
+
 type Server struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
-	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
-	Asynq         *Server_ASYNQ          `protobuf:"bytes,3,opt,name=asynq,proto3" json:"asynq,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Http        *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	Grpc        *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Asynq       *Server_ASYNQ          `protobuf:"bytes,3,opt,name=asynq,proto3" json:"asynq,omitempty"`
+	EventBus    *Server_EventBus       `protobuf:"bytes,4,opt,name=event_bus,json=eventBus,proto3" json:"event_bus,omitempty"`
+	EventIngest *Server_EventIngest    `protobuf:"bytes,5,opt,name=event_ingest,json=eventIngest,proto3" json:"event_ingest,omitempty"`
+	// operation（/package.Service/Method） -> 路由策略
+	RoutePolicies map[string]*Server_RoutePolicy `protobuf:"bytes,6,rep,name=route_policies,json=routePolicies,proto3" json:"route_policies,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Server) Reset() {
 	*x = Server{}
-	mi := &file_conf_conf_proto_msgTypes[1]
+	mi := &file_conf_conf_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -284,7 +363,7 @@ func (x *Server) String() string {
 func (*Server) ProtoMessage() {}
 
 func (x *Server) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[1]
+	mi := &file_conf_conf_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -297,7 +376,7 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server.ProtoReflect.Descriptor instead.
 func (*Server) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1}
+	return file_conf_conf_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Server) GetHttp() *Server_HTTP {
@@ -321,17 +400,41 @@ func (x *Server) GetAsynq() *Server_ASYNQ {
 	return nil
 }
 
+func (x *Server) GetEventBus() *Server_EventBus {
+	if x != nil {
+		return x.EventBus
+	}
+	return nil
+}
+
+func (x *Server) GetEventIngest() *Server_EventIngest {
+	if x != nil {
+		return x.EventIngest
+	}
+	return nil
+}
+
+func (x *Server) GetRoutePolicies() map[string]*Server_RoutePolicy {
+	if x != nil {
+		return x.RoutePolicies
+	}
+	return nil
+}
+
 type Data struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Database         *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis            *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Outbox           *Data_Outbox           `protobuf:"bytes,3,opt,name=outbox,proto3" json:"outbox,omitempty"`
+	EventIdempotency *Data_EventIdempotency `protobuf:"bytes,4,opt,name=event_idempotency,json=eventIdempotency,proto3" json:"event_idempotency,omitempty"`
+	Webhook          *Data_Webhook          `protobuf:"bytes,5,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Data) Reset() {
 	*x = Data{}
-	mi := &file_conf_conf_proto_msgTypes[2]
+	mi := &file_conf_conf_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +446,7 @@ func (x *Data) String() string {
 func (*Data) ProtoMessage() {}
 
 func (x *Data) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[2]
+	mi := &file_conf_conf_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +459,7 @@ func (x *Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data.ProtoReflect.Descriptor instead.
 func (*Data) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2}
+	return file_conf_conf_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Data) GetDatabase() *Data_Database {
@@ -373,6 +476,27 @@ func (x *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+func (x *Data) GetOutbox() *Data_Outbox {
+	if x != nil {
+		return x.Outbox
+	}
+	return nil
+}
+
+func (x *Data) GetEventIdempotency() *Data_EventIdempotency {
+	if x != nil {
+		return x.EventIdempotency
+	}
+	return nil
+}
+
+func (x *Data) GetWebhook() *Data_Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
 type Tracing struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
@@ -384,7 +508,7 @@ type Tracing struct {
 
 func (x *Tracing) Reset() {
 	*x = Tracing{}
-	mi := &file_conf_conf_proto_msgTypes[3]
+	mi := &file_conf_conf_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -396,7 +520,7 @@ func (x *Tracing) String() string {
 func (*Tracing) ProtoMessage() {}
 
 func (x *Tracing) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[3]
+	mi := &file_conf_conf_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +533,7 @@ func (x *Tracing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tracing.ProtoReflect.Descriptor instead.
 func (*Tracing) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3}
+	return file_conf_conf_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Tracing) GetHost() string {
@@ -443,7 +567,7 @@ type Sentry struct {
 
 func (x *Sentry) Reset() {
 	*x = Sentry{}
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_conf_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -455,7 +579,7 @@ func (x *Sentry) String() string {
 func (*Sentry) ProtoMessage() {}
 
 func (x *Sentry) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_conf_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,7 +592,7 @@ func (x *Sentry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sentry.ProtoReflect.Descriptor instead.
 func (*Sentry) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{4}
+	return file_conf_conf_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Sentry) GetDsn() string {
@@ -493,13 +617,21 @@ type Alarm struct {
 	DefaultPlatform     string                 `protobuf:"bytes,4,opt,name=default_platform,json=defaultPlatform,proto3" json:"default_platform,omitempty"`
 	DryRun              bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	Concurrency         int32                  `protobuf:"varint,6,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// 告警渠道类型：platform -> lark/slack，未配置的platform默认为lark
+	Channels    map[string]string  `protobuf:"bytes,7,rep,name=channels,proto3" json:"channels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ServerError *Alarm_ServerError `protobuf:"bytes,8,opt,name=server_error,json=serverError,proto3" json:"server_error,omitempty"`
+	// Redis不可用时进程内降级队列的容量，默认1000
+	FallbackQueueSize int32                       `protobuf:"varint,9,opt,name=fallback_queue_size,json=fallbackQueueSize,proto3" json:"fallback_queue_size,omitempty"`
+	RateLimits        map[string]*Alarm_RateLimit `protobuf:"bytes,10,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 告警被ack后，超过该时长未再触发视为已恢复，之后再次触发会重新通知，默认1h
+	AckResolveTimeout *durationpb.Duration `protobuf:"bytes,11,opt,name=ack_resolve_timeout,json=ackResolveTimeout,proto3" json:"ack_resolve_timeout,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Alarm) Reset() {
 	*x = Alarm{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -511,7 +643,7 @@ func (x *Alarm) String() string {
 func (*Alarm) ProtoMessage() {}
 
 func (x *Alarm) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,7 +656,7 @@ func (x *Alarm) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Alarm.ProtoReflect.Descriptor instead.
 func (*Alarm) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+	return file_conf_conf_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Alarm) GetWebHooks() map[string]string {
@@ -569,17 +701,54 @@ func (x *Alarm) GetConcurrency() int32 {
 	return 0
 }
 
+func (x *Alarm) GetChannels() map[string]string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *Alarm) GetServerError() *Alarm_ServerError {
+	if x != nil {
+		return x.ServerError
+	}
+	return nil
+}
+
+func (x *Alarm) GetFallbackQueueSize() int32 {
+	if x != nil {
+		return x.FallbackQueueSize
+	}
+	return 0
+}
+
+func (x *Alarm) GetRateLimits() map[string]*Alarm_RateLimit {
+	if x != nil {
+		return x.RateLimits
+	}
+	return nil
+}
+
+func (x *Alarm) GetAckResolveTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.AckResolveTimeout
+	}
+	return nil
+}
+
 type Auth struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JwtKey_25519  string                 `protobuf:"bytes,1,opt,name=jwt_key_25519,json=jwtKey25519,proto3" json:"jwt_key_25519,omitempty"`
-	LoginExpires  *durationpb.Duration   `protobuf:"bytes,2,opt,name=login_expires,json=loginExpires,proto3" json:"login_expires,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	JwtKey_25519 string                 `protobuf:"bytes,1,opt,name=jwt_key_25519,json=jwtKey25519,proto3" json:"jwt_key_25519,omitempty"`
+	LoginExpires *durationpb.Duration   `protobuf:"bytes,2,opt,name=login_expires,json=loginExpires,proto3" json:"login_expires,omitempty"`
+	// 可以调用 /admin 管理接口的用户id，为空时所有管理接口返回403，支持热更新
+	AdminUserIds  []string `protobuf:"bytes,3,rep,name=admin_user_ids,json=adminUserIds,proto3" json:"admin_user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Auth) Reset() {
 	*x = Auth{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -591,7 +760,7 @@ func (x *Auth) String() string {
 func (*Auth) ProtoMessage() {}
 
 func (x *Auth) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,7 +773,7 @@ func (x *Auth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Auth.ProtoReflect.Descriptor instead.
 func (*Auth) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{6}
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Auth) GetJwtKey_25519() string {
@@ -621,6 +790,13 @@ func (x *Auth) GetLoginExpires() *durationpb.Duration {
 	return nil
 }
 
+func (x *Auth) GetAdminUserIds() []string {
+	if x != nil {
+		return x.AdminUserIds
+	}
+	return nil
+}
+
 type Cos struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
@@ -634,7 +810,7 @@ type Cos struct {
 
 func (x *Cos) Reset() {
 	*x = Cos{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -646,7 +822,7 @@ func (x *Cos) String() string {
 func (*Cos) ProtoMessage() {}
 
 func (x *Cos) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -659,7 +835,7 @@ func (x *Cos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cos.ProtoReflect.Descriptor instead.
 func (*Cos) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{7}
+	return file_conf_conf_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Cos) GetSecretId() string {
@@ -710,7 +886,7 @@ type S3 struct {
 
 func (x *S3) Reset() {
 	*x = S3{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -722,7 +898,7 @@ func (x *S3) String() string {
 func (*S3) ProtoMessage() {}
 
 func (x *S3) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,7 +911,7 @@ func (x *S3) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S3.ProtoReflect.Descriptor instead.
 func (*S3) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{8}
+	return file_conf_conf_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *S3) GetAccessKey() string {
@@ -783,7 +959,7 @@ type GeoIp struct {
 
 func (x *GeoIp) Reset() {
 	*x = GeoIp{}
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -795,7 +971,7 @@ func (x *GeoIp) String() string {
 func (*GeoIp) ProtoMessage() {}
 
 func (x *GeoIp) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,7 +984,7 @@ func (x *GeoIp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeoIp.ProtoReflect.Descriptor instead.
 func (*GeoIp) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{9}
+	return file_conf_conf_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GeoIp) GetFileBucket() string {
@@ -825,18 +1001,101 @@ func (x *GeoIp) GetFileKey() string {
 	return ""
 }
 
+type Crontab_Job struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 执行超时，超时后取消任务的context
+	Timeout *durationpb.Duration `protobuf:"bytes,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 上次执行未结束时的处理：skip跳过/queue排队一次/allow并发执行
+	Overlap string `protobuf:"bytes,2,opt,name=overlap,proto3" json:"overlap,omitempty"`
+	// 执行计划，包含秒，如"0 */5 * * * *"
+	Spec string `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	// 停止调度，仍可手动触发
+	Disabled bool `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// spec所在时区，如"Asia/Shanghai"，为空时使用crontab.timezone
+	Timezone      string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Crontab_Job) Reset() {
+	*x = Crontab_Job{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Crontab_Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Crontab_Job) ProtoMessage() {}
+
+func (x *Crontab_Job) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Crontab_Job.ProtoReflect.Descriptor instead.
+func (*Crontab_Job) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Crontab_Job) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Crontab_Job) GetOverlap() string {
+	if x != nil {
+		return x.Overlap
+	}
+	return ""
+}
+
+func (x *Crontab_Job) GetSpec() string {
+	if x != nil {
+		return x.Spec
+	}
+	return ""
+}
+
+func (x *Crontab_Job) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Crontab_Job) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 type Server_HTTP struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Network string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr    string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 允许跨域的origin，如"https://app.example.com"，为空时允许所有，支持热更新
+	CorsOrigins   []string `protobuf:"bytes,4,rep,name=cors_origins,json=corsOrigins,proto3" json:"cors_origins,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -848,7 +1107,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -861,7 +1120,7 @@ func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
 func (*Server_HTTP) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0}
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *Server_HTTP) GetNetwork() string {
@@ -885,6 +1144,13 @@ func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *Server_HTTP) GetCorsOrigins() []string {
+	if x != nil {
+		return x.CorsOrigins
+	}
+	return nil
+}
+
 type Server_GRPC struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -896,7 +1162,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -908,7 +1174,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,7 +1187,7 @@ func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
 func (*Server_GRPC) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1, 1}
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1}
 }
 
 func (x *Server_GRPC) GetNetwork() string {
@@ -956,7 +1222,7 @@ type Server_ASYNQ struct {
 
 func (x *Server_ASYNQ) Reset() {
 	*x = Server_ASYNQ{}
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -968,7 +1234,7 @@ func (x *Server_ASYNQ) String() string {
 func (*Server_ASYNQ) ProtoMessage() {}
 
 func (x *Server_ASYNQ) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1247,7 @@ func (x *Server_ASYNQ) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server_ASYNQ.ProtoReflect.Descriptor instead.
 func (*Server_ASYNQ) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1, 2}
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2}
 }
 
 func (x *Server_ASYNQ) GetRedisUri() string {
@@ -1005,25 +1271,357 @@ func (x *Server_ASYNQ) GetConcurrency() int32 {
 	return 0
 }
 
-type Data_Database struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Driver             string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
-	SlavesDsn          []string               `protobuf:"bytes,2,rep,name=slaves_dsn,json=slavesDsn,proto3" json:"slaves_dsn,omitempty"`
-	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	MaxOpenConnections int32                  `protobuf:"varint,4,opt,name=max_open_connections,json=maxOpenConnections,proto3" json:"max_open_connections,omitempty"`
-	MaxIdleConnections int32                  `protobuf:"varint,5,opt,name=max_idle_connections,json=maxIdleConnections,proto3" json:"max_idle_connections,omitempty"`
-	ConnMaxLifetime    *durationpb.Duration   `protobuf:"bytes,6,opt,name=conn_max_lifetime,json=connMaxLifetime,proto3" json:"conn_max_lifetime,omitempty"`
-	ConnMaxIdleTime    *durationpb.Duration   `protobuf:"bytes,7,opt,name=conn_max_idle_time,json=connMaxIdleTime,proto3" json:"conn_max_idle_time,omitempty"`
-	DefaultSchema      string                 `protobuf:"bytes,8,opt,name=default_schema,json=defaultSchema,proto3" json:"default_schema,omitempty"`
-	GormLevel          int32                  `protobuf:"varint,9,opt,name=gorm_level,json=gormLevel,proto3" json:"gorm_level,omitempty"`              // 4-info  3-warn
-	SlowThreshold      int32                  `protobuf:"varint,10,opt,name=slow_threshold,json=slowThreshold,proto3" json:"slow_threshold,omitempty"` // unit: millisecond
+// 事件总线
+type Server_EventBus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// asynq（默认）/nats/kafka
+	Driver string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	// 订阅组：同组实例分摊事件，不同组各自收到全部事件，默认服务名；asynq只支持一个组
+	Group string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	// 处理失败后的最大重试次数，默认3
+	MaxRetry int32                  `protobuf:"varint,3,opt,name=max_retry,json=maxRetry,proto3" json:"max_retry,omitempty"`
+	Nats     *Server_EventBus_NATS  `protobuf:"bytes,4,opt,name=nats,proto3" json:"nats,omitempty"`
+	Kafka    *Server_EventBus_Kafka `protobuf:"bytes,5,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	// 非asynq总线的定时事件到期扫描间隔，默认1s
+	SchedulePollInterval *durationpb.Duration `protobuf:"bytes,6,opt,name=schedule_poll_interval,json=schedulePollInterval,proto3" json:"schedule_poll_interval,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Server_EventBus) Reset() {
+	*x = Server_EventBus{}
+	mi := &file_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_EventBus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_EventBus) ProtoMessage() {}
+
+func (x *Server_EventBus) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_EventBus.ProtoReflect.Descriptor instead.
+func (*Server_EventBus) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Server_EventBus) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Server_EventBus) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *Server_EventBus) GetMaxRetry() int32 {
+	if x != nil {
+		return x.MaxRetry
+	}
+	return 0
+}
+
+func (x *Server_EventBus) GetNats() *Server_EventBus_NATS {
+	if x != nil {
+		return x.Nats
+	}
+	return nil
+}
+
+func (x *Server_EventBus) GetKafka() *Server_EventBus_Kafka {
+	if x != nil {
+		return x.Kafka
+	}
+	return nil
+}
+
+func (x *Server_EventBus) GetSchedulePollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.SchedulePollInterval
+	}
+	return nil
+}
+
+// 通过gRPC EventHandler接收其他服务推送的事件
+type Server_EventIngest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// true: 投递到事件总线后返回，由订阅者异步处理；false: 同步处理
+	Enqueue bool `protobuf:"varint,1,opt,name=enqueue,proto3" json:"enqueue,omitempty"`
+	// 调用方服务名 -> token，请求需在metadata中携带 x-service-name 和 x-service-token；未配置时拒绝所有调用
+	ServiceTokens map[string]string `protobuf:"bytes,2,rep,name=service_tokens,json=serviceTokens,proto3" json:"service_tokens,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_EventIngest) Reset() {
+	*x = Server_EventIngest{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_EventIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_EventIngest) ProtoMessage() {}
+
+func (x *Server_EventIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_EventIngest.ProtoReflect.Descriptor instead.
+func (*Server_EventIngest) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *Server_EventIngest) GetEnqueue() bool {
+	if x != nil {
+		return x.Enqueue
+	}
+	return false
+}
+
+func (x *Server_EventIngest) GetServiceTokens() map[string]string {
+	if x != nil {
+		return x.ServiceTokens
+	}
+	return nil
+}
+
+// HTTP路由策略，支持热更新
+type Server_RoutePolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 下线路由，请求返回ROUTE_DISABLED
+	Disabled      bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_RoutePolicy) Reset() {
+	*x = Server_RoutePolicy{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_RoutePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_RoutePolicy) ProtoMessage() {}
+
+func (x *Server_RoutePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_RoutePolicy.ProtoReflect.Descriptor instead.
+func (*Server_RoutePolicy) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *Server_RoutePolicy) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type Server_EventBus_NATS struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// JetStream stream名，默认EVENTS
+	Stream string `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	// subject前缀，事件的subject为 <subject_prefix>.<事件名>，默认events
+	SubjectPrefix string `protobuf:"bytes,3,opt,name=subject_prefix,json=subjectPrefix,proto3" json:"subject_prefix,omitempty"`
+	// 等待ack的时长，超时后重新投递，默认60s
+	AckWait *durationpb.Duration `protobuf:"bytes,4,opt,name=ack_wait,json=ackWait,proto3" json:"ack_wait,omitempty"`
+	// stream中事件的保留时长，默认7天
+	MaxAge        *durationpb.Duration `protobuf:"bytes,5,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_EventBus_NATS) Reset() {
+	*x = Server_EventBus_NATS{}
+	mi := &file_conf_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_EventBus_NATS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_EventBus_NATS) ProtoMessage() {}
+
+func (x *Server_EventBus_NATS) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_EventBus_NATS.ProtoReflect.Descriptor instead.
+func (*Server_EventBus_NATS) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3, 0}
+}
+
+func (x *Server_EventBus_NATS) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Server_EventBus_NATS) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *Server_EventBus_NATS) GetSubjectPrefix() string {
+	if x != nil {
+		return x.SubjectPrefix
+	}
+	return ""
+}
+
+func (x *Server_EventBus_NATS) GetAckWait() *durationpb.Duration {
+	if x != nil {
+		return x.AckWait
+	}
+	return nil
+}
+
+func (x *Server_EventBus_NATS) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+type Server_EventBus_Kafka struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Brokers []string               `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	// topic前缀，事件的topic为 <topic_prefix>.<事件名>，默认events
+	TopicPrefix string `protobuf:"bytes,2,opt,name=topic_prefix,json=topicPrefix,proto3" json:"topic_prefix,omitempty"`
+	// broker版本，默认2.8.0
+	Version       string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_EventBus_Kafka) Reset() {
+	*x = Server_EventBus_Kafka{}
+	mi := &file_conf_conf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_EventBus_Kafka) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_EventBus_Kafka) ProtoMessage() {}
+
+func (x *Server_EventBus_Kafka) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_EventBus_Kafka.ProtoReflect.Descriptor instead.
+func (*Server_EventBus_Kafka) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3, 1}
+}
+
+func (x *Server_EventBus_Kafka) GetBrokers() []string {
+	if x != nil {
+		return x.Brokers
+	}
+	return nil
+}
+
+func (x *Server_EventBus_Kafka) GetTopicPrefix() string {
+	if x != nil {
+		return x.TopicPrefix
+	}
+	return ""
+}
+
+func (x *Server_EventBus_Kafka) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type Data_Database struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Driver             string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	SlavesDsn          []string               `protobuf:"bytes,2,rep,name=slaves_dsn,json=slavesDsn,proto3" json:"slaves_dsn,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MaxOpenConnections int32                  `protobuf:"varint,4,opt,name=max_open_connections,json=maxOpenConnections,proto3" json:"max_open_connections,omitempty"`
+	MaxIdleConnections int32                  `protobuf:"varint,5,opt,name=max_idle_connections,json=maxIdleConnections,proto3" json:"max_idle_connections,omitempty"`
+	ConnMaxLifetime    *durationpb.Duration   `protobuf:"bytes,6,opt,name=conn_max_lifetime,json=connMaxLifetime,proto3" json:"conn_max_lifetime,omitempty"`
+	ConnMaxIdleTime    *durationpb.Duration   `protobuf:"bytes,7,opt,name=conn_max_idle_time,json=connMaxIdleTime,proto3" json:"conn_max_idle_time,omitempty"`
+	DefaultSchema      string                 `protobuf:"bytes,8,opt,name=default_schema,json=defaultSchema,proto3" json:"default_schema,omitempty"`
+	GormLevel          int32                  `protobuf:"varint,9,opt,name=gorm_level,json=gormLevel,proto3" json:"gorm_level,omitempty"`              // 4-info  3-warn
+	SlowThreshold      int32                  `protobuf:"varint,10,opt,name=slow_threshold,json=slowThreshold,proto3" json:"slow_threshold,omitempty"` // unit: millisecond
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[14]
+	mi := &file_conf_conf_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1035,7 +1633,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[14]
+	mi := &file_conf_conf_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1048,7 +1646,7 @@ func (x *Data_Database) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
 func (*Data_Database) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 0}
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *Data_Database) GetDriver() string {
@@ -1140,7 +1738,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[15]
+	mi := &file_conf_conf_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1152,7 +1750,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[15]
+	mi := &file_conf_conf_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1165,7 +1763,7 @@ func (x *Data_Redis) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
 func (*Data_Redis) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1}
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 1}
 }
 
 func (x *Data_Redis) GetIsCluster() bool {
@@ -1245,51 +1843,466 @@ func (x *Data_Redis) GetIdleTimeout() *durationpb.Duration {
 	return nil
 }
 
+// 事务性outbox的投递
+type Data_Outbox struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 扫描待投递事件的间隔，默认1s
+	PollInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// 每次扫描投递的最大事件数，默认100
+	BatchSize int32 `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// 最大投递次数，超过后标记为失败并告警，默认20
+	MaxAttempts int32 `protobuf:"varint,3,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// 已投递事件的保留时长，默认7天
+	Retention     *durationpb.Duration `protobuf:"bytes,4,opt,name=retention,proto3" json:"retention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Outbox) Reset() {
+	*x = Data_Outbox{}
+	mi := &file_conf_conf_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Outbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Outbox) ProtoMessage() {}
+
+func (x *Data_Outbox) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Outbox.ProtoReflect.Descriptor instead.
+func (*Data_Outbox) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *Data_Outbox) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Data_Outbox) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Data_Outbox) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Data_Outbox) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+// 事件消费幂等记录
+type Data_EventIdempotency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 已处理事件的保留时长，默认7天
+	Ttl *durationpb.Duration `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// 处理中的占用时长，超过后视为处理者已退出，其他实例可重新处理，默认2分钟
+	Lease         *durationpb.Duration `protobuf:"bytes,2,opt,name=lease,proto3" json:"lease,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_EventIdempotency) Reset() {
+	*x = Data_EventIdempotency{}
+	mi := &file_conf_conf_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_EventIdempotency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_EventIdempotency) ProtoMessage() {}
+
+func (x *Data_EventIdempotency) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_EventIdempotency.ProtoReflect.Descriptor instead.
+func (*Data_EventIdempotency) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 3}
+}
+
+func (x *Data_EventIdempotency) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Data_EventIdempotency) GetLease() *durationpb.Duration {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+// webhook推送
+type Data_Webhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 扫描待投递记录的间隔，默认1s
+	PollInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// 每次扫描投递的最大记录数，默认50
+	BatchSize int32 `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// 最大投递次数，超过后标记为失败并告警，默认10
+	MaxAttempts int32 `protobuf:"varint,3,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// 单次请求超时，默认10s
+	Timeout *durationpb.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 已结束投递记录的保留时长，默认30天
+	Retention *durationpb.Duration `protobuf:"bytes,5,opt,name=retention,proto3" json:"retention,omitempty"`
+	// 允许投递到回环、内网、链路本地地址，仅用于本地开发
+	AllowPrivateNetwork bool `protobuf:"varint,6,opt,name=allow_private_network,json=allowPrivateNetwork,proto3" json:"allow_private_network,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Data_Webhook) Reset() {
+	*x = Data_Webhook{}
+	mi := &file_conf_conf_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Webhook) ProtoMessage() {}
+
+func (x *Data_Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Webhook.ProtoReflect.Descriptor instead.
+func (*Data_Webhook) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 4}
+}
+
+func (x *Data_Webhook) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Data_Webhook) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Data_Webhook) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Data_Webhook) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Data_Webhook) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *Data_Webhook) GetAllowPrivateNetwork() bool {
+	if x != nil {
+		return x.AllowPrivateNetwork
+	}
+	return false
+}
+
+// 5xx错误自动告警
+type Alarm_ServerError struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// 采样率(0, 1]，未配置时全部告警
+	SampleRate float64 `protobuf:"fixed64,2,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	// 不告警的ErrorReason
+	ExcludeReasons []string `protobuf:"bytes,3,rep,name=exclude_reasons,json=excludeReasons,proto3" json:"exclude_reasons,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Alarm_ServerError) Reset() {
+	*x = Alarm_ServerError{}
+	mi := &file_conf_conf_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alarm_ServerError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alarm_ServerError) ProtoMessage() {}
+
+func (x *Alarm_ServerError) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alarm_ServerError.ProtoReflect.Descriptor instead.
+func (*Alarm_ServerError) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 2}
+}
+
+func (x *Alarm_ServerError) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Alarm_ServerError) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *Alarm_ServerError) GetExcludeReasons() []string {
+	if x != nil {
+		return x.ExcludeReasons
+	}
+	return nil
+}
+
+// 按platform的webhook发送限流（令牌桶，多副本通过Redis共享），未配置的platform不限流；webhook返回429时所有platform都按Retry-After暂停
+type Alarm_RateLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 每秒生成的令牌数
+	Rate float64 `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	// 桶容量，默认为 ceil(rate)
+	Burst int32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	// 限流期间积压的告警合并为一条发送，单条最多合并的告警数，默认20
+	MaxBatch      int32 `protobuf:"varint,3,opt,name=max_batch,json=maxBatch,proto3" json:"max_batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alarm_RateLimit) Reset() {
+	*x = Alarm_RateLimit{}
+	mi := &file_conf_conf_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alarm_RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alarm_RateLimit) ProtoMessage() {}
+
+func (x *Alarm_RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alarm_RateLimit.ProtoReflect.Descriptor instead.
+func (*Alarm_RateLimit) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 3}
+}
+
+func (x *Alarm_RateLimit) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *Alarm_RateLimit) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *Alarm_RateLimit) GetMaxBatch() int32 {
+	if x != nil {
+		return x.MaxBatch
+	}
+	return 0
+}
+
+var file_conf_conf_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         50000,
+		Name:          "kratos.api.sensitive",
+		Tag:           "varint,50000,opt,name=sensitive",
+		Filename:      "conf/conf.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// 敏感字段（密码、密钥、DSN、webhook地址等），查看配置时脱敏，见global.RedactConfig
+	//
+	// optional bool sensitive = 50000;
+	E_Sensitive = &file_conf_conf_proto_extTypes[0]
+)
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xbb\x03\n" +
-	"\tBootstrap\x12*\n" +
-	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
-	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12!\n" +
-	"\x03env\x18\x03 \x01(\x0e2\x0f.kratos.api.EnvR\x03env\x121\n" +
-	"\tlog_level\x18\x04 \x01(\x0e2\x14.kratos.api.LogLevelR\blogLevel\x12\x12\n" +
-	"\x04name\x18\x05 \x01(\tR\x04name\x12*\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/descriptor.proto\x1a\x1bbuf/validate/validate.proto\"\x9f\x04\n" +
+	"\tBootstrap\x122\n" +
+	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerB\x06\xbaH\x03\xc8\x01\x01R\x06server\x12,\n" +
+	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataB\x06\xbaH\x03\xc8\x01\x01R\x04data\x12+\n" +
+	"\x03env\x18\x03 \x01(\x0e2\x0f.kratos.api.EnvB\b\xbaH\x05\x82\x01\x02\x10\x01R\x03env\x12;\n" +
+	"\tlog_level\x18\x04 \x01(\x0e2\x14.kratos.api.LogLevelB\b\xbaH\x05\x82\x01\x02\x10\x01R\blogLevel\x12\x1b\n" +
+	"\x04name\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12*\n" +
 	"\x06sentry\x18\x06 \x01(\v2\x12.kratos.api.SentryR\x06sentry\x12-\n" +
 	"\atracing\x18\a \x01(\v2\x13.kratos.api.TracingR\atracing\x12'\n" +
-	"\x05alarm\x18\b \x01(\v2\x11.kratos.api.AlarmR\x05alarm\x12$\n" +
-	"\x04auth\x18\t \x01(\v2\x10.kratos.api.AuthR\x04auth\x12\x1e\n" +
+	"\x05alarm\x18\b \x01(\v2\x11.kratos.api.AlarmR\x05alarm\x12,\n" +
+	"\x04auth\x18\t \x01(\v2\x10.kratos.api.AuthB\x06\xbaH\x03\xc8\x01\x01R\x04auth\x12\x1e\n" +
 	"\x02s3\x18\n" +
 	" \x01(\v2\x0e.kratos.api.S3R\x02s3\x12(\n" +
-	"\x06geo_ip\x18\v \x01(\v2\x11.kratos.api.GeoIpR\x05geoIp\"\xaa\x04\n" +
-	"\x06Server\x12+\n" +
-	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
+	"\x06geo_ip\x18\v \x01(\v2\x11.kratos.api.GeoIpR\x05geoIp\x12-\n" +
+	"\acrontab\x18\f \x01(\v2\x13.kratos.api.CrontabR\acrontab\"\xbe\x03\n" +
+	"\aCrontab\x121\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x1d.kratos.api.Crontab.JobsEntryR\x04jobs\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12H\n" +
+	"\rrun_retention\x18\x03 \x01(\v2\x19.google.protobuf.DurationB\b\xbaH\x05\xaa\x01\x022\x00R\frunRetention\x1a\xc7\x01\n" +
+	"\x03Job\x12=\n" +
+	"\atimeout\x18\x01 \x01(\v2\x19.google.protobuf.DurationB\b\xbaH\x05\xaa\x01\x022\x00R\atimeout\x125\n" +
+	"\aoverlap\x18\x02 \x01(\tB\x1b\xbaH\x18r\x16R\x00R\x04skipR\x05queueR\x05allowR\aoverlap\x12\x12\n" +
+	"\x04spec\x18\x03 \x01(\tR\x04spec\x12\x1a\n" +
+	"\bdisabled\x18\x04 \x01(\bR\bdisabled\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x1aP\n" +
+	"\tJobsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.kratos.api.Crontab.JobR\x05value:\x028\x01\"\xe8\r\n" +
+	"\x06Server\x123\n" +
+	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPB\x06\xbaH\x03\xc8\x01\x01R\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12.\n" +
-	"\x05asynq\x18\x03 \x01(\v2\x18.kratos.api.Server.ASYNQR\x05asynq\x1ai\n" +
+	"\x05asynq\x18\x03 \x01(\v2\x18.kratos.api.Server.ASYNQR\x05asynq\x128\n" +
+	"\tevent_bus\x18\x04 \x01(\v2\x1b.kratos.api.Server.EventBusR\beventBus\x12A\n" +
+	"\fevent_ingest\x18\x05 \x01(\v2\x1e.kratos.api.Server.EventIngestR\veventIngest\x12[\n" +
+	"\x0eroute_policies\x18\x06 \x03(\v2%.kratos.api.Server.RoutePoliciesEntryB\r\xbaH\n" +
+	"\x9a\x01\a\"\x05r\x03:\x01/R\rroutePolicies\x1a\xa3\x01\n" +
 	"\x04HTTP\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x1b\n" +
+	"\x04addr\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12/\n" +
+	"\fcors_origins\x18\x04 \x03(\tB\f\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01R\vcorsOrigins\x1ai\n" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\xbf\x01\n" +
-	"\x05ASYNQ\x12\x1b\n" +
-	"\tredis_uri\x18\x01 \x01(\tR\bredisUri\x12<\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\xc5\x01\n" +
+	"\x05ASYNQ\x12!\n" +
+	"\tredis_uri\x18\x01 \x01(\tB\x04\x80\xb5\x18\x01R\bredisUri\x12<\n" +
 	"\x06queues\x18\x02 \x03(\v2$.kratos.api.Server.ASYNQ.QueuesEntryR\x06queues\x12 \n" +
 	"\vconcurrency\x18\x03 \x01(\x05R\vconcurrency\x1a9\n" +
 	"\vQueuesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xcd\a\n" +
-	"\x04Data\x125\n" +
-	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
-	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x1a\xb5\x03\n" +
-	"\bDatabase\x12\x16\n" +
-	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x1d\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a\xbf\x04\n" +
+	"\bEventBus\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12\x1b\n" +
+	"\tmax_retry\x18\x03 \x01(\x05R\bmaxRetry\x124\n" +
+	"\x04nats\x18\x04 \x01(\v2 .kratos.api.Server.EventBus.NATSR\x04nats\x127\n" +
+	"\x05kafka\x18\x05 \x01(\v2!.kratos.api.Server.EventBus.KafkaR\x05kafka\x12O\n" +
+	"\x16schedule_poll_interval\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x14schedulePollInterval\x1a\xc7\x01\n" +
+	"\x04NATS\x12\x16\n" +
+	"\x03url\x18\x01 \x01(\tB\x04\x80\xb5\x18\x01R\x03url\x12\x16\n" +
+	"\x06stream\x18\x02 \x01(\tR\x06stream\x12%\n" +
+	"\x0esubject_prefix\x18\x03 \x01(\tR\rsubjectPrefix\x124\n" +
+	"\back_wait\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\aackWait\x122\n" +
+	"\amax_age\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\x1a^\n" +
+	"\x05Kafka\x12\x18\n" +
+	"\abrokers\x18\x01 \x03(\tR\abrokers\x12!\n" +
+	"\ftopic_prefix\x18\x02 \x01(\tR\vtopicPrefix\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x1a\xc9\x01\n" +
+	"\vEventIngest\x12\x18\n" +
+	"\aenqueue\x18\x01 \x01(\bR\aenqueue\x12^\n" +
+	"\x0eservice_tokens\x18\x02 \x03(\v21.kratos.api.Server.EventIngest.ServiceTokensEntryB\x04\x80\xb5\x18\x01R\rserviceTokens\x1a@\n" +
+	"\x12ServiceTokensEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a)\n" +
+	"\vRoutePolicy\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x1a`\n" +
+	"\x12RoutePoliciesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.kratos.api.Server.RoutePolicyR\x05value:\x028\x01\"\x98\x0e\n" +
+	"\x04Data\x12=\n" +
+	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseB\x06\xbaH\x03\xc8\x01\x01R\bdatabase\x124\n" +
+	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisB\x06\xbaH\x03\xc8\x01\x01R\x05redis\x12/\n" +
+	"\x06outbox\x18\x03 \x01(\v2\x17.kratos.api.Data.OutboxR\x06outbox\x12N\n" +
+	"\x11event_idempotency\x18\x04 \x01(\v2!.kratos.api.Data.EventIdempotencyR\x10eventIdempotency\x122\n" +
+	"\awebhook\x18\x05 \x01(\v2\x18.kratos.api.Data.WebhookR\awebhook\x1a\xc4\x03\n" +
+	"\bDatabase\x12\x1f\n" +
+	"\x06driver\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06driver\x12#\n" +
 	"\n" +
-	"slaves_dsn\x18\x02 \x03(\tR\tslavesDsn\x12\x12\n" +
+	"slaves_dsn\x18\x02 \x03(\tB\x04\x80\xb5\x18\x01R\tslavesDsn\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x120\n" +
 	"\x14max_open_connections\x18\x04 \x01(\x05R\x12maxOpenConnections\x120\n" +
 	"\x14max_idle_connections\x18\x05 \x01(\x05R\x12maxIdleConnections\x12E\n" +
@@ -1299,53 +2312,96 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"gorm_level\x18\t \x01(\x05R\tgormLevel\x12%\n" +
 	"\x0eslow_threshold\x18\n" +
-	" \x01(\x05R\rslowThreshold\x1a\xa7\x03\n" +
+	" \x01(\x05R\rslowThreshold\x1a\xb6\x03\n" +
 	"\x05Redis\x12\x1d\n" +
 	"\n" +
-	"is_cluster\x18\x01 \x01(\bR\tisCluster\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
+	"is_cluster\x18\x01 \x01(\bR\tisCluster\x12\x1b\n" +
+	"\x04addr\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04addr\x12<\n" +
 	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
 	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x12\x0e\n" +
 	"\x02db\x18\x05 \x01(\x05R\x02db\x12\x1a\n" +
-	"\busername\x18\x06 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\a \x01(\tR\bpassword\x12\x1b\n" +
+	"\busername\x18\x06 \x01(\tR\busername\x12 \n" +
+	"\bpassword\x18\a \x01(\tB\x04\x80\xb5\x18\x01R\bpassword\x12\x1b\n" +
 	"\tpool_size\x18\b \x01(\rR\bpoolSize\x12\"\n" +
 	"\rmin_idle_conn\x18\t \x01(\rR\vminIdleConn\x12&\n" +
 	"\x0fmax_retry_times\x18\n" +
 	" \x01(\rR\rmaxRetryTimes\x12<\n" +
-	"\fidle_timeout\x18\v \x01(\v2\x19.google.protobuf.DurationR\vidleTimeout\"E\n" +
+	"\fidle_timeout\x18\v \x01(\v2\x19.google.protobuf.DurationR\vidleTimeout\x1a\xc3\x01\n" +
+	"\x06Outbox\x12>\n" +
+	"\rpoll_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x12!\n" +
+	"\fmax_attempts\x18\x03 \x01(\x05R\vmaxAttempts\x127\n" +
+	"\tretention\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\tretention\x1ap\n" +
+	"\x10EventIdempotency\x12+\n" +
+	"\x03ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12/\n" +
+	"\x05lease\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x05lease\x1a\xad\x02\n" +
+	"\aWebhook\x12>\n" +
+	"\rpoll_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x12!\n" +
+	"\fmax_attempts\x18\x03 \x01(\x05R\vmaxAttempts\x123\n" +
+	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x127\n" +
+	"\tretention\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\tretention\x122\n" +
+	"\x15allow_private_network\x18\x06 \x01(\bR\x13allowPrivateNetwork\"\xd8\x01\n" +
 	"\aTracing\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\"H\n" +
-	"\x06Sentry\x12\x10\n" +
-	"\x03dsn\x18\x01 \x01(\tR\x03dsn\x12,\n" +
-	"\x12attach_stack_trace\x18\x02 \x01(\bR\x10attachStackTrace\"\x82\x03\n" +
-	"\x05Alarm\x12<\n" +
-	"\tweb_hooks\x18\x01 \x03(\v2\x1f.kratos.api.Alarm.WebHooksEntryR\bwebHooks\x12M\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type:\x90\x01\xbaH\x8c\x01\x1a\x89\x01\n" +
+	"\ftracing.host\x12Bport must be positive and type is required when host is configured\x1a5this.host == '' || (this.port > 0 && this.type != '')\"N\n" +
+	"\x06Sentry\x12\x16\n" +
+	"\x03dsn\x18\x01 \x01(\tB\x04\x80\xb5\x18\x01R\x03dsn\x12,\n" +
+	"\x12attach_stack_trace\x18\x02 \x01(\bR\x10attachStackTrace\"\xc5\n" +
+	"\n" +
+	"\x05Alarm\x12B\n" +
+	"\tweb_hooks\x18\x01 \x03(\v2\x1f.kratos.api.Alarm.WebHooksEntryB\x04\x80\xb5\x18\x01R\bwebHooks\x12M\n" +
 	"\x15cache_ignore_duration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x13cacheIgnoreDuration\x12I\n" +
 	"\x13cache_fuse_duration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x11cacheFuseDuration\x12)\n" +
 	"\x10default_platform\x18\x04 \x01(\tR\x0fdefaultPlatform\x12\x17\n" +
 	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12 \n" +
-	"\vconcurrency\x18\x06 \x01(\x05R\vconcurrency\x1a;\n" +
+	"\vconcurrency\x18\x06 \x01(\x05R\vconcurrency\x12T\n" +
+	"\bchannels\x18\a \x03(\v2\x1f.kratos.api.Alarm.ChannelsEntryB\x17\xbaH\x14\x9a\x01\x11*\x0fr\rR\x04larkR\x05slackR\bchannels\x12@\n" +
+	"\fserver_error\x18\b \x01(\v2\x1d.kratos.api.Alarm.ServerErrorR\vserverError\x12.\n" +
+	"\x13fallback_queue_size\x18\t \x01(\x05R\x11fallbackQueueSize\x12B\n" +
+	"\vrate_limits\x18\n" +
+	" \x03(\v2!.kratos.api.Alarm.RateLimitsEntryR\n" +
+	"rateLimits\x12I\n" +
+	"\x13ack_resolve_timeout\x18\v \x01(\v2\x19.google.protobuf.DurationR\x11ackResolveTimeout\x1a;\n" +
 	"\rWebHooksEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"j\n" +
-	"\x04Auth\x12\"\n" +
-	"\rjwt_key_25519\x18\x01 \x01(\tR\vjwtKey25519\x12>\n" +
-	"\rlogin_expires\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\floginExpires\"\x85\x01\n" +
-	"\x03Cos\x12\x1b\n" +
-	"\tsecret_id\x18\x01 \x01(\tR\bsecretId\x12\x1d\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rChannelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\x8a\x01\n" +
+	"\vServerError\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x128\n" +
+	"\vsample_rate\x18\x02 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\n" +
+	"sampleRate\x12'\n" +
+	"\x0fexclude_reasons\x18\x03 \x03(\tR\x0eexcludeReasons\x1at\n" +
+	"\tRateLimit\x12\"\n" +
+	"\x04rate\x18\x01 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x04rate\x12\x1d\n" +
+	"\x05burst\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x05burst\x12$\n" +
+	"\tmax_batch\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bmaxBatch\x1aZ\n" +
+	"\x0fRateLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x121\n" +
+	"\x05value\x18\x02 \x01(\v2\x1b.kratos.api.Alarm.RateLimitR\x05value:\x028\x01:\xc7\x01\xbaH\xc3\x01\x1a\xc0\x01\n" +
+	"\x16alarm.default_platform\x12Ldefault_platform with a configured webhook is required when alarm is enabled\x1aXthis.dry_run || (this.default_platform != '' && this.default_platform in this.web_hooks)\"\xb8\x01\n" +
+	"\x04Auth\x12/\n" +
+	"\rjwt_key_25519\x18\x01 \x01(\tB\v\xbaH\x04r\x02\x10\x01\x80\xb5\x18\x01R\vjwtKey25519\x12K\n" +
+	"\rlogin_expires\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\v\xbaH\b\xc8\x01\x01\xaa\x01\x02*\x00R\floginExpires\x122\n" +
+	"\x0eadmin_user_ids\x18\x03 \x03(\tB\f\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01R\fadminUserIds\"\x91\x01\n" +
+	"\x03Cos\x12!\n" +
+	"\tsecret_id\x18\x01 \x01(\tB\x04\x80\xb5\x18\x01R\bsecretId\x12#\n" +
 	"\n" +
-	"secret_key\x18\x02 \x01(\tR\tsecretKey\x12\x16\n" +
+	"secret_key\x18\x02 \x01(\tB\x04\x80\xb5\x18\x01R\tsecretKey\x12\x16\n" +
 	"\x06region\x18\x03 \x01(\tR\x06region\x12\x16\n" +
 	"\x06bucket\x18\x04 \x01(\tR\x06bucket\x12\x12\n" +
-	"\x04host\x18\x05 \x01(\tR\x04host\"\x86\x01\n" +
-	"\x02S3\x12\x1d\n" +
+	"\x04host\x18\x05 \x01(\tR\x04host\"\x92\x01\n" +
+	"\x02S3\x12#\n" +
 	"\n" +
-	"access_key\x18\x01 \x01(\tR\taccessKey\x12\x1d\n" +
+	"access_key\x18\x01 \x01(\tB\x04\x80\xb5\x18\x01R\taccessKey\x12#\n" +
 	"\n" +
-	"secret_key\x18\x02 \x01(\tR\tsecretKey\x12\x16\n" +
+	"secret_key\x18\x02 \x01(\tB\x04\x80\xb5\x18\x01R\tsecretKey\x12\x16\n" +
 	"\x06region\x18\x03 \x01(\tR\x06region\x12\x16\n" +
 	"\x06bucket\x18\x04 \x01(\tR\x06bucket\x12\x12\n" +
 	"\x04host\x18\x05 \x01(\tR\x04host\"C\n" +
@@ -1364,7 +2420,8 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04Warn\x10\x02\x12\t\n" +
 	"\x05Error\x10\x03\x12\t\n" +
 	"\x05Fatal\x10\x04\x12\t\n" +
-	"\x05Panic\x10\x05B\x18Z\x16web/internal/conf;confb\x06proto3"
+	"\x05Panic\x10\x05:=\n" +
+	"\tsensitive\x12\x1d.google.protobuf.FieldOptions\x18І\x03 \x01(\bR\tsensitiveB\x18Z\x16web/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -1379,62 +2436,111 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 }
 
 var file_conf_conf_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_conf_conf_proto_goTypes = []any{
-	(Env)(0),                    // 0: kratos.api.Env
-	(LogLevel)(0),               // 1: kratos.api.LogLevel
-	(*Bootstrap)(nil),           // 2: kratos.api.Bootstrap
-	(*Server)(nil),              // 3: kratos.api.Server
-	(*Data)(nil),                // 4: kratos.api.Data
-	(*Tracing)(nil),             // 5: kratos.api.Tracing
-	(*Sentry)(nil),              // 6: kratos.api.Sentry
-	(*Alarm)(nil),               // 7: kratos.api.Alarm
-	(*Auth)(nil),                // 8: kratos.api.Auth
-	(*Cos)(nil),                 // 9: kratos.api.Cos
-	(*S3)(nil),                  // 10: kratos.api.S3
-	(*GeoIp)(nil),               // 11: kratos.api.GeoIp
-	(*Server_HTTP)(nil),         // 12: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 13: kratos.api.Server.GRPC
-	(*Server_ASYNQ)(nil),        // 14: kratos.api.Server.ASYNQ
-	nil,                         // 15: kratos.api.Server.ASYNQ.QueuesEntry
-	(*Data_Database)(nil),       // 16: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 17: kratos.api.Data.Redis
-	nil,                         // 18: kratos.api.Alarm.WebHooksEntry
-	(*durationpb.Duration)(nil), // 19: google.protobuf.Duration
+	(Env)(0),                          // 0: kratos.api.Env
+	(LogLevel)(0),                     // 1: kratos.api.LogLevel
+	(*Bootstrap)(nil),                 // 2: kratos.api.Bootstrap
+	(*Crontab)(nil),                   // 3: kratos.api.Crontab
+	(*Server)(nil),                    // 4: kratos.api.Server
+	(*Data)(nil),                      // 5: kratos.api.Data
+	(*Tracing)(nil),                   // 6: kratos.api.Tracing
+	(*Sentry)(nil),                    // 7: kratos.api.Sentry
+	(*Alarm)(nil),                     // 8: kratos.api.Alarm
+	(*Auth)(nil),                      // 9: kratos.api.Auth
+	(*Cos)(nil),                       // 10: kratos.api.Cos
+	(*S3)(nil),                        // 11: kratos.api.S3
+	(*GeoIp)(nil),                     // 12: kratos.api.GeoIp
+	(*Crontab_Job)(nil),               // 13: kratos.api.Crontab.Job
+	nil,                               // 14: kratos.api.Crontab.JobsEntry
+	(*Server_HTTP)(nil),               // 15: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),               // 16: kratos.api.Server.GRPC
+	(*Server_ASYNQ)(nil),              // 17: kratos.api.Server.ASYNQ
+	(*Server_EventBus)(nil),           // 18: kratos.api.Server.EventBus
+	(*Server_EventIngest)(nil),        // 19: kratos.api.Server.EventIngest
+	(*Server_RoutePolicy)(nil),        // 20: kratos.api.Server.RoutePolicy
+	nil,                               // 21: kratos.api.Server.RoutePoliciesEntry
+	nil,                               // 22: kratos.api.Server.ASYNQ.QueuesEntry
+	(*Server_EventBus_NATS)(nil),      // 23: kratos.api.Server.EventBus.NATS
+	(*Server_EventBus_Kafka)(nil),     // 24: kratos.api.Server.EventBus.Kafka
+	nil,                               // 25: kratos.api.Server.EventIngest.ServiceTokensEntry
+	(*Data_Database)(nil),             // 26: kratos.api.Data.Database
+	(*Data_Redis)(nil),                // 27: kratos.api.Data.Redis
+	(*Data_Outbox)(nil),               // 28: kratos.api.Data.Outbox
+	(*Data_EventIdempotency)(nil),     // 29: kratos.api.Data.EventIdempotency
+	(*Data_Webhook)(nil),              // 30: kratos.api.Data.Webhook
+	nil,                               // 31: kratos.api.Alarm.WebHooksEntry
+	nil,                               // 32: kratos.api.Alarm.ChannelsEntry
+	(*Alarm_ServerError)(nil),         // 33: kratos.api.Alarm.ServerError
+	(*Alarm_RateLimit)(nil),           // 34: kratos.api.Alarm.RateLimit
+	nil,                               // 35: kratos.api.Alarm.RateLimitsEntry
+	(*durationpb.Duration)(nil),       // 36: google.protobuf.Duration
+	(*descriptorpb.FieldOptions)(nil), // 37: google.protobuf.FieldOptions
 }
 var file_conf_conf_proto_depIdxs = []int32{
-	3,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
-	4,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
+	4,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
+	5,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
 	0,  // 2: kratos.api.Bootstrap.env:type_name -> kratos.api.Env
 	1,  // 3: kratos.api.Bootstrap.log_level:type_name -> kratos.api.LogLevel
-	6,  // 4: kratos.api.Bootstrap.sentry:type_name -> kratos.api.Sentry
-	5,  // 5: kratos.api.Bootstrap.tracing:type_name -> kratos.api.Tracing
-	7,  // 6: kratos.api.Bootstrap.alarm:type_name -> kratos.api.Alarm
-	8,  // 7: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
-	10, // 8: kratos.api.Bootstrap.s3:type_name -> kratos.api.S3
-	11, // 9: kratos.api.Bootstrap.geo_ip:type_name -> kratos.api.GeoIp
-	12, // 10: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	13, // 11: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	14, // 12: kratos.api.Server.asynq:type_name -> kratos.api.Server.ASYNQ
-	16, // 13: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	17, // 14: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	18, // 15: kratos.api.Alarm.web_hooks:type_name -> kratos.api.Alarm.WebHooksEntry
-	19, // 16: kratos.api.Alarm.cache_ignore_duration:type_name -> google.protobuf.Duration
-	19, // 17: kratos.api.Alarm.cache_fuse_duration:type_name -> google.protobuf.Duration
-	19, // 18: kratos.api.Auth.login_expires:type_name -> google.protobuf.Duration
-	19, // 19: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	19, // 20: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	15, // 21: kratos.api.Server.ASYNQ.queues:type_name -> kratos.api.Server.ASYNQ.QueuesEntry
-	19, // 22: kratos.api.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
-	19, // 23: kratos.api.Data.Database.conn_max_idle_time:type_name -> google.protobuf.Duration
-	19, // 24: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	19, // 25: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	19, // 26: kratos.api.Data.Redis.idle_timeout:type_name -> google.protobuf.Duration
-	27, // [27:27] is the sub-list for method output_type
-	27, // [27:27] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	7,  // 4: kratos.api.Bootstrap.sentry:type_name -> kratos.api.Sentry
+	6,  // 5: kratos.api.Bootstrap.tracing:type_name -> kratos.api.Tracing
+	8,  // 6: kratos.api.Bootstrap.alarm:type_name -> kratos.api.Alarm
+	9,  // 7: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
+	11, // 8: kratos.api.Bootstrap.s3:type_name -> kratos.api.S3
+	12, // 9: kratos.api.Bootstrap.geo_ip:type_name -> kratos.api.GeoIp
+	3,  // 10: kratos.api.Bootstrap.crontab:type_name -> kratos.api.Crontab
+	14, // 11: kratos.api.Crontab.jobs:type_name -> kratos.api.Crontab.JobsEntry
+	36, // 12: kratos.api.Crontab.run_retention:type_name -> google.protobuf.Duration
+	15, // 13: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	16, // 14: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	17, // 15: kratos.api.Server.asynq:type_name -> kratos.api.Server.ASYNQ
+	18, // 16: kratos.api.Server.event_bus:type_name -> kratos.api.Server.EventBus
+	19, // 17: kratos.api.Server.event_ingest:type_name -> kratos.api.Server.EventIngest
+	21, // 18: kratos.api.Server.route_policies:type_name -> kratos.api.Server.RoutePoliciesEntry
+	26, // 19: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	27, // 20: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	28, // 21: kratos.api.Data.outbox:type_name -> kratos.api.Data.Outbox
+	29, // 22: kratos.api.Data.event_idempotency:type_name -> kratos.api.Data.EventIdempotency
+	30, // 23: kratos.api.Data.webhook:type_name -> kratos.api.Data.Webhook
+	31, // 24: kratos.api.Alarm.web_hooks:type_name -> kratos.api.Alarm.WebHooksEntry
+	36, // 25: kratos.api.Alarm.cache_ignore_duration:type_name -> google.protobuf.Duration
+	36, // 26: kratos.api.Alarm.cache_fuse_duration:type_name -> google.protobuf.Duration
+	32, // 27: kratos.api.Alarm.channels:type_name -> kratos.api.Alarm.ChannelsEntry
+	33, // 28: kratos.api.Alarm.server_error:type_name -> kratos.api.Alarm.ServerError
+	35, // 29: kratos.api.Alarm.rate_limits:type_name -> kratos.api.Alarm.RateLimitsEntry
+	36, // 30: kratos.api.Alarm.ack_resolve_timeout:type_name -> google.protobuf.Duration
+	36, // 31: kratos.api.Auth.login_expires:type_name -> google.protobuf.Duration
+	36, // 32: kratos.api.Crontab.Job.timeout:type_name -> google.protobuf.Duration
+	13, // 33: kratos.api.Crontab.JobsEntry.value:type_name -> kratos.api.Crontab.Job
+	36, // 34: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	36, // 35: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	22, // 36: kratos.api.Server.ASYNQ.queues:type_name -> kratos.api.Server.ASYNQ.QueuesEntry
+	23, // 37: kratos.api.Server.EventBus.nats:type_name -> kratos.api.Server.EventBus.NATS
+	24, // 38: kratos.api.Server.EventBus.kafka:type_name -> kratos.api.Server.EventBus.Kafka
+	36, // 39: kratos.api.Server.EventBus.schedule_poll_interval:type_name -> google.protobuf.Duration
+	25, // 40: kratos.api.Server.EventIngest.service_tokens:type_name -> kratos.api.Server.EventIngest.ServiceTokensEntry
+	20, // 41: kratos.api.Server.RoutePoliciesEntry.value:type_name -> kratos.api.Server.RoutePolicy
+	36, // 42: kratos.api.Server.EventBus.NATS.ack_wait:type_name -> google.protobuf.Duration
+	36, // 43: kratos.api.Server.EventBus.NATS.max_age:type_name -> google.protobuf.Duration
+	36, // 44: kratos.api.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
+	36, // 45: kratos.api.Data.Database.conn_max_idle_time:type_name -> google.protobuf.Duration
+	36, // 46: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	36, // 47: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	36, // 48: kratos.api.Data.Redis.idle_timeout:type_name -> google.protobuf.Duration
+	36, // 49: kratos.api.Data.Outbox.poll_interval:type_name -> google.protobuf.Duration
+	36, // 50: kratos.api.Data.Outbox.retention:type_name -> google.protobuf.Duration
+	36, // 51: kratos.api.Data.EventIdempotency.ttl:type_name -> google.protobuf.Duration
+	36, // 52: kratos.api.Data.EventIdempotency.lease:type_name -> google.protobuf.Duration
+	36, // 53: kratos.api.Data.Webhook.poll_interval:type_name -> google.protobuf.Duration
+	36, // 54: kratos.api.Data.Webhook.timeout:type_name -> google.protobuf.Duration
+	36, // 55: kratos.api.Data.Webhook.retention:type_name -> google.protobuf.Duration
+	34, // 56: kratos.api.Alarm.RateLimitsEntry.value:type_name -> kratos.api.Alarm.RateLimit
+	37, // 57: kratos.api.sensitive:extendee -> google.protobuf.FieldOptions
+	58, // [58:58] is the sub-list for method output_type
+	58, // [58:58] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	57, // [57:58] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -1448,14 +2554,15 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   17,
-			NumExtensions: 0,
+			NumMessages:   34,
+			NumExtensions: 1,
 			NumServices:   0,
 		},
 		GoTypes:           file_conf_conf_proto_goTypes,
 		DependencyIndexes: file_conf_conf_proto_depIdxs,
 		EnumInfos:         file_conf_conf_proto_enumTypes,
 		MessageInfos:      file_conf_conf_proto_msgTypes,
+		ExtensionInfos:    file_conf_conf_proto_extTypes,
 	}.Build()
 	File_conf_conf_proto = out.File
 	file_conf_conf_proto_goTypes = nil
@@ -1,20 +1,24 @@
 package crontab
 
 import (
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
-	"github.com/seanbit/kratos/webkit/transport/crontab"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
 )
 
 // ProviderSet is biz providers.
 var ProviderSet = wire.NewSet(
 	NewJobTest,
+	NewJobRunCleanup,
 	NewJobRegister,
+	NewServer,
 )
 
-func NewJobRegister(test *JobTest) crontab.JobRegister {
-	return &JobRegister{
-		jobs: []crontab.Job{
-			NewJobWrap("test", "0 * * * * *", test),
-		},
-	}
+func NewJobRegister(config *conf.Crontab, locker biz.IJobLockRepo, control biz.IJobControlRepo, history *biz.JobHistory, logger log.Logger,
+	test *JobTest, runCleanup *JobRunCleanup) *JobRegister {
+	return RegisterJobs(locker, control, history, config, logger,
+		NewJobWrap("test", "0 * * * * *", test, Singleton()),
+		NewJobWrap("job_run_cleanup", "0 0 * * * *", runCleanup, Singleton()),
+	)
 }
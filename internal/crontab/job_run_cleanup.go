@@ -0,0 +1,27 @@
+package crontab
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/global"
+)
+
+// JobRunCleanup 删除超过crontab.run_retention的任务执行记录，保留时长在每次执行时读取，支持热更新
+type JobRunCleanup struct {
+	history *biz.JobHistory
+}
+
+func NewJobRunCleanup(history *biz.JobHistory) *JobRunCleanup {
+	return &JobRunCleanup{history: history}
+}
+
+func (job *JobRunCleanup) Run(ctx context.Context) error {
+	retention := global.GetConfig().GetCrontab().GetRunRetention().AsDuration()
+	deleted, err := job.history.Cleanup(ctx, retention)
+	if deleted > 0 {
+		log.Context(ctx).Infof("cleanup %d job runs", deleted)
+	}
+	return err
+}
@@ -1,6 +1,10 @@
 package crontab
 
-import "github.com/go-kratos/kratos/v2/log"
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
 
 type JobTest struct {
 }
@@ -9,6 +13,7 @@ func NewJobTest() *JobTest {
 	return &JobTest{}
 }
 
-func (job *JobTest) Run() {
-	log.Debugf("job test run")
+func (job *JobTest) Run(ctx context.Context) error {
+	log.Context(ctx).Debugf("job test run")
+	return nil
 }
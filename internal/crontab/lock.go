@@ -0,0 +1,321 @@
+package crontab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/global"
+)
+
+// 跳过执行的原因
+const (
+	skipReasonLocked    = "locked"
+	skipReasonFired     = "fired"
+	skipReasonLockError = "lock_error"
+	skipReasonPaused    = "paused"
+	skipReasonDisabled  = "disabled"
+	skipReasonOverlap   = "overlap"
+)
+
+var (
+	// errJobTimeout 执行超时
+	errJobTimeout = errors.New("crontab job timeout")
+	// errJobLockLost 锁丢失
+	errJobLockLost = errors.New("crontab job lock lost")
+	// errJobAbandoned 任务被取消后未在stopGracePeriod内返回
+	errJobAbandoned = errors.New("crontab job abandoned")
+)
+
+// ErrJobSkipped 任务已暂停、已停止调度或锁被其他实例持有，本次未执行
+var ErrJobSkipped = errors.New("crontab job skipped")
+
+// Run cron调用入口：任务已暂停时跳过，否则按mode加锁后执行；
+// cron按秒触发，本次的计划执行时间为当前时间所在的秒，各实例按此认领，时钟偏差不会导致重复执行
+func (job *JobWrap) Run() {
+	ctx := context.WithValue(context.Background(), jobFireTimeKey{}, time.Now().Truncate(time.Second))
+	_ = job.RunOnce(ctx, nil)
+}
+
+type jobFireTimeKey struct{}
+
+type abandonedRunsKey struct{}
+
+// abandonedRuns 一次执行中被放弃但仍在运行的任务
+type abandonedRuns struct {
+	wg    sync.WaitGroup
+	count atomic.Int32
+}
+
+// add done在任务返回时可读
+func (a *abandonedRuns) add(done <-chan error) {
+	a.count.Add(1)
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		<-done
+	}()
+}
+
+// RunOnce 同步执行一次：任务已暂停、已停止调度或未取得锁时返回ErrJobSkipped，否则返回任务的错误
+func (job *JobWrap) RunOnce(ctx context.Context, params map[string]string) error {
+	if job.Disabled() {
+		job.log.Infof("crontab job %s skipped, disabled in config", job.name)
+		jobSkippedCounter.WithLabelValues(job.name, skipReasonDisabled).Inc()
+		return fmt.Errorf("%w: disabled", ErrJobSkipped)
+	}
+	paused, err := job.control.IsPaused(ctx, job.name)
+	if err != nil {
+		// 无法读取暂停标记时照常执行
+		job.log.Warnf("crontab job %s check paused failed: %v", job.name, err)
+	}
+	if paused {
+		job.log.Infof("crontab job %s skipped, paused", job.name)
+		jobSkippedCounter.WithLabelValues(job.name, skipReasonPaused).Inc()
+		return fmt.Errorf("%w: paused", ErrJobSkipped)
+	}
+	if params != nil {
+		ctx = context.WithValue(ctx, jobParamsKey{}, params)
+	}
+	return job.execute(ctx)
+}
+
+// Trigger 在本实例后台立即执行一次，忽略暂停标记与停止调度；加锁规则与定时执行相同，结果见执行记录
+func (job *JobWrap) Trigger(params map[string]string) {
+	ctx := context.WithValue(context.Background(), jobParamsKey{}, params)
+	go func() {
+		_ = job.execute(ctx)
+	}()
+}
+
+func (job *JobWrap) execute(ctx context.Context) error {
+	overlap := job.settings.Load().overlap
+	release, ok := job.guard.enter(overlap)
+	if !ok {
+		job.log.Infof("crontab job %s skipped, previous run is still running (overlap policy %s)", job.name, overlap)
+		jobSkippedCounter.WithLabelValues(job.name, skipReasonOverlap).Inc()
+		return fmt.Errorf("%w: previous run is still running", ErrJobSkipped)
+	}
+	abandoned := &abandonedRuns{}
+	ctx = context.WithValue(ctx, abandonedRunsKey{}, abandoned)
+	defer func() {
+		if abandoned.count.Load() == 0 {
+			release()
+			return
+		}
+		// 被放弃的任务返回前一直占用，避免与下次执行重叠
+		go func() {
+			abandoned.wg.Wait()
+			release()
+		}()
+	}()
+
+	switch job.mode {
+	case JobModeSingleton:
+		return job.runLocked(ctx, job.name, JobLock{Shards: 1})
+	case JobModeSharded:
+		return job.runShards(ctx)
+	default:
+		return job.run(ctx, JobLock{})
+	}
+}
+
+// runShards 从随机分片开始竞争各分片的锁，取得的分片并发执行；各实例同时触发，分片大致均匀分布
+// 所有分片都被跳过时返回ErrJobSkipped，否则返回执行失败的分片的错误
+func (job *JobWrap) runShards(ctx context.Context) error {
+	var wg sync.WaitGroup
+	errs := make([]error, job.shards)
+	offset := rand.IntN(job.shards)
+	for i := 0; i < job.shards; i++ {
+		shard := (offset + i) % job.shards
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[shard] = job.runLocked(ctx, fmt.Sprintf("%s:%d", job.name, shard), JobLock{Shard: shard, Shards: job.shards})
+		}()
+	}
+	wg.Wait()
+	var (
+		failed  []error
+		skipped int
+	)
+	for shard, err := range errs {
+		if errors.Is(err, ErrJobSkipped) {
+			skipped++
+		} else if err != nil {
+			failed = append(failed, fmt.Errorf("shard %d: %w", shard, err))
+		}
+	}
+	if skipped == job.shards {
+		return fmt.Errorf("%w: all shards are held by other instances", ErrJobSkipped)
+	}
+	return errors.Join(failed...)
+}
+
+// runLocked 取得锁后执行，执行期间续期；续期失败时取消任务的ctx；
+// 定时执行时还需认领本次的计划执行时间，已被其他实例认领时跳过；
+// 任务被放弃时停止续期但不释放锁，锁过期前其他实例不会开始下一次执行
+func (job *JobWrap) runLocked(ctx context.Context, key string, lock JobLock) (err error) {
+	token, ok, err := job.locker.Acquire(ctx, key, job.lockTTL)
+	if err != nil {
+		job.log.Errorf("crontab job %s skipped, acquire lock %s failed: %v", job.name, key, err)
+		jobSkippedCounter.WithLabelValues(job.name, skipReasonLockError).Inc()
+		return err
+	}
+	if !ok {
+		job.log.Infof("crontab job %s skipped, lock %s is held by another instance", job.name, key)
+		jobSkippedCounter.WithLabelValues(job.name, skipReasonLocked).Inc()
+		return fmt.Errorf("%w: lock %s is held by another instance", ErrJobSkipped, key)
+	}
+	lock.Token = token
+	if err := job.claimFireTime(ctx, key); err != nil {
+		if err := job.locker.Release(context.Background(), key, token); err != nil {
+			job.log.Warnf("crontab job %s release lock %s failed: %v", job.name, key, err)
+		}
+		return err
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	renewDone := make(chan struct{})
+	go func() {
+		defer close(renewDone)
+		job.keepLock(ctx, cancel, key, token)
+	}()
+	defer func() {
+		cancel(nil)
+		<-renewDone
+		if errors.Is(err, errJobAbandoned) {
+			job.log.Warnf("crontab job %s is still running, lock %s will expire after %s", job.name, key, job.lockTTL)
+			return
+		}
+		if err := job.locker.Release(context.Background(), key, token); err != nil {
+			job.log.Warnf("crontab job %s release lock %s failed: %v", job.name, key, err)
+		}
+	}()
+	return job.run(ctx, lock)
+}
+
+// claimFireTime 以 <锁key>:fired:<计划执行时间> 认领本次定时执行，不释放，lockTTL后过期，不占用fencing token，
+// lockTTL需大于实例间的时钟偏差；本次已被其他实例执行时返回ErrJobSkipped，手动执行时不认领
+func (job *JobWrap) claimFireTime(ctx context.Context, key string) error {
+	fireTime, ok := ctx.Value(jobFireTimeKey{}).(time.Time)
+	if !ok {
+		return nil
+	}
+	claimKey := fmt.Sprintf("%s:fired:%d", key, fireTime.Unix())
+	ok, err := job.locker.Claim(ctx, claimKey, job.lockTTL)
+	if err != nil {
+		job.log.Errorf("crontab job %s skipped, claim %s failed: %v", job.name, claimKey, err)
+		jobSkippedCounter.WithLabelValues(job.name, skipReasonLockError).Inc()
+		return err
+	}
+	if !ok {
+		job.log.Infof("crontab job %s skipped, run at %s has been executed by another instance", job.name, fireTime.Format(time.RFC3339))
+		jobSkippedCounter.WithLabelValues(job.name, skipReasonFired).Inc()
+		return fmt.Errorf("%w: run at %s has been executed by another instance", ErrJobSkipped, fireTime.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// keepLock 每ttl/3续期一次，锁被其他实例取得或超过ttl未能续期时视为丢失
+func (job *JobWrap) keepLock(ctx context.Context, cancel context.CancelCauseFunc, key string, token int64) {
+	ticker := time.NewTicker(job.lockTTL / 3)
+	defer ticker.Stop()
+	renewedAt := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		ok, err := job.locker.Renew(ctx, key, token, job.lockTTL)
+		if err != nil && ctx.Err() != nil {
+			return
+		}
+		if err == nil && ok {
+			renewedAt = time.Now()
+			continue
+		}
+		if err != nil && time.Since(renewedAt) < job.lockTTL {
+			job.log.Warnf("crontab job %s renew lock %s failed, will retry: %v", job.name, key, err)
+			continue
+		}
+		job.log.Errorf("crontab job %s lost lock %s (token %d), cancelling: %v", job.name, key, token, err)
+		jobLockLostCounter.WithLabelValues(job.name).Inc()
+		cancel(errJobLockLost)
+		return
+	}
+}
+
+// run 执行任务并记录结果：超时记为失败，锁丢失或服务停止导致的取消记为cancelled
+func (job *JobWrap) run(ctx context.Context, lock JobLock) error {
+	ctx = context.WithValue(ctx, jobLockKey{}, lock)
+	run := &biz.JobRun{
+		JobName:      job.name,
+		Shard:        lock.Shard,
+		Shards:       lock.Shards,
+		InstanceId:   global.GetHost(),
+		FencingToken: lock.Token,
+	}
+	job.history.Start(ctx, run)
+	timeout := job.settings.Load().timeout
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, timeout, errJobTimeout)
+		defer cancel()
+	}
+
+	err := job.call(ctx, run)
+	status := biz.JobRunSucceeded
+	if err != nil {
+		status = biz.JobRunFailed
+		if ctx.Err() != nil {
+			if cause := context.Cause(ctx); errors.Is(cause, errJobTimeout) {
+				err = fmt.Errorf("timeout after %s: %w", timeout, err)
+			} else {
+				status = biz.JobRunCancelled
+			}
+		}
+		job.log.Errorf("crontab job %s %s: %v", job.name, status, err)
+	}
+	// ctx可能已取消，仍需记录结果
+	job.history.Finish(context.WithoutCancel(ctx), run, status, err, job.failureThreshold)
+	return err
+}
+
+// call 执行任务，panic时转为错误；ctx取消后任务仍未返回时放弃等待并返回errJobAbandoned，
+// 被放弃的任务记入abandonedRuns，返回前不释放overlap占用
+func (job *JobWrap) call(ctx context.Context, run *biz.JobRun) error {
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				job.history.ReportPanic(context.WithoutCancel(ctx), run, r, debug.Stack())
+				done <- fmt.Errorf("panic: %v", r)
+			}
+		}()
+		done <- job.job.Run(ctx)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+	}
+	// 给任务响应取消的时间
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(job.stopGracePeriod):
+		job.log.Errorf("crontab job %s did not return %s after being cancelled, abandoned", job.name, job.stopGracePeriod)
+		if abandoned, ok := ctx.Value(abandonedRunsKey{}).(*abandonedRuns); ok {
+			abandoned.add(done)
+		}
+		return fmt.Errorf("%w: %w", errJobAbandoned, context.Cause(ctx))
+	}
+}
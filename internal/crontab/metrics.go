@@ -0,0 +1,17 @@
+package crontab
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	jobSkippedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "crontab_job_skipped_total",
+		Help: "Number of crontab job executions skipped because the lock was not acquired",
+	}, []string{"job", "reason"})
+	jobLockLostCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "crontab_job_lock_lost_total",
+		Help: "Number of crontab job executions cancelled because the lock was lost",
+	}, []string{"job"})
+)
@@ -0,0 +1,48 @@
+package crontab
+
+import "sync"
+
+// overlapGuard 控制本实例上同一任务的并发执行
+type overlapGuard struct {
+	running chan struct{}
+	mu      sync.Mutex
+	queued  bool
+}
+
+func newOverlapGuard() *overlapGuard {
+	return &overlapGuard{running: make(chan struct{}, 1)}
+}
+
+// enter 按policy开始执行，返回false时跳过本次执行；返回true时执行结束后须调用release
+func (g *overlapGuard) enter(policy OverlapPolicy) (release func(), ok bool) {
+	leave := func() { <-g.running }
+	switch policy {
+	case OverlapAllow:
+		return func() {}, true
+	case OverlapQueue:
+		select {
+		case g.running <- struct{}{}:
+			return leave, true
+		default:
+		}
+		g.mu.Lock()
+		if g.queued {
+			g.mu.Unlock()
+			return nil, false
+		}
+		g.queued = true
+		g.mu.Unlock()
+		g.running <- struct{}{}
+		g.mu.Lock()
+		g.queued = false
+		g.mu.Unlock()
+		return leave, true
+	default:
+		select {
+		case g.running <- struct{}{}:
+			return leave, true
+		default:
+			return nil, false
+		}
+	}
+}
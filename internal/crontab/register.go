@@ -1,36 +1,323 @@
 package crontab
 
 import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/robfig/cron/v3"
-	"github.com/seanbit/kratos/webkit/transport/crontab"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+)
+
+// JobMode 多副本部署时任务的执行方式
+type JobMode int
+
+const (
+	// JobModePerInstance 每个实例都执行
+	JobModePerInstance JobMode = iota
+	// JobModeSingleton 集群内同一时刻只有一个实例执行
+	JobModeSingleton
+	// JobModeSharded 任务分为多个分片，每个分片同一时刻只有一个实例执行
+	JobModeSharded
 )
 
+func (mode JobMode) String() string {
+	switch mode {
+	case JobModeSingleton:
+		return "singleton"
+	case JobModeSharded:
+		return "sharded"
+	}
+	return "per_instance"
+}
+
+// OverlapPolicy 本实例上次执行未结束时再次触发的处理方式
+type OverlapPolicy string
+
+const (
+	// OverlapSkip 跳过本次执行
+	OverlapSkip OverlapPolicy = "skip"
+	// OverlapQueue 等待上次执行结束后执行，最多排队一次，排队已满时跳过
+	OverlapQueue OverlapPolicy = "queue"
+	// OverlapAllow 并发执行
+	OverlapAllow OverlapPolicy = "allow"
+)
+
+// defaultJobLockTTL 锁的过期时间，任务执行期间每ttl/3续期一次
+const defaultJobLockTTL = 30 * time.Second
+
+// defaultJobStopGracePeriod 任务被取消后等待其返回的时间
+const defaultJobStopGracePeriod = 10 * time.Second
+
 type JobRegister struct {
-	jobs []crontab.Job
+	jobs []*JobWrap
+}
+
+// specParser spec包含秒，支持CRON_TZ=前缀指定时区
+var specParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// RegisterJobs 注册任务，为任务注入分布式锁、暂停标记与执行记录，并应用config中对任务的覆盖
+func RegisterJobs(locker biz.IJobLockRepo, control biz.IJobControlRepo, history *biz.JobHistory, config *conf.Crontab, logger log.Logger, jobs ...*JobWrap) *JobRegister {
+	register := &JobRegister{jobs: jobs}
+	helper := log.NewHelper(log.With(logger, "module", "crontab"))
+	for _, job := range jobs {
+		job.locker = locker
+		job.control = control
+		job.history = history
+		job.log = helper
+	}
+	register.ApplyConfig(config)
+	return register
 }
 
-func (register *JobRegister) Jobs() []crontab.Job {
+func (register *JobRegister) Jobs() []*JobWrap {
 	return register.jobs
 }
 
+// Job 按名称查找任务
+func (register *JobRegister) Job(name string) (*JobWrap, bool) {
+	for _, job := range register.jobs {
+		if job.name == name {
+			return job, true
+		}
+	}
+	return nil, false
+}
+
+// ApplyConfig 以代码中的设置为基础应用config中的覆盖，config中没有的任务恢复代码中的设置；
+// 已调度的执行计划由Server.Reload重新调度
+func (register *JobRegister) ApplyConfig(config *conf.Crontab) {
+	for _, job := range register.jobs {
+		job.applyConfig(config.GetJobs()[job.name], config.GetTimezone())
+	}
+}
+
+// JobLock 本次执行持有的锁，mode为per_instance时为零值
+type JobLock struct {
+	// Token fencing token，单调递增
+	Token int64
+	// Shard 当前分片，从0开始
+	Shard int
+	// Shards 分片总数
+	Shards int
+}
+
+// Job 定时任务：ctx在锁丢失（续期失败）时取消，返回error时记录为失败；分片任务通过JobLockFromContext取得当前分片
+//
+// ctx取消后应尽快返回，超过WithStopGracePeriod（默认10s）未返回的任务被放弃：锁不再续期，过期后其他实例可开始下一次执行，
+// 因此Singleton/Sharded任务写外部资源时需携带JobLock.Token，由资源方拒绝较旧token的写入
+type Job interface {
+	Run(ctx context.Context) error
+}
+
+// JobFunc 函数形式的任务
+type JobFunc func(ctx context.Context) error
+
+func (f JobFunc) Run(ctx context.Context) error {
+	return f(ctx)
+}
+
+type jobLockKey struct{}
+
+type jobParamsKey struct{}
+
+// JobParamsFromContext 手动触发时指定的参数，定时执行时为nil
+func JobParamsFromContext(ctx context.Context) map[string]string {
+	params, _ := ctx.Value(jobParamsKey{}).(map[string]string)
+	return params
+}
+
+// JobLockFromContext 本次执行持有的锁
+func JobLockFromContext(ctx context.Context) (JobLock, bool) {
+	lock, ok := ctx.Value(jobLockKey{}).(JobLock)
+	return lock, ok
+}
+
+// JobOption 任务选项
+type JobOption func(*JobWrap)
+
+// Singleton 集群内只由一个实例执行，未取得锁的实例跳过本次执行
+func Singleton() JobOption {
+	return func(job *JobWrap) {
+		job.mode = JobModeSingleton
+		job.shards = 1
+	}
+}
+
+// Sharded 每次执行分为shards个分片，各实例竞争分片的锁，每个分片只由一个实例执行
+func Sharded(shards int) JobOption {
+	return func(job *JobWrap) {
+		job.mode = JobModeSharded
+		job.shards = shards
+	}
+}
+
+// WithFailureAlarmThreshold 连续失败n次时告警，默认3次
+func WithFailureAlarmThreshold(n int) JobOption {
+	return func(job *JobWrap) {
+		job.failureThreshold = n
+	}
+}
+
+// WithTimeout 执行超时，超时后取消任务的ctx并记录为失败，<=0时不限
+func WithTimeout(timeout time.Duration) JobOption {
+	return func(job *JobWrap) {
+		job.defaults.timeout = timeout
+	}
+}
+
+// WithOverlap 本实例上次执行未结束时的处理方式，默认跳过
+func WithOverlap(policy OverlapPolicy) JobOption {
+	return func(job *JobWrap) {
+		job.defaults.overlap = policy
+	}
+}
+
+// WithLockTTL 锁的过期时间，默认30s，需大于实例间的时钟偏差
+func WithLockTTL(ttl time.Duration) JobOption {
+	return func(job *JobWrap) {
+		job.lockTTL = ttl
+	}
+}
+
+// WithStopGracePeriod 任务被取消后等待其返回的时间，默认10s，超过后放弃等待
+func WithStopGracePeriod(d time.Duration) JobOption {
+	return func(job *JobWrap) {
+		job.stopGracePeriod = d
+	}
+}
+
+// jobSettings 可由配置覆盖、运行中可替换的设置
+type jobSettings struct {
+	spec     string
+	timezone string
+	disabled bool
+	timeout  time.Duration
+	overlap  OverlapPolicy
+}
+
+// schedule 交给cron的spec，指定时区时加CRON_TZ=前缀
+func (settings *jobSettings) schedule() string {
+	if settings.timezone == "" {
+		return settings.spec
+	}
+	return "CRON_TZ=" + settings.timezone + " " + settings.spec
+}
+
 type JobWrap struct {
-	cron.Job
+	job  Job
 	name string
-	spec string
+	mode JobMode
+	// defaults 代码中的设置
+	defaults jobSettings
+	// settings 生效的设置，配置变化时整体替换
+	settings         atomic.Pointer[jobSettings]
+	shards           int
+	lockTTL          time.Duration
+	stopGracePeriod  time.Duration
+	guard            *overlapGuard
+	failureThreshold int
+	locker           biz.IJobLockRepo
+	control          biz.IJobControlRepo
+	history          *biz.JobHistory
+	log              *log.Helper
+}
+
+func NewJobWrap(name, spec string, job Job, opts ...JobOption) *JobWrap {
+	wrap := &JobWrap{
+		job:             job,
+		name:            name,
+		defaults:        jobSettings{spec: spec, overlap: OverlapSkip},
+		lockTTL:         defaultJobLockTTL,
+		stopGracePeriod: defaultJobStopGracePeriod,
+		guard:           newOverlapGuard(),
+		log:             log.NewHelper(log.GetLogger()),
+	}
+	for _, opt := range opts {
+		opt(wrap)
+	}
+	if wrap.mode == JobModeSharded && wrap.shards <= 0 {
+		panic(fmt.Sprintf("crontab job %s: shards must be positive", name))
+	}
+	if _, err := specParser.Parse(spec); err != nil {
+		panic(fmt.Sprintf("crontab job %s: invalid spec %q: %v", name, spec, err))
+	}
+	defaults := wrap.defaults
+	wrap.settings.Store(&defaults)
+	return wrap
 }
 
-func NewJobWrap(name, spec string, job cron.Job) *JobWrap {
-	return &JobWrap{
-		Job:  job,
-		name: name,
-		spec: spec,
+// applyConfig 配置中的值优先于代码中的设置，无效的值忽略并保留代码中的设置
+func (job *JobWrap) applyConfig(config *conf.Crontab_Job, timezone string) {
+	settings := job.defaults
+	if timeout := config.GetTimeout(); timeout != nil {
+		settings.timeout = timeout.AsDuration()
+	}
+	switch policy := OverlapPolicy(config.GetOverlap()); policy {
+	case "":
+	case OverlapSkip, OverlapQueue, OverlapAllow:
+		settings.overlap = policy
+	default:
+		job.log.Warnf("crontab job %s: unsupported overlap policy %q in config, keep %s", job.name, policy, settings.overlap)
+	}
+	if spec := config.GetSpec(); spec != "" {
+		settings.spec = spec
 	}
+	if config.GetTimezone() != "" {
+		timezone = config.GetTimezone()
+	}
+	settings.timezone = timezone
+	if _, err := specParser.Parse(settings.schedule()); err != nil {
+		job.log.Warnf("crontab job %s: invalid spec %q in config, keep %q: %v", job.name, settings.schedule(), job.defaults.spec, err)
+		settings.spec, settings.timezone = job.defaults.spec, ""
+	}
+	settings.disabled = config.GetDisabled()
+	job.settings.Store(&settings)
 }
 
 func (job *JobWrap) Name() string {
 	return job.name
 }
 
+// Spec 生效的执行计划
 func (job *JobWrap) Spec() string {
-	return job.spec
+	return job.settings.Load().spec
+}
+
+// Timezone 执行计划所在时区，为空时为本地时区
+func (job *JobWrap) Timezone() string {
+	return job.settings.Load().timezone
+}
+
+// Disabled 是否已在配置中停止调度
+func (job *JobWrap) Disabled() bool {
+	return job.settings.Load().disabled
+}
+
+func (job *JobWrap) Mode() JobMode {
+	return job.mode
+}
+
+// Shards 分片数，非分片任务为0
+func (job *JobWrap) Shards() int {
+	if job.mode != JobModeSharded {
+		return 0
+	}
+	return job.shards
+}
+
+// Next now之后的下次执行时间，已停止调度时返回零值
+func (job *JobWrap) Next(now time.Time) time.Time {
+	settings := job.settings.Load()
+	if settings.disabled {
+		return time.Time{}
+	}
+	schedule, err := specParser.Parse(settings.schedule())
+	if err != nil {
+		return time.Time{}
+	}
+	return schedule.Next(now)
 }
@@ -0,0 +1,104 @@
+package crontab
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/robfig/cron/v3"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/global"
+	"google.golang.org/protobuf/proto"
+)
+
+// scheduled 已交给cron的任务
+type scheduled struct {
+	id       cron.EntryID
+	schedule string
+}
+
+// Server 按任务的spec调度执行，配置热更新时crontab段变化则重新调度，无需重启
+type Server struct {
+	cron     *cron.Cron
+	register *JobRegister
+	log      *log.Helper
+
+	mu          sync.Mutex
+	entries     map[string]scheduled
+	unsubscribe func()
+}
+
+func NewServer(register *JobRegister, logger log.Logger) *Server {
+	return &Server{
+		cron:     cron.New(cron.WithParser(specParser)),
+		register: register,
+		log:      log.NewHelper(log.With(logger, "module", "crontab")),
+		entries:  make(map[string]scheduled),
+	}
+}
+
+func (s *Server) Start(ctx context.Context) error {
+	s.schedule()
+	s.cron.Start()
+	s.unsubscribe = global.SubscribeConfig("crontab", s.onConfigChange)
+	return nil
+}
+
+// Stop 停止调度并等待执行中的任务结束
+func (s *Server) Stop(ctx context.Context) error {
+	if s.unsubscribe != nil {
+		s.unsubscribe()
+	}
+	select {
+	case <-s.cron.Stop().Done():
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+// Reload 应用新的crontab配置并重新调度，执行计划未变的任务不受影响；配置无效时保持当前调度
+func (s *Server) Reload(config *conf.Crontab) error {
+	if err := global.ValidateCrontabConfig(config); err != nil {
+		return err
+	}
+	s.register.ApplyConfig(config)
+	s.schedule()
+	return nil
+}
+
+func (s *Server) onConfigChange(old, next *conf.Bootstrap) error {
+	if proto.Equal(old.GetCrontab(), next.GetCrontab()) {
+		return nil
+	}
+	if err := s.Reload(next.GetCrontab()); err != nil {
+		return err
+	}
+	s.log.Infof("crontab config reloaded")
+	return nil
+}
+
+// schedule 按任务当前的设置增删cron中的任务，已停止调度的任务移除，执行计划变化的任务重新添加
+func (s *Server) schedule() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, job := range s.register.Jobs() {
+		settings := job.settings.Load()
+		entry, ok := s.entries[job.name]
+		if ok && (settings.disabled || entry.schedule != settings.schedule()) {
+			s.cron.Remove(entry.id)
+			delete(s.entries, job.name)
+			s.log.Infof("crontab job %s unscheduled: %s", job.name, entry.schedule)
+		}
+		if settings.disabled || (ok && entry.schedule == settings.schedule()) {
+			continue
+		}
+		id, err := s.cron.AddJob(settings.schedule(), job)
+		if err != nil {
+			// spec已在NewJobWrap与applyConfig中校验，正常不会出现
+			s.log.Errorf("crontab job %s schedule %q failed: %v", job.name, settings.schedule(), err)
+			continue
+		}
+		s.entries[job.name] = scheduled{id: id, schedule: settings.schedule()}
+		s.log.Infof("crontab job %s scheduled: %s", job.name, settings.schedule())
+	}
+}
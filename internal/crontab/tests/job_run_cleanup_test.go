@@ -0,0 +1,38 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/biz/mocks"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/crontab"
+	"github.com/seanbit/kratos/template/internal/global"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// 保留时长在每次执行时从全局配置读取，热更新后下次执行生效
+func TestJobRunCleanup_Reload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	runs := mocks.NewMockIJobRunRepo(ctrl)
+	job := crontab.NewJobRunCleanup(biz.NewJobHistory(runs, mocks.NewMockIAlarmRepo(ctrl)))
+	beforeDay := func(days int) gomock.Matcher {
+		return gomock.Cond(func(before time.Time) bool {
+			expected := time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+			return before.Sub(expected).Abs() < time.Minute
+		})
+	}
+
+	for _, days := range []int{7, 3} {
+		global.SetConfig(&conf.Bootstrap{Crontab: &conf.Crontab{RunRetention: durationpb.New(time.Duration(days) * 24 * time.Hour)}})
+		runs.EXPECT().DeleteStartedBefore(gomock.Any(), beforeDay(days), gomock.Any()).Return(int64(0), nil)
+		if err := job.Run(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
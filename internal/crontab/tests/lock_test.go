@@ -0,0 +1,511 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/biz/mocks"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/crontab"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type countJob struct {
+	runs atomic.Int32
+}
+
+func (job *countJob) Run(ctx context.Context) error {
+	job.runs.Add(1)
+	return nil
+}
+
+type shardJob struct {
+	mu    sync.Mutex
+	locks []crontab.JobLock
+}
+
+func (job *shardJob) Run(ctx context.Context) error {
+	lock, _ := crontab.JobLockFromContext(ctx)
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	job.locks = append(job.locks, lock)
+	return nil
+}
+
+type blockingJob struct {
+	cancelled chan struct{}
+}
+
+func (job *blockingJob) Run(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		close(job.cancelled)
+		return ctx.Err()
+	case <-time.After(time.Second):
+		return nil
+	}
+}
+
+type testJobDeps struct {
+	locker  *mocks.MockIJobLockRepo
+	control *mocks.MockIJobControlRepo
+	runs    *mocks.MockIJobRunRepo
+	alarm   *mocks.MockIAlarmRepo
+	config  *conf.Crontab
+}
+
+// newTestJobDeps 任务默认未暂停
+func newTestJobDeps(ctrl *gomock.Controller) *testJobDeps {
+	deps := &testJobDeps{
+		locker:  mocks.NewMockIJobLockRepo(ctrl),
+		control: mocks.NewMockIJobControlRepo(ctrl),
+		runs:    mocks.NewMockIJobRunRepo(ctrl),
+		alarm:   mocks.NewMockIAlarmRepo(ctrl),
+	}
+	deps.control.EXPECT().IsPaused(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	return deps
+}
+
+// firedKey 匹配定时执行时认领计划执行时间的key
+func firedKey(key string) gomock.Matcher {
+	return gomock.Cond(func(x string) bool {
+		return strings.HasPrefix(x, key+":fired:")
+	})
+}
+
+// register 注册单个任务，返回cron调用入口
+func (deps *testJobDeps) register(job *crontab.JobWrap) func() {
+	history := biz.NewJobHistory(deps.runs, deps.alarm)
+	return crontab.RegisterJobs(deps.locker, deps.control, history, deps.config, log.DefaultLogger, job).Jobs()[0].Run
+}
+
+// recordAll 执行记录的写入均成功
+func (deps *testJobDeps) recordAll() {
+	deps.runs.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, run *biz.JobRun) error {
+		run.Id = 1
+		return nil
+	}).AnyTimes()
+	deps.runs.EXPECT().Finish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
+func TestJobWrap_Singleton(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	deps := newTestJobDeps(ctrl)
+	deps.recordAll()
+	job := &countJob{}
+	run := deps.register(crontab.NewJobWrap("count", "* * * * * *", job, crontab.Singleton()))
+
+	// 取得锁并认领本次执行时执行并释放锁，认领不释放
+	deps.locker.EXPECT().Acquire(gomock.Any(), "count", gomock.Any()).Return(int64(7), true, nil)
+	deps.locker.EXPECT().Claim(gomock.Any(), firedKey("count"), gomock.Any()).Return(true, nil)
+	deps.locker.EXPECT().Release(gomock.Any(), "count", int64(7)).Return(nil)
+	run()
+	// 本次已被其他实例执行时跳过
+	deps.locker.EXPECT().Acquire(gomock.Any(), "count", gomock.Any()).Return(int64(9), true, nil)
+	deps.locker.EXPECT().Claim(gomock.Any(), firedKey("count"), gomock.Any()).Return(false, nil)
+	deps.locker.EXPECT().Release(gomock.Any(), "count", int64(9)).Return(nil)
+	run()
+	// 锁被其他实例持有时跳过
+	deps.locker.EXPECT().Acquire(gomock.Any(), "count", gomock.Any()).Return(int64(0), false, nil)
+	run()
+	if runs := job.runs.Load(); runs != 1 {
+		t.Errorf("expected 1 run, got %d", runs)
+	}
+}
+
+func TestJobWrap_Sharded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	deps := newTestJobDeps(ctrl)
+	deps.recordAll()
+	job := &shardJob{}
+	run := deps.register(crontab.NewJobWrap("shard", "* * * * * *", job, crontab.Sharded(3)))
+
+	// 只执行取得锁的分片
+	deps.locker.EXPECT().Acquire(gomock.Any(), "shard:0", gomock.Any()).Return(int64(0), false, nil)
+	deps.locker.EXPECT().Acquire(gomock.Any(), "shard:1", gomock.Any()).Return(int64(11), true, nil)
+	deps.locker.EXPECT().Claim(gomock.Any(), firedKey("shard:1"), gomock.Any()).Return(true, nil)
+	deps.locker.EXPECT().Acquire(gomock.Any(), "shard:2", gomock.Any()).Return(int64(0), false, nil)
+	deps.locker.EXPECT().Release(gomock.Any(), "shard:1", int64(11)).Return(nil)
+	run()
+	if len(job.locks) != 1 || job.locks[0] != (crontab.JobLock{Token: 11, Shard: 1, Shards: 3}) {
+		t.Errorf("unexpected locks %+v", job.locks)
+	}
+}
+
+// memoryLocker 多个实例共用的内存锁
+type memoryLocker struct {
+	mu    sync.Mutex
+	token int64
+	locks map[string]memoryLock
+}
+
+type memoryLock struct {
+	token     int64
+	expiresAt time.Time
+}
+
+func newMemoryLocker() *memoryLocker {
+	return &memoryLocker{locks: make(map[string]memoryLock)}
+}
+
+func (l *memoryLocker) Acquire(ctx context.Context, key string, ttl time.Duration) (int64, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if lock, ok := l.locks[key]; ok && time.Now().Before(lock.expiresAt) {
+		return 0, false, nil
+	}
+	l.token++
+	l.locks[key] = memoryLock{token: l.token, expiresAt: time.Now().Add(ttl)}
+	return l.token, true, nil
+}
+
+func (l *memoryLocker) Renew(ctx context.Context, key string, token int64, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if lock, ok := l.locks[key]; !ok || lock.token != token {
+		return false, nil
+	}
+	l.locks[key] = memoryLock{token: token, expiresAt: time.Now().Add(ttl)}
+	return true, nil
+}
+
+func (l *memoryLocker) Release(ctx context.Context, key string, token int64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if lock, ok := l.locks[key]; ok && lock.token == token {
+		delete(l.locks, key)
+	}
+	return nil
+}
+
+func (l *memoryLocker) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if lock, ok := l.locks[key]; ok && time.Now().Before(lock.expiresAt) {
+		return false, nil
+	}
+	l.locks[key] = memoryLock{expiresAt: time.Now().Add(ttl)}
+	return true, nil
+}
+
+// 两个实例时钟有偏差，先触发的实例执行完释放锁后另一个实例才触发，同一次计划执行只执行一次
+func TestJobWrap_ClockSkew(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	deps := newTestJobDeps(ctrl)
+	deps.recordAll()
+	locker := newMemoryLocker()
+	job := &countJob{}
+	history := biz.NewJobHistory(deps.runs, deps.alarm)
+	instances := make([]func(), 2)
+	for i := range instances {
+		wrap := crontab.NewJobWrap("skew", "* * * * * *", job, crontab.Singleton())
+		instances[i] = crontab.RegisterJobs(locker, deps.control, history, deps.config, log.DefaultLogger, wrap).Jobs()[0].Run
+	}
+
+	// 在同一秒内先后触发
+	time.Sleep(time.Until(time.Now().Truncate(time.Second).Add(time.Second + 100*time.Millisecond)))
+	instances[0]()
+	time.Sleep(300 * time.Millisecond)
+	instances[1]()
+	if runs := job.runs.Load(); runs != 1 {
+		t.Fatalf("expected 1 run within the same fire time, got %d", runs)
+	}
+	// 下一次计划执行不受影响
+	time.Sleep(time.Until(time.Now().Truncate(time.Second).Add(time.Second + 100*time.Millisecond)))
+	instances[1]()
+	if runs := job.runs.Load(); runs != 2 {
+		t.Errorf("expected next fire time executed, got %d runs", runs)
+	}
+}
+
+func TestJobWrap_LockLost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	deps := newTestJobDeps(ctrl)
+	job := &blockingJob{cancelled: make(chan struct{})}
+	run := deps.register(crontab.NewJobWrap("block", "* * * * * *", job,
+		crontab.Singleton(), crontab.WithLockTTL(30*time.Millisecond)))
+
+	// 续期时锁已被其他实例取得，任务的ctx被取消，记录为cancelled
+	deps.locker.EXPECT().Acquire(gomock.Any(), "block", gomock.Any()).Return(int64(1), true, nil)
+	deps.locker.EXPECT().Claim(gomock.Any(), firedKey("block"), gomock.Any()).Return(true, nil)
+	deps.locker.EXPECT().Renew(gomock.Any(), "block", int64(1), gomock.Any()).Return(false, nil)
+	deps.locker.EXPECT().Release(gomock.Any(), "block", int64(1)).Return(nil)
+	deps.runs.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, run *biz.JobRun) error {
+		run.Id = 1
+		return nil
+	})
+	deps.runs.EXPECT().Finish(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, run *biz.JobRun) error {
+		if run.Status != biz.JobRunCancelled || run.FencingToken != 1 {
+			t.Errorf("unexpected run %+v", run)
+		}
+		return nil
+	})
+	run()
+	select {
+	case <-job.cancelled:
+	default:
+		t.Error("job should be cancelled after losing the lock")
+	}
+}
+
+// 任务忽略ctx被放弃后，锁不释放，任务返回前本实例不开始下一次执行
+func TestJobWrap_Abandoned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	deps := newTestJobDeps(ctrl)
+	deps.recordAll()
+	deps.runs.EXPECT().ListRecentStatuses(gomock.Any(), "stuck", gomock.Any()).Return(nil, nil).AnyTimes()
+	var runs atomic.Int32
+	release := make(chan struct{})
+	job := crontab.NewJobWrap("stuck", "* * * * * *", crontab.JobFunc(func(ctx context.Context) error {
+		runs.Add(1)
+		<-release
+		return nil
+	}), crontab.Singleton(), crontab.WithTimeout(20*time.Millisecond), crontab.WithStopGracePeriod(20*time.Millisecond))
+	deps.register(job)
+
+	// 被放弃时不释放锁，由锁过期后其他实例取得
+	deps.locker.EXPECT().Acquire(gomock.Any(), "stuck", gomock.Any()).Return(int64(1), true, nil)
+	if err := job.RunOnce(context.Background(), nil); err == nil {
+		t.Fatal("expected abandoned error")
+	}
+	// 被放弃的任务仍在运行，跳过
+	if err := job.RunOnce(context.Background(), nil); !errors.Is(err, crontab.ErrJobSkipped) {
+		t.Fatalf("expected skipped while abandoned run is still running, got %v", err)
+	}
+
+	// 任务返回后可以再次执行
+	close(release)
+	deps.locker.EXPECT().Acquire(gomock.Any(), "stuck", gomock.Any()).Return(int64(2), true, nil)
+	deps.locker.EXPECT().Release(gomock.Any(), "stuck", int64(2)).Return(nil)
+	deadline := time.Now().Add(time.Second)
+	for {
+		err := job.RunOnce(context.Background(), nil)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, crontab.ErrJobSkipped) || time.Now().After(deadline) {
+			t.Fatalf("expected run after abandoned run returned, got %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := runs.Load(); got != 2 {
+		t.Errorf("expected 2 runs, got %d", got)
+	}
+}
+
+func TestJobWrap_FailureAlarm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	deps := newTestJobDeps(ctrl)
+	deps.recordAll()
+	run := deps.register(crontab.NewJobWrap("fail", "* * * * * *", crontab.JobFunc(func(ctx context.Context) error {
+		return errors.New("boom")
+	}), crontab.WithFailureAlarmThreshold(2)))
+
+	// 第1次失败不告警
+	deps.runs.EXPECT().ListRecentStatuses(gomock.Any(), "fail", 3).Return([]string{biz.JobRunFailed, biz.JobRunSucceeded}, nil)
+	run()
+	// 连续失败2次告警
+	deps.runs.EXPECT().ListRecentStatuses(gomock.Any(), "fail", 3).Return([]string{biz.JobRunFailed, biz.JobRunFailed, biz.JobRunSucceeded}, nil)
+	deps.alarm.EXPECT().SendBizMessage(gomock.Any(), gomock.Any(), gomock.Any())
+	run()
+	// 之后继续失败不重复告警
+	deps.runs.EXPECT().ListRecentStatuses(gomock.Any(), "fail", 3).Return([]string{biz.JobRunFailed, biz.JobRunFailed, biz.JobRunFailed}, nil)
+	run()
+}
+
+func TestJobWrap_PauseAndTrigger(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	deps := &testJobDeps{
+		locker:  mocks.NewMockIJobLockRepo(ctrl),
+		control: mocks.NewMockIJobControlRepo(ctrl),
+		runs:    mocks.NewMockIJobRunRepo(ctrl),
+		alarm:   mocks.NewMockIAlarmRepo(ctrl),
+	}
+	deps.recordAll()
+	params := make(chan map[string]string, 1)
+	wrap := crontab.NewJobWrap("backfill", "0 0 * * * *", crontab.JobFunc(func(ctx context.Context) error {
+		params <- crontab.JobParamsFromContext(ctx)
+		return nil
+	}))
+	run := deps.register(wrap)
+
+	// 已暂停时定时执行跳过
+	deps.control.EXPECT().IsPaused(gomock.Any(), "backfill").Return(true, nil)
+	run()
+	if len(params) != 0 {
+		t.Fatal("paused job should not run")
+	}
+
+	// 手动触发忽略暂停，参数通过ctx传入
+	wrap.Trigger(map[string]string{"from": "2026-01-01"})
+	select {
+	case got := <-params:
+		if got["from"] != "2026-01-01" {
+			t.Errorf("unexpected params %v", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("triggered job did not run")
+	}
+
+	now := time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)
+	if next := wrap.Next(now); !next.Equal(time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected next run %s", next)
+	}
+}
+
+func TestJobWrap_TimeoutAndPanic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	deps := newTestJobDeps(ctrl)
+	// 配置覆盖代码中的超时
+	deps.config = &conf.Crontab{Jobs: map[string]*conf.Crontab_Job{
+		"slow": {Timeout: durationpb.New(20 * time.Millisecond)},
+	}}
+	deps.runs.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	deps.runs.EXPECT().ListRecentStatuses(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	slow := crontab.NewJobWrap("slow", "* * * * * *", crontab.JobFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}), crontab.WithTimeout(time.Hour))
+	if err := crontab.RegisterJobs(deps.locker, deps.control, biz.NewJobHistory(deps.runs, deps.alarm), deps.config, log.DefaultLogger, slow).
+		Jobs()[0].RunOnce(context.Background(), nil); err == nil {
+		t.Error("expected timeout error")
+	}
+
+	// panic转为失败并告警
+	deps.alarm.EXPECT().SendBizMessage(gomock.Any(), "crontab job panic", gomock.Any())
+	panicking := crontab.NewJobWrap("panic", "* * * * * *", crontab.JobFunc(func(ctx context.Context) error {
+		panic("boom")
+	}))
+	if err := crontab.RegisterJobs(deps.locker, deps.control, biz.NewJobHistory(deps.runs, deps.alarm), deps.config, log.DefaultLogger, panicking).
+		Jobs()[0].RunOnce(context.Background(), nil); err == nil {
+		t.Error("expected panic error")
+	}
+}
+
+func TestJobWrap_Overlap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	deps := newTestJobDeps(ctrl)
+	deps.recordAll()
+
+	for _, tc := range []struct {
+		policy crontab.OverlapPolicy
+		runs   int32
+	}{
+		// 执行中再触发两次：skip都跳过，queue排队一次，allow都执行
+		{crontab.OverlapSkip, 1},
+		{crontab.OverlapQueue, 2},
+		{crontab.OverlapAllow, 3},
+	} {
+		var runs atomic.Int32
+		release := make(chan struct{})
+		run := deps.register(crontab.NewJobWrap(string(tc.policy), "* * * * * *", crontab.JobFunc(func(ctx context.Context) error {
+			runs.Add(1)
+			<-release
+			return nil
+		}), crontab.WithOverlap(tc.policy)))
+
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				run()
+			}()
+			time.Sleep(20 * time.Millisecond)
+		}
+		close(release)
+		wg.Wait()
+		if got := runs.Load(); got != tc.runs {
+			t.Errorf("overlap %s: expected %d runs, got %d", tc.policy, tc.runs, got)
+		}
+	}
+}
+
+func TestJobRegister_ApplyConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	deps := newTestJobDeps(ctrl)
+	deps.config = &conf.Crontab{
+		Timezone: "Asia/Shanghai",
+		Jobs: map[string]*conf.Crontab_Job{
+			"report":  {Spec: "0 0 9 * * *"},
+			"cleanup": {Disabled: true},
+			"sync":    {Spec: "not a spec", Timezone: "UTC"},
+		},
+	}
+	report := crontab.NewJobWrap("report", "0 0 8 * * *", &countJob{})
+	cleanup := crontab.NewJobWrap("cleanup", "0 0 * * * *", &countJob{})
+	syncJob := crontab.NewJobWrap("sync", "0 */5 * * * *", &countJob{})
+	register := crontab.RegisterJobs(deps.locker, deps.control, biz.NewJobHistory(deps.runs, deps.alarm), deps.config, log.DefaultLogger, report, cleanup, syncJob)
+
+	// 覆盖spec，使用默认时区
+	now := time.Date(2026, 1, 1, 0, 30, 0, 0, time.UTC)
+	if report.Spec() != "0 0 9 * * *" || report.Timezone() != "Asia/Shanghai" {
+		t.Errorf("unexpected report settings: %s %s", report.Spec(), report.Timezone())
+	}
+	if next := report.Next(now); !next.Equal(time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected report next run: %s", next)
+	}
+	// 停止调度时不计算下次执行，RunOnce跳过
+	if !cleanup.Disabled() || !cleanup.Next(now).IsZero() {
+		t.Error("cleanup should be disabled")
+	}
+	if err := cleanup.RunOnce(context.Background(), nil); !errors.Is(err, crontab.ErrJobSkipped) {
+		t.Errorf("expected skipped, got %v", err)
+	}
+	// 无效的spec保留代码中的设置
+	if syncJob.Spec() != "0 */5 * * * *" || syncJob.Timezone() != "" {
+		t.Errorf("unexpected sync settings: %s %s", syncJob.Spec(), syncJob.Timezone())
+	}
+
+	// 配置中移除覆盖后恢复代码中的设置
+	register.ApplyConfig(&conf.Crontab{})
+	if report.Spec() != "0 0 8 * * *" || report.Timezone() != "" || cleanup.Disabled() {
+		t.Error("settings should be restored from code")
+	}
+}
+
+func TestServer_Reload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	deps := newTestJobDeps(ctrl)
+	job := crontab.NewJobWrap("report", "0 0 8 * * *", &countJob{})
+	register := crontab.RegisterJobs(deps.locker, deps.control, biz.NewJobHistory(deps.runs, deps.alarm), deps.config, log.DefaultLogger, job)
+	server := crontab.NewServer(register, log.DefaultLogger)
+	if err := server.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop(context.Background())
+
+	if err := server.Reload(&conf.Crontab{Jobs: map[string]*conf.Crontab_Job{"report": {Spec: "0 30 8 * * *", Timezone: "UTC"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if job.Spec() != "0 30 8 * * *" || job.Timezone() != "UTC" {
+		t.Errorf("unexpected settings after reload: %s %s", job.Spec(), job.Timezone())
+	}
+	// 无效配置整体拒绝，保持当前设置
+	if err := server.Reload(&conf.Crontab{Jobs: map[string]*conf.Crontab_Job{"report": {Spec: "0 0 9 * * *", Timezone: "Mars/Base"}}}); err == nil {
+		t.Error("expected invalid timezone error")
+	}
+	if job.Spec() != "0 30 8 * * *" {
+		t.Errorf("settings should be kept, got %s", job.Spec())
+	}
+}
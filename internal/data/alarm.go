@@ -16,14 +16,22 @@ import (
 	"github.com/seanbit/kratos/template/internal/global"
 	"github.com/seanbit/kratos/template/pkg/loghelper"
 	"github.com/seanbit/kratos/webkit"
-	"github.com/seanbit/kratos/webkit/thirds"
+	"google.golang.org/protobuf/proto"
 )
 
 type AlarmMessage struct {
-	Platform         string                   `json:"platform"`
-	AlarmTextMessage *thirds.AlarmTextMessage `json:"alarm_text_message"`
-	Retry            int                      `json:"retry"`
-	MaxRetry         int                      `json:"max_retry"`
+	Platform string     `json:"platform"`
+	Card     *AlarmCard `json:"card"`
+	Retry    int        `json:"retry"`
+	MaxRetry int        `json:"max_retry"`
+	// 限流期间合并发送的原始告警，Card为合并后的摘要
+	Batch []*AlarmCard `json:"batch,omitempty"`
+}
+
+// DelayedAlarmMessage 延迟队列中的消息
+type DelayedAlarmMessage struct {
+	*AlarmMessage
+	ExecuteAt time.Time
 }
 
 //go:generate mockgen -source=alarm.go -destination=./mocks/mock_alarm_message_repo.go -package=mocks
@@ -38,42 +46,105 @@ type IAlarmMessageRepo interface {
 	// 延迟队列方法
 	EnqueueDelayedMessage(ctx context.Context, msg *AlarmMessage, delay time.Duration) error
 	ProcessDelayedMessages(ctx context.Context) error
+	Ping(ctx context.Context) error
+	// 队列查询方法
+	QueueLength(ctx context.Context) (int64, error)
+	DelayedQueueSize(ctx context.Context) (int64, error)
+	ListDelayedMessages(ctx context.Context, limit int) ([]*DelayedAlarmMessage, error)
+	ListFusedMessages(ctx context.Context, serviceName string) ([]*biz.FusedAlarmMessage, error)
+	// 限流方法
+	AcquireSendToken(ctx context.Context, platform string, rate float64, burst int) (allowed bool, wait time.Duration, err error)
+	BlockPlatform(ctx context.Context, platform string, duration time.Duration) error
+	AppendBatchMessage(ctx context.Context, msg *AlarmMessage) error
+	BatchLength(ctx context.Context, platform string) (int64, error)
+	PopBatchMessages(ctx context.Context, platform string, limit int) ([]*AlarmMessage, error)
+}
+
+// alarmPlatforms 告警配置与各platform的发送器，热更新时整体替换
+type alarmPlatforms struct {
+	config  *conf.Alarm
+	senders map[string]alarmSender // platform:sender instance
+}
+
+func newAlarmPlatforms(config *conf.Alarm) (*alarmPlatforms, error) {
+	if config.DefaultPlatform == "" || config.WebHooks[config.DefaultPlatform] == "" {
+		return nil, errors.New("Invalid alarm default platform configuration, please check")
+	}
+	senders := make(map[string]alarmSender, len(config.WebHooks))
+	for k, v := range config.WebHooks {
+		sender, err := newAlarmSender(config.Channels[k], v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "alarm platform %s", k)
+		}
+		senders[k] = sender
+	}
+	return &alarmPlatforms{config: config, senders: senders}, nil
 }
 
 type Alarm struct {
-	config      *conf.Alarm
-	alarms      map[string]*thirds.Alarm // platform:alarm instance
+	platforms   atomic.Pointer[alarmPlatforms]
 	messageRepo IAlarmMessageRepo
+	silenceRepo biz.IAlarmSilenceRepo
 	workerNum   int
 	wg          sync.WaitGroup
 	ctx         context.Context
 	cancel      context.CancelFunc
 	cleaning    *atomic.Bool
+	fallback    *alarmFallback // Redis不可用时的降级通道
 }
 
-func NewAlarm(config *conf.Alarm, messageRepo IAlarmMessageRepo) (biz.IAlarmRepo, func(), error) {
-	if config.DefaultPlatform == "" || config.WebHooks[config.DefaultPlatform] == "" {
-		return nil, nil, errors.New("Invalid alarm default platform configuration, please check")
-	}
-	if config.Concurrency == 0 {
-		config.Concurrency = 3
+func NewAlarm(config *conf.Alarm, messageRepo IAlarmMessageRepo, silenceRepo biz.IAlarmSilenceRepo) (biz.IAlarmRepo, func(), error) {
+	platforms, err := newAlarmPlatforms(config)
+	if err != nil {
+		return nil, nil, err
 	}
-	alarms := make(map[string]*thirds.Alarm, len(config.WebHooks))
-	for k, v := range config.WebHooks {
-		alarms[k] = thirds.NewAlarm(global.GetServiceName(), v)
+	workerNum := int(config.Concurrency)
+	if workerNum == 0 {
+		workerNum = 3
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	alarm := &Alarm{
-		config:      config,
-		alarms:      alarms,
 		messageRepo: messageRepo,
-		workerNum:   int(config.Concurrency),
+		silenceRepo: silenceRepo,
+		workerNum:   workerNum,
 		ctx:         ctx,
 		cancel:      cancel,
 		cleaning:    &atomic.Bool{},
+		fallback:    newAlarmFallback(int(config.FallbackQueueSize)),
 	}
+	alarm.platforms.Store(platforms)
 	alarm.StartWorkerPool()
-	return alarm, alarm.StopWorkerPool, nil
+	unsubscribe := global.SubscribeConfig("alarm", func(old, next *conf.Bootstrap) error {
+		if proto.Equal(old.GetAlarm(), next.GetAlarm()) {
+			return nil
+		}
+		return alarm.Reload(next.GetAlarm())
+	})
+	return alarm, func() {
+		unsubscribe()
+		alarm.StopWorkerPool()
+	}, nil
+}
+
+// Reload 替换platform、webhook与限流等配置，队列中的消息按新配置发送；concurrency与fallback_queue_size需重启生效
+func (alarm *Alarm) Reload(config *conf.Alarm) error {
+	platforms, err := newAlarmPlatforms(config)
+	if err != nil {
+		return err
+	}
+	alarm.platforms.Store(platforms)
+	log.Infof("alarm config reloaded, platforms: %d", len(platforms.senders))
+	return nil
+}
+
+func (alarm *Alarm) config() *conf.Alarm {
+	return alarm.platforms.Load().config
+}
+
+// sender 热更新后platform可能已被移除
+func (alarm *Alarm) sender(platform string) (alarmSender, bool) {
+	sender, ok := alarm.platforms.Load().senders[platform]
+	return sender, ok
 }
 
 func (alarm *Alarm) SendBizMessage(ctx context.Context, title, info string) {
@@ -81,52 +152,133 @@ func (alarm *Alarm) SendBizMessage(ctx context.Context, title, info string) {
 }
 
 func (alarm *Alarm) SendMessage(ctx context.Context, platform, title, info string) {
-	function := webkit.GetOperationFromContext(ctx)
-	if function == "" {
-		function = title
-	}
+	alarm.SendDetailMessage(ctx, &biz.AlarmDetail{Platform: platform, Title: title, Info: info})
+}
+
+func (alarm *Alarm) SendDetailMessage(ctx context.Context, detail *biz.AlarmDetail) {
+	platform, title, info := detail.Platform, detail.Title, detail.Info
 	title = "[" + strings.ToUpper(global.GetEnv()) + "] " + title
 
-	isExceed := false
-	isIgnoreMessage, cooldownTimes := alarm.messageRepo.IsIgnoreMessage(global.GetServiceName(), info)
-	if isIgnoreMessage {
-		var err error
-		isExceed, err = alarm.messageRepo.IncrMessageTimes(ctx, global.GetServiceName(), info, cooldownTimes, alarm.config.CacheIgnoreDuration.AsDuration())
-		if err != nil {
-			log.Context(ctx).Errorf("biz.Alarm.IncrMessageTimes error: %v", err)
-		}
-		if isExceed {
-			if err = alarm.messageRepo.FuseMessage(ctx, global.GetServiceName(), info, alarm.config.CacheFuseDuration.AsDuration()); err != nil {
-				log.Context(ctx).Errorf("biz.Alarm.FuseMessage error: %v", err)
-			}
-		}
+	if platform == "" {
+		platform = alarm.config().DefaultPlatform
 	}
 
-	if isFusing, err := alarm.messageRepo.IsMessageFusing(ctx, global.GetServiceName(), info); err != nil {
-		log.Context(ctx).Errorf("biz.Alarm.FuseMessage error: %v", err)
-	} else if isFusing {
+	card := newAlarmCard(ctx, fmt.Sprintf("[%s] %s", platform, title), detail)
+	if alarm.isSilenced(ctx, platform, detail.Title, card) {
 		return
 	}
 
-	if platform == "" {
-		platform = alarm.config.DefaultPlatform
+	isIgnoreMessage, cooldownTimes := alarm.messageRepo.IsIgnoreMessage(global.GetServiceName(), info)
+	if isIgnoreMessage && alarm.incrMessageTimes(ctx, info, cooldownTimes) {
+		alarmFuseTriggeredCounter.Inc()
+		alarm.fuseMessage(ctx, info)
+	}
+	if alarm.isMessageFusing(ctx, info) {
+		alarmMessagesCounter.WithLabelValues(platform, alarmStatusFused).Inc()
+		return
 	}
-	if alarm.config.DryRun {
+
+	if alarm.config().DryRun {
+		alarmMessagesCounter.WithLabelValues(platform, alarmStatusDryRun).Inc()
 		log.Context(ctx).Debugf("dry-run alarm send text message: title:%s info: %s", fmt.Sprintf("[%s] %s", platform, title), info)
 		return
 	}
 
 	alarmMessage := &AlarmMessage{
 		Platform: platform,
-		AlarmTextMessage: &thirds.AlarmTextMessage{
-			TraceId:   webkit.GetTraceID(ctx),
-			Operation: webkit.GetOperationFromContext(ctx),
-			Title:     fmt.Sprintf("[%s] %s", platform, title),
-			Info:      info,
-		},
-	}
-	if err := alarm.messageRepo.EnqueueMessage(ctx, alarmMessage); err != nil {
+		Card:     card,
+	}
+	alarm.enqueueMessage(ctx, alarmMessage)
+}
+
+// isSilenced 告警命中静默规则或已被ack时不发送
+func (alarm *Alarm) isSilenced(ctx context.Context, platform, title string, card *AlarmCard) bool {
+	silence, err := alarm.silenceRepo.MatchSilence(ctx, title, card.Info, card.Labels(platform))
+	if err != nil {
+		log.Context(ctx).Errorf("biz.Alarm.MatchSilence error: %v", err)
+	}
+	if silence != nil {
+		alarmMessagesCounter.WithLabelValues(platform, alarmStatusSilenced).Inc()
+		log.Context(ctx).Debugf("alarm %s silenced by silence %d", card.Fingerprint, silence.ID)
+		return true
+	}
+
+	// ack状态保存在Redis中，降级期间不检查
+	if alarm.fallback.IsDegraded() {
+		return false
+	}
+	acked, err := alarm.silenceRepo.TouchAck(ctx, card.Fingerprint, biz.AlarmAckResolveTimeout(alarm.config()))
+	if err != nil {
+		log.Context(ctx).Errorf("biz.Alarm.TouchAck error: %v", err)
+		return false
+	}
+	if acked {
+		alarmMessagesCounter.WithLabelValues(platform, alarmStatusAcked).Inc()
+		return true
+	}
+	return false
+}
+
+func (alarm *Alarm) incrMessageTimes(ctx context.Context, info string, cooldownTimes int) bool {
+	cacheIgnoreTime := alarm.config().CacheIgnoreDuration.AsDuration()
+	if !alarm.fallback.IsDegraded() {
+		isExceed, err := alarm.messageRepo.IncrMessageTimes(ctx, global.GetServiceName(), info, cooldownTimes, cacheIgnoreTime)
+		if err == nil {
+			return isExceed
+		}
+		log.Context(ctx).Errorf("biz.Alarm.IncrMessageTimes error: %v", err)
+		alarm.degrade(ctx, err)
+	}
+	return alarm.fallback.IncrMessageTimes(info, cooldownTimes, cacheIgnoreTime)
+}
+
+func (alarm *Alarm) fuseMessage(ctx context.Context, info string) {
+	fuseDuration := alarm.config().CacheFuseDuration.AsDuration()
+	if !alarm.fallback.IsDegraded() {
+		err := alarm.messageRepo.FuseMessage(ctx, global.GetServiceName(), info, fuseDuration)
+		if err == nil {
+			return
+		}
+		log.Context(ctx).Errorf("biz.Alarm.FuseMessage error: %v", err)
+		alarm.degrade(ctx, err)
+	}
+	alarm.fallback.FuseMessage(info, fuseDuration)
+}
+
+func (alarm *Alarm) isMessageFusing(ctx context.Context, info string) bool {
+	// 降级期间内存中的熔断状态同样生效
+	if alarm.fallback.IsMessageFusing(info) {
+		return true
+	}
+	if alarm.fallback.IsDegraded() {
+		return false
+	}
+	isFusing, err := alarm.messageRepo.IsMessageFusing(ctx, global.GetServiceName(), info)
+	if err != nil {
+		log.Context(ctx).Errorf("biz.Alarm.IsMessageFusing error: %v", err)
+		alarm.degrade(ctx, err)
+		return false
+	}
+	return isFusing
+}
+
+// enqueueMessage 消息入队，Redis不可用时进入进程内降级队列
+func (alarm *Alarm) enqueueMessage(ctx context.Context, msg *AlarmMessage) {
+	if !alarm.fallback.IsDegraded() {
+		err := alarm.messageRepo.EnqueueMessage(ctx, msg)
+		if err == nil {
+			alarmMessagesCounter.WithLabelValues(msg.Platform, alarmStatusEnqueued).Inc()
+			return
+		}
 		log.Context(ctx).Errorf("SendBizMessage:EnqueueMessage error: %v", err)
+		alarm.degrade(ctx, err)
+	}
+	if alarm.fallback.Enqueue(msg) {
+		alarmMessagesCounter.WithLabelValues(msg.Platform, alarmStatusEnqueued).Inc()
+	} else {
+		alarmMessagesCounter.WithLabelValues(msg.Platform, alarmStatusDropped).Inc()
+		log.Context(ctx).Error("Alarm fallback queue is full, drop message",
+			loghelper.String("title", msg.Card.Title), loghelper.String("msg", msg.Card.Info))
 	}
 }
 
@@ -140,6 +292,16 @@ func (alarm *Alarm) StartWorkerPool() {
 	// 启动延迟队列处理器
 	alarm.wg.Add(1)
 	go alarm.delayedQueueProcessor()
+	// 启动降级通道的发送器和Redis恢复探测
+	alarm.wg.Add(2)
+	go alarm.fallbackSender()
+	go alarm.fallbackMonitor()
+	// 启动限流合并队列的发送器，限流配置可能在热更新时增加，始终启动
+	alarm.wg.Add(1)
+	go alarm.batchFlusher()
+	// 启动队列指标采集
+	alarm.wg.Add(1)
+	go alarm.metricsCollector()
 }
 
 // delayedQueueProcessor 延迟队列处理器
@@ -158,6 +320,9 @@ func (alarm *Alarm) delayedQueueProcessor() {
 			log.Context(alarm.ctx).Info("Delayed queue processor stopped")
 			return
 		case <-ticker.C:
+			if alarm.fallback.IsDegraded() {
+				continue
+			}
 			if err := alarm.messageRepo.ProcessDelayedMessages(alarm.ctx); err != nil {
 				log.Context(alarm.ctx).Errorf("Process delayed messages error: %v", err)
 			}
@@ -189,6 +354,10 @@ func (alarm *Alarm) worker(id int) {
 			log.Context(alarm.ctx).Infof("Worker %d stopped", id)
 			return
 		default:
+			if alarm.fallback.IsDegraded() {
+				time.Sleep(time.Second) // 降级期间由fallbackSender发送
+				continue
+			}
 			task, err := alarm.messageRepo.DequeueMessage(alarm.ctx)
 			if err != nil {
 				if !errors.Is(err, redis.Nil) {
@@ -208,14 +377,40 @@ func (alarm *Alarm) processMessage(msg *AlarmMessage) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 	defer cancel()
 
-	err := alarm.alarms[msg.Platform].SendTextMessage(ctx, msg.AlarmTextMessage)
+	if _, ok := alarm.sender(msg.Platform); !ok {
+		log.Context(ctx).Errorf("Alarm platform %s not configured, drop message %s", msg.Platform, msg.Card.Title)
+		return
+	}
+	// 令牌不足时消息进入合并队列，由batchFlusher合并发送
+	if !alarm.acquireSendToken(ctx, msg) {
+		return
+	}
+	alarm.deliverMessage(ctx, msg)
+}
+
+// deliverMessage 发送消息，失败时进入延迟队列重试
+func (alarm *Alarm) deliverMessage(ctx context.Context, msg *AlarmMessage) {
+	sender, ok := alarm.sender(msg.Platform)
+	if !ok {
+		log.Context(ctx).Errorf("Alarm platform %s not configured, drop message %s", msg.Platform, msg.Card.Title)
+		return
+	}
+	start := time.Now()
+	err := sender.Send(ctx, msg.Card)
+	alarmSendDuration.WithLabelValues(msg.Platform).Observe(time.Since(start).Seconds())
 	if err != nil {
+		alarmSendCounter.WithLabelValues(msg.Platform, "failed").Inc()
 		log.Context(ctx).Error("SendBizMessage error",
-			loghelper.String("title", msg.AlarmTextMessage.Title),
-			loghelper.String("msg", msg.AlarmTextMessage.Info), loghelper.FieldErr(err))
+			loghelper.String("title", msg.Card.Title),
+			loghelper.String("msg", msg.Card.Info), loghelper.FieldErr(err))
+		var rateLimitedErr *alarmRateLimitedError
+		if errors.As(err, &rateLimitedErr) && alarm.onRateLimited(ctx, msg, rateLimitedErr.RetryAfter) {
+			return
+		}
 		// 重试逻辑
 		if msg.Retry < msg.MaxRetry {
 			msg.Retry++
+			alarmRetryCounter.WithLabelValues(msg.Platform).Inc()
 			// 使用指数退避策略计算延迟时间：1s, 2s, 4s, 8s...
 			retryDelay := time.Duration(1<<uint(msg.Retry-1)) * time.Second
 			if retryDelay > 30*time.Second {
@@ -223,18 +418,46 @@ func (alarm *Alarm) processMessage(msg *AlarmMessage) {
 			}
 
 			log.Context(ctx).Debugf("Scheduling alarm message %s retry (attempt %d/%d) after %v",
-				msg.AlarmTextMessage.TraceId, msg.Retry, msg.MaxRetry, retryDelay)
+				msg.Card.TraceId, msg.Retry, msg.MaxRetry, retryDelay)
 
 			// 使用延迟队列，避免goroutine爆炸
 			if err := alarm.messageRepo.EnqueueDelayedMessage(ctx, msg, retryDelay); err != nil {
 				log.Context(ctx).Errorf("Failed to enqueue delayed alarm message %s: %v",
-					msg.AlarmTextMessage.TraceId, err)
+					msg.Card.TraceId, err)
+				alarm.degrade(ctx, err)
+				alarm.fallback.Enqueue(msg)
 			}
 		} else {
+			alarmRetryExhaustedCounter.WithLabelValues(msg.Platform).Inc()
 			log.Context(ctx).Warnf("Alarm message %s reached max retry limit (%d), giving up",
-				msg.AlarmTextMessage.TraceId, msg.MaxRetry)
+				msg.Card.TraceId, msg.MaxRetry)
 		}
 	} else {
-		log.Context(ctx).Debugf("Alarm message %s completed successfully", msg.AlarmTextMessage.TraceId)
+		alarmSendCounter.WithLabelValues(msg.Platform, "success").Inc()
+		log.Context(ctx).Debugf("Alarm message %s completed successfully", msg.Card.TraceId)
+	}
+}
+
+// newAlarmCard 组装告警卡片，从ctx中补全请求上下文
+func newAlarmCard(ctx context.Context, title string, detail *biz.AlarmDetail) *AlarmCard {
+	card := &AlarmCard{
+		Title:   title,
+		Info:    detail.Info,
+		Service: global.GetServiceName(),
+		Env:     global.GetEnv(),
+		Host:    global.GetHost(),
+		Version: global.GetVersion(),
+		TraceId: webkit.GetTraceID(ctx),
+		// 与熔断使用相同的指纹，用于ack
+		Fingerprint: alarmFingerprint(detail.Info),
+		Operation:   webkit.GetOperationFromContext(ctx),
+		Path:        webkit.CtxGetPath(ctx),
+		Panic:       detail.Panic,
+		Stack:       detail.Stack,
+		Error:       detail.Error,
+	}
+	if userId, ok := webkit.UserIdFromContext(ctx); ok {
+		card.UserId = userId
 	}
+	return card
 }
@@ -0,0 +1,255 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/webkit/thirds"
+)
+
+const (
+	AlarmChannelLark  = "lark"
+	AlarmChannelSlack = "slack"
+)
+
+// AlarmCard 告警卡片内容，由各渠道渲染为对应的消息格式
+type AlarmCard struct {
+	Title     string `json:"title"`
+	Info      string `json:"info"`
+	Service   string `json:"service"`
+	Env       string `json:"env"`
+	Host      string `json:"host"`
+	Version   string `json:"version,omitempty"`
+	TraceId   string `json:"trace_id"`
+	Operation string `json:"operation"`
+	Path      string `json:"path,omitempty"`
+	UserId    string `json:"user_id,omitempty"`
+	Panic     string `json:"panic,omitempty"`
+	Stack     string `json:"stack,omitempty"`
+	Error     string `json:"error,omitempty"`
+	// 告警指纹，用于ack
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+type alarmCardField struct {
+	Label string
+	Value string
+}
+
+// ShortFields 卡片中的短字段（并排展示），忽略空值
+func (card *AlarmCard) ShortFields() []alarmCardField {
+	fields := []alarmCardField{
+		{"Service", card.Service},
+		{"Env", card.Env},
+		{"Host", card.Host},
+		{"Version", card.Version},
+		{"Operation", card.Operation},
+		{"Path", card.Path},
+		{"User ID", card.UserId},
+		{"Trace ID", card.TraceId},
+		{"Fingerprint", card.Fingerprint},
+	}
+	result := make([]alarmCardField, 0, len(fields))
+	for _, field := range fields {
+		if field.Value != "" {
+			result = append(result, field)
+		}
+	}
+	return result
+}
+
+// Labels 静默规则可匹配的告警标签
+func (card *AlarmCard) Labels(platform string) map[string]string {
+	return map[string]string{
+		biz.AlarmLabelPlatform:  platform,
+		biz.AlarmLabelService:   card.Service,
+		biz.AlarmLabelEnv:       card.Env,
+		biz.AlarmLabelHost:      card.Host,
+		biz.AlarmLabelOperation: card.Operation,
+		biz.AlarmLabelPath:      card.Path,
+		biz.AlarmLabelUserId:    card.UserId,
+	}
+}
+
+// LongFields 卡片中的长字段（独占一行），忽略空值
+func (card *AlarmCard) LongFields() []alarmCardField {
+	fields := []alarmCardField{
+		{"Info", card.Info},
+		{"Error", card.Error},
+		{"Panic", card.Panic},
+		{"Stack", card.Stack},
+	}
+	result := make([]alarmCardField, 0, len(fields))
+	for _, field := range fields {
+		if field.Value != "" {
+			result = append(result, field)
+		}
+	}
+	return result
+}
+
+// alarmSender 告警渠道发送器
+type alarmSender interface {
+	Send(ctx context.Context, card *AlarmCard) error
+}
+
+func newAlarmSender(channel, webhook string) (alarmSender, error) {
+	switch channel {
+	case "", AlarmChannelLark:
+		return &larkAlarmSender{webhook: webhook}, nil
+	case AlarmChannelSlack:
+		return &slackAlarmSender{webhook: webhook}, nil
+	default:
+		return nil, fmt.Errorf("unsupported alarm channel: %s", channel)
+	}
+}
+
+type larkAlarmSender struct {
+	webhook string
+}
+
+func (sender *larkAlarmSender) Send(ctx context.Context, card *AlarmCard) error {
+	template := "blue"
+	if card.Panic != "" {
+		template = "red"
+	} else if card.Error != "" {
+		template = "orange"
+	}
+
+	var fields []map[string]interface{}
+	for _, field := range card.ShortFields() {
+		fields = append(fields, map[string]interface{}{
+			"is_short": true,
+			"text": map[string]string{
+				"tag":     "lark_md",
+				"content": fmt.Sprintf("**%s**\n%s", field.Label, field.Value),
+			},
+		})
+	}
+	elements := []map[string]interface{}{
+		{"tag": "div", "fields": fields},
+	}
+	for _, field := range card.LongFields() {
+		elements = append(elements, map[string]interface{}{
+			"tag": "div",
+			"text": map[string]string{
+				"tag":     "plain_text",
+				"content": fmt.Sprintf("%s: %s", field.Label, field.Value),
+			},
+		})
+	}
+
+	return postAlarmWebhook(ctx, sender.webhook, map[string]interface{}{
+		"msg_type": "interactive",
+		"card": map[string]interface{}{
+			"header": map[string]interface{}{
+				"title": map[string]string{
+					"tag":     "plain_text",
+					"content": card.Title,
+				},
+				"template": template,
+			},
+			"elements": elements,
+		},
+	})
+}
+
+type slackAlarmSender struct {
+	webhook string
+}
+
+func (sender *slackAlarmSender) Send(ctx context.Context, card *AlarmCard) error {
+	var fields []map[string]string
+	for _, field := range card.ShortFields() {
+		fields = append(fields, map[string]string{
+			"type": "mrkdwn",
+			"text": fmt.Sprintf("*%s*\n%s", field.Label, field.Value),
+		})
+	}
+	blocks := []map[string]interface{}{
+		{"type": "header", "text": map[string]string{"type": "plain_text", "text": card.Title}},
+	}
+	// slack 单个section最多10个fields
+	for start := 0; start < len(fields); start += 10 {
+		end := min(start+10, len(fields))
+		blocks = append(blocks, map[string]interface{}{"type": "section", "fields": fields[start:end]})
+	}
+	for _, field := range card.LongFields() {
+		blocks = append(blocks, map[string]interface{}{
+			"type": "section",
+			"text": map[string]string{
+				"type": "mrkdwn",
+				"text": fmt.Sprintf("*%s*\n```%s```", field.Label, field.Value),
+			},
+		})
+	}
+
+	return postAlarmWebhook(ctx, sender.webhook, map[string]interface{}{
+		"text":   card.Title,
+		"blocks": blocks,
+	})
+}
+
+// postAlarmWebhook 发送告警到webhook
+func postAlarmWebhook(ctx context.Context, url string, body interface{}) error {
+	jsonData, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("json marshal failed: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(jsonData))
+	if err != nil {
+		return fmt.Errorf("new request failed: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := thirds.DefaultHTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("http post failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return &alarmRateLimitedError{RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"))}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("http post failed with status %d: %s", resp.StatusCode, resp.Status)
+	}
+	return nil
+}
+
+const (
+	// alarmDefaultRetryAfter 429未携带Retry-After时的默认等待时长
+	alarmDefaultRetryAfter = 5 * time.Second
+	alarmMaxRetryAfter     = 10 * time.Minute
+)
+
+// alarmRateLimitedError webhook返回429
+type alarmRateLimitedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *alarmRateLimitedError) Error() string {
+	return fmt.Sprintf("http post rate limited, retry after %s", e.RetryAfter)
+}
+
+// parseRetryAfter 解析Retry-After头，支持秒数和HTTP日期两种格式
+func parseRetryAfter(value string) time.Duration {
+	var retryAfter time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		retryAfter = time.Duration(seconds) * time.Second
+	} else if at, err := http.ParseTime(value); err == nil {
+		retryAfter = time.Until(at)
+	}
+	if retryAfter <= 0 {
+		return alarmDefaultRetryAfter
+	}
+	return min(retryAfter, alarmMaxRetryAfter)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/redis/go-redis/v9"
+	"github.com/seanbit/kratos/template/internal/biz"
 	"github.com/seanbit/kratos/template/internal/data/dao"
 	"github.com/seanbit/kratos/template/internal/data/model"
 	"github.com/seanbit/kratos/template/internal/global"
@@ -63,6 +64,7 @@ func (repo *alarmMessageRepo) IsMessageFusing(ctx context.Context, serviceName,
 		if errors.Is(err, redis.Nil) {
 			return false, nil
 		}
+		alarmRedisErrorCounter.WithLabelValues("is_message_fusing").Inc()
 		return false, err
 	}
 	return res != "", nil
@@ -71,7 +73,64 @@ func (repo *alarmMessageRepo) IsMessageFusing(ctx context.Context, serviceName,
 func (repo *alarmMessageRepo) FuseMessage(ctx context.Context, serviceName, message string, fuseDuration time.Duration) error {
 	infoKey := repo.md5([]byte(message))
 	key := repo.FilterWordsFuseKey(serviceName, infoKey)
-	return repo.rdbProvider.GetRedis().SetNX(ctx, key, "1", fuseDuration).Err()
+	// 保存消息内容，便于查询熔断中的消息
+	if err := repo.rdbProvider.GetRedis().SetNX(ctx, key, truncateFuseMessage(message), fuseDuration).Err(); err != nil {
+		alarmRedisErrorCounter.WithLabelValues("fuse_message").Inc()
+		return err
+	}
+	return nil
+}
+
+// truncateFuseMessage 熔断key中保存的消息内容最多保留256个字符
+func truncateFuseMessage(message string) string {
+	if runes := []rune(message); len(runes) > 256 {
+		return string(runes[:256])
+	}
+	return message
+}
+
+// ListFusedMessages 列出熔断中的消息及剩余时长
+func (repo *alarmMessageRepo) ListFusedMessages(ctx context.Context, serviceName string) ([]*biz.FusedAlarmMessage, error) {
+	rdb := repo.rdbProvider.GetRedis()
+	prefix := repo.FilterWordsFuseKey(serviceName, "")
+	var keys []string
+	iter := rdb.Scan(ctx, 0, prefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		alarmRedisErrorCounter.WithLabelValues("list_fused_messages").Inc()
+		return nil, fmt.Errorf("scan fused messages failed: %w", err)
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	pipe := rdb.Pipeline()
+	getCmds := make([]*redis.StringCmd, len(keys))
+	ttlCmds := make([]*redis.DurationCmd, len(keys))
+	for i, key := range keys {
+		getCmds[i] = pipe.Get(ctx, key)
+		ttlCmds[i] = pipe.TTL(ctx, key)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		alarmRedisErrorCounter.WithLabelValues("list_fused_messages").Inc()
+		return nil, fmt.Errorf("get fused messages failed: %w", err)
+	}
+
+	fused := make([]*biz.FusedAlarmMessage, 0, len(keys))
+	for i, key := range keys {
+		// 期间已过期的key
+		if errors.Is(getCmds[i].Err(), redis.Nil) {
+			continue
+		}
+		fused = append(fused, &biz.FusedAlarmMessage{
+			Fingerprint: strings.TrimPrefix(key, prefix),
+			Info:        getCmds[i].Val(),
+			TTL:         ttlCmds[i].Val(),
+		})
+	}
+	return fused, nil
 }
 
 func (repo *alarmMessageRepo) IncrMessageTimes(ctx context.Context, serviceName, message string,
@@ -145,12 +204,29 @@ func (repo *alarmMessageRepo) FilterWordsFuseKey(serviceName, infoKey string) st
 	return fmt.Sprintf("%s:alarm:fuse:%s", serviceName, infoKey)
 }
 
+// Ping 检查Redis是否可用
+func (repo *alarmMessageRepo) Ping(ctx context.Context) error {
+	return repo.rdbProvider.GetRedis().Ping(ctx).Err()
+}
+
+// alarmFingerprint 告警指纹：告警内容的md5，与熔断key一致
+func alarmFingerprint(info string) string {
+	m := md5.New()
+	m.Write([]byte(info))
+	return hex.EncodeToString(m.Sum(nil))
+}
+
 func (repo *alarmMessageRepo) md5(v []byte) string {
 	m := md5.New()
 	m.Write(v)
 	return hex.EncodeToString(m.Sum(nil))
 }
 
+// queueKey 主队列的Redis key
+func (repo *alarmMessageRepo) queueKey() string {
+	return fmt.Sprintf("%s:alarm:message:queue", global.GetServiceName())
+}
+
 // EnqueueMessage 入队
 func (repo *alarmMessageRepo) EnqueueMessage(ctx context.Context, msg *AlarmMessage) error {
 	taskData, err := json.Marshal(msg)
@@ -159,9 +235,9 @@ func (repo *alarmMessageRepo) EnqueueMessage(ctx context.Context, msg *AlarmMess
 	}
 
 	// 使用LPUSH将任务添加到队列头部
-	queueName := fmt.Sprintf("%s:alarm:message:queue", global.GetServiceName())
-	err = repo.rdbProvider.GetRedis().LPush(ctx, queueName, taskData).Err()
+	err = repo.rdbProvider.GetRedis().LPush(ctx, repo.queueKey(), taskData).Err()
 	if err != nil {
+		alarmRedisErrorCounter.WithLabelValues("enqueue").Inc()
 		return fmt.Errorf("enqueue failed: %w", err)
 	}
 
@@ -171,9 +247,11 @@ func (repo *alarmMessageRepo) EnqueueMessage(ctx context.Context, msg *AlarmMess
 // DequeueMessage 出队
 func (repo *alarmMessageRepo) DequeueMessage(ctx context.Context) (*AlarmMessage, error) {
 	// 使用BRPop从队列尾部获取任务，阻塞等待
-	queueName := fmt.Sprintf("%s:alarm:message:queue", global.GetServiceName())
-	result, err := repo.rdbProvider.GetRedis().BRPop(ctx, time.Second*10, queueName).Result()
+	result, err := repo.rdbProvider.GetRedis().BRPop(ctx, time.Second*10, repo.queueKey()).Result()
 	if err != nil {
+		if !errors.Is(err, redis.Nil) && ctx.Err() == nil {
+			alarmRedisErrorCounter.WithLabelValues("dequeue").Inc()
+		}
 		return nil, fmt.Errorf("dequeue alarm message failed: %w", err)
 	}
 
@@ -189,6 +267,16 @@ func (repo *alarmMessageRepo) DequeueMessage(ctx context.Context) (*AlarmMessage
 	return &msg, nil
 }
 
+// QueueLength 主队列深度
+func (repo *alarmMessageRepo) QueueLength(ctx context.Context) (int64, error) {
+	length, err := repo.rdbProvider.GetRedis().LLen(ctx, repo.queueKey()).Result()
+	if err != nil {
+		alarmRedisErrorCounter.WithLabelValues("queue_length").Inc()
+		return 0, fmt.Errorf("get alarm queue length failed: %w", err)
+	}
+	return length, nil
+}
+
 // delayQueueKey 延迟队列的Redis key
 func (repo *alarmMessageRepo) delayQueueKey() string {
 	return fmt.Sprintf("%s:alarm:message:delay_queue", global.GetServiceName())
@@ -204,25 +292,63 @@ func (repo *alarmMessageRepo) EnqueueDelayedMessage(ctx context.Context, msg *Al
 
 	executeAt := float64(time.Now().Add(delay).UnixMilli())
 	// 使用消息的TraceId+Retry作为member的一部分，避免重复消息被覆盖
-	member := fmt.Sprintf("%s:%d:%s", msg.AlarmTextMessage.TraceId, msg.Retry, string(taskData))
+	member := fmt.Sprintf("%s:%d:%s", msg.Card.TraceId, msg.Retry, string(taskData))
 
 	err = repo.rdbProvider.GetRedis().ZAdd(ctx, repo.delayQueueKey(), redis.Z{
 		Score:  executeAt,
 		Member: member,
 	}).Err()
 	if err != nil {
+		alarmRedisErrorCounter.WithLabelValues("enqueue_delayed").Inc()
 		return fmt.Errorf("enqueue delayed message failed: %w", err)
 	}
 
 	return nil
 }
 
+// DelayedQueueSize 延迟队列大小
+func (repo *alarmMessageRepo) DelayedQueueSize(ctx context.Context) (int64, error) {
+	size, err := repo.rdbProvider.GetRedis().ZCard(ctx, repo.delayQueueKey()).Result()
+	if err != nil {
+		alarmRedisErrorCounter.WithLabelValues("delayed_queue_size").Inc()
+		return 0, fmt.Errorf("get alarm delayed queue size failed: %w", err)
+	}
+	return size, nil
+}
+
+// ListDelayedMessages 按执行时间顺序列出延迟队列中的消息
+func (repo *alarmMessageRepo) ListDelayedMessages(ctx context.Context, limit int) ([]*DelayedAlarmMessage, error) {
+	members, err := repo.rdbProvider.GetRedis().ZRangeWithScores(ctx, repo.delayQueueKey(), 0, int64(limit-1)).Result()
+	if err != nil {
+		alarmRedisErrorCounter.WithLabelValues("list_delayed_messages").Inc()
+		return nil, fmt.Errorf("list delayed messages failed: %w", err)
+	}
+
+	messages := make([]*DelayedAlarmMessage, 0, len(members))
+	for _, member := range members {
+		// member格式: traceId:retry:jsonData
+		parts := strings.SplitN(member.Member.(string), ":", 3)
+		if len(parts) < 3 {
+			continue
+		}
+		var msg AlarmMessage
+		if err = json.Unmarshal([]byte(parts[2]), &msg); err != nil {
+			continue
+		}
+		messages = append(messages, &DelayedAlarmMessage{
+			AlarmMessage: &msg,
+			ExecuteAt:    time.UnixMilli(int64(member.Score)),
+		})
+	}
+	return messages, nil
+}
+
 // ProcessDelayedMessages 处理到期的延迟消息，将其移入主队列
 // 使用 ZRANGEBYSCORE 获取到期消息，然后 ZREM 删除并 LPUSH 到主队列
 func (repo *alarmMessageRepo) ProcessDelayedMessages(ctx context.Context) error {
 	now := float64(time.Now().UnixMilli())
 	delayKey := repo.delayQueueKey()
-	queueKey := fmt.Sprintf("%s:alarm:message:queue", global.GetServiceName())
+	queueKey := repo.queueKey()
 	rdb := repo.rdbProvider.GetRedis()
 
 	// 获取所有到期的消息（score <= now）
@@ -232,6 +358,7 @@ func (repo *alarmMessageRepo) ProcessDelayedMessages(ctx context.Context) error
 		Count: 100, // 每次最多处理100条，避免阻塞过久
 	}).Result()
 	if err != nil {
+		alarmRedisErrorCounter.WithLabelValues("process_delayed").Inc()
 		return fmt.Errorf("get delayed messages failed: %w", err)
 	}
 
@@ -260,8 +387,10 @@ func (repo *alarmMessageRepo) ProcessDelayedMessages(ctx context.Context) error
 
 	_, err = pipe.Exec(ctx)
 	if err != nil {
+		alarmRedisErrorCounter.WithLabelValues("process_delayed").Inc()
 		return fmt.Errorf("process delayed messages pipeline failed: %w", err)
 	}
+	alarmDelayedProcessedCounter.Add(float64(len(members)))
 
 	if len(members) > 0 {
 		repo.log.Infof("Processed %d delayed alarm messages", len(members))
@@ -269,3 +398,122 @@ func (repo *alarmMessageRepo) ProcessDelayedMessages(ctx context.Context) error
 
 	return nil
 }
+
+// alarmTokenBucketScript 令牌桶限流，平台被Retry-After阻塞期间直接拒绝
+// KEYS[1]: 令牌桶 KEYS[2]: 阻塞标记 ARGV: rate(个/秒，不大于0时不限流) burst now(毫秒)
+// 返回 {是否获取到令牌, 需要等待的毫秒数}
+var alarmTokenBucketScript = redis.NewScript(`
+local blocked = redis.call('PTTL', KEYS[2])
+if blocked > 0 then
+	return {0, blocked}
+end
+local rate = tonumber(ARGV[1])
+if rate <= 0 then
+	return {1, 0}
+end
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return {allowed, wait}
+`)
+
+func (repo *alarmMessageRepo) rateLimitKey(platform string) string {
+	return fmt.Sprintf("%s:alarm:ratelimit:%s", global.GetServiceName(), platform)
+}
+
+func (repo *alarmMessageRepo) rateLimitBlockedKey(platform string) string {
+	return fmt.Sprintf("%s:alarm:ratelimit:%s:blocked", global.GetServiceName(), platform)
+}
+
+func (repo *alarmMessageRepo) batchQueueKey(platform string) string {
+	return fmt.Sprintf("%s:alarm:message:batch:%s", global.GetServiceName(), platform)
+}
+
+// AcquireSendToken 从platform的令牌桶获取一个发送令牌，获取失败时返回需要等待的时长
+func (repo *alarmMessageRepo) AcquireSendToken(ctx context.Context, platform string, rate float64, burst int) (bool, time.Duration, error) {
+	keys := []string{repo.rateLimitKey(platform), repo.rateLimitBlockedKey(platform)}
+	res, err := alarmTokenBucketScript.Run(ctx, repo.rdbProvider.GetRedis(), keys, rate, burst, time.Now().UnixMilli()).Int64Slice()
+	if err != nil {
+		alarmRedisErrorCounter.WithLabelValues("acquire_send_token").Inc()
+		return false, 0, fmt.Errorf("acquire alarm send token failed: %w", err)
+	}
+	if len(res) < 2 {
+		return false, 0, fmt.Errorf("invalid token bucket result")
+	}
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
+
+// BlockPlatform 按webhook返回的Retry-After暂停platform的发送
+func (repo *alarmMessageRepo) BlockPlatform(ctx context.Context, platform string, duration time.Duration) error {
+	if err := repo.rdbProvider.GetRedis().Set(ctx, repo.rateLimitBlockedKey(platform), "1", duration).Err(); err != nil {
+		alarmRedisErrorCounter.WithLabelValues("block_platform").Inc()
+		return fmt.Errorf("block alarm platform failed: %w", err)
+	}
+	return nil
+}
+
+// AppendBatchMessage 限流期间的消息加入platform的合并队列
+func (repo *alarmMessageRepo) AppendBatchMessage(ctx context.Context, msg *AlarmMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("marshal batch alarm message failed: %w", err)
+	}
+	if err = repo.rdbProvider.GetRedis().RPush(ctx, repo.batchQueueKey(msg.Platform), data).Err(); err != nil {
+		alarmRedisErrorCounter.WithLabelValues("append_batch").Inc()
+		return fmt.Errorf("append batch alarm message failed: %w", err)
+	}
+	return nil
+}
+
+// BatchLength 合并队列中积压的消息数
+func (repo *alarmMessageRepo) BatchLength(ctx context.Context, platform string) (int64, error) {
+	length, err := repo.rdbProvider.GetRedis().LLen(ctx, repo.batchQueueKey(platform)).Result()
+	if err != nil {
+		alarmRedisErrorCounter.WithLabelValues("batch_length").Inc()
+		return 0, fmt.Errorf("get batch alarm length failed: %w", err)
+	}
+	return length, nil
+}
+
+// PopBatchMessages 原子地取出合并队列中最早的limit条消息
+func (repo *alarmMessageRepo) PopBatchMessages(ctx context.Context, platform string, limit int) ([]*AlarmMessage, error) {
+	key := repo.batchQueueKey(platform)
+	var rangeCmd *redis.StringSliceCmd
+	_, err := repo.rdbProvider.GetRedis().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		rangeCmd = pipe.LRange(ctx, key, 0, int64(limit-1))
+		pipe.LTrim(ctx, key, int64(limit), -1)
+		return nil
+	})
+	if err != nil {
+		alarmRedisErrorCounter.WithLabelValues("pop_batch").Inc()
+		return nil, fmt.Errorf("pop batch alarm messages failed: %w", err)
+	}
+
+	messages := make([]*AlarmMessage, 0, len(rangeCmd.Val()))
+	for _, data := range rangeCmd.Val() {
+		var msg AlarmMessage
+		if err = json.Unmarshal([]byte(data), &msg); err != nil {
+			repo.log.Warnf("invalid batch alarm message: %s", data)
+			continue
+		}
+		messages = append(messages, &msg)
+	}
+	return messages, nil
+}
@@ -0,0 +1,211 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/pkg/loghelper"
+)
+
+const (
+	alarmFallbackDefaultQueueSize = 1000
+	// alarmFallbackProbeInterval 降级模式下探测Redis恢复的间隔
+	alarmFallbackProbeInterval = 5 * time.Second
+)
+
+// alarmFallback Redis不可用时的降级告警通道
+// 使用进程内有界队列和内存中的计数/熔断状态，消息直接投递到默认平台
+type alarmFallback struct {
+	degraded *atomic.Bool
+	queue    chan *AlarmMessage
+
+	mu     sync.Mutex
+	times  map[string]*alarmFallbackCounter // message -> 计数
+	fusing map[string]time.Time             // message -> 熔断结束时间
+}
+
+type alarmFallbackCounter struct {
+	times    int
+	expireAt time.Time
+}
+
+func newAlarmFallback(queueSize int) *alarmFallback {
+	if queueSize <= 0 {
+		queueSize = alarmFallbackDefaultQueueSize
+	}
+	return &alarmFallback{
+		degraded: &atomic.Bool{},
+		queue:    make(chan *AlarmMessage, queueSize),
+		times:    make(map[string]*alarmFallbackCounter),
+		fusing:   make(map[string]time.Time),
+	}
+}
+
+// IsDegraded 是否处于降级模式
+func (fb *alarmFallback) IsDegraded() bool {
+	return fb.degraded.Load()
+}
+
+// Degrade 进入降级模式，返回是否为本次切换
+func (fb *alarmFallback) Degrade() bool {
+	return fb.degraded.CompareAndSwap(false, true)
+}
+
+// Recover 退出降级模式，返回是否为本次切换
+func (fb *alarmFallback) Recover() bool {
+	return fb.degraded.CompareAndSwap(true, false)
+}
+
+// IncrMessageTimes 与 alarmMessageRepo.IncrMessageTimes 语义一致的内存实现
+func (fb *alarmFallback) IncrMessageTimes(message string, cooldownTimes int, cacheIgnoreTime time.Duration) bool {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+
+	now := time.Now()
+	counter, ok := fb.times[message]
+	if !ok || counter.expireAt.Before(now) {
+		fb.times[message] = &alarmFallbackCounter{times: 1, expireAt: now.Add(cacheIgnoreTime)}
+		return false
+	}
+	counter.expireAt = now.Add(cacheIgnoreTime)
+	if counter.times+1 >= cooldownTimes {
+		counter.times = 0
+		return true
+	}
+	counter.times++
+	return false
+}
+
+// FuseMessage 熔断消息，已熔断的不延长
+func (fb *alarmFallback) FuseMessage(message string, fuseDuration time.Duration) {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+
+	if endAt, ok := fb.fusing[message]; ok && endAt.After(time.Now()) {
+		return
+	}
+	fb.fusing[message] = time.Now().Add(fuseDuration)
+}
+
+func (fb *alarmFallback) IsMessageFusing(message string) bool {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+
+	endAt, ok := fb.fusing[message]
+	return ok && endAt.After(time.Now())
+}
+
+// Enqueue 非阻塞入队，队列满时丢弃
+func (fb *alarmFallback) Enqueue(msg *AlarmMessage) bool {
+	select {
+	case fb.queue <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
+// CleanExpired 清理过期的计数和熔断状态
+func (fb *alarmFallback) CleanExpired() {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+
+	now := time.Now()
+	for message, counter := range fb.times {
+		if counter.expireAt.Before(now) {
+			delete(fb.times, message)
+		}
+	}
+	for message, endAt := range fb.fusing {
+		if endAt.Before(now) {
+			delete(fb.fusing, message)
+		}
+	}
+}
+
+// degrade Redis调用失败时切换到降级模式
+func (alarm *Alarm) degrade(ctx context.Context, err error) {
+	if alarm.fallback.Degrade() {
+		alarmDegradedGauge.Set(1)
+		log.Context(ctx).Warn("Alarm redis unavailable, switch to degraded mode", loghelper.FieldErr(err))
+	}
+}
+
+// fallbackSender 降级队列的唯一消费者：降级模式下直接投递到默认平台，Redis恢复后将剩余消息转回Redis队列
+func (alarm *Alarm) fallbackSender() {
+	defer alarm.wg.Done()
+
+	log.Context(alarm.ctx).Info("Alarm fallback sender started")
+	for {
+		select {
+		case <-alarm.ctx.Done():
+			log.Context(alarm.ctx).Info("Alarm fallback sender stopped")
+			return
+		case msg := <-alarm.fallback.queue:
+			if !alarm.fallback.IsDegraded() && alarm.requeueFallbackMessage(msg) {
+				continue
+			}
+			alarm.sendFallbackMessage(msg)
+		}
+	}
+}
+
+// requeueFallbackMessage 将降级期间积压的消息转回Redis队列，Redis再次不可用时返回false
+func (alarm *Alarm) requeueFallbackMessage(msg *AlarmMessage) bool {
+	if err := alarm.messageRepo.EnqueueMessage(alarm.ctx, msg); err != nil {
+		alarm.degrade(alarm.ctx, err)
+		return false
+	}
+	log.Context(alarm.ctx).Debugf("Alarm fallback message %s requeued to redis", msg.Card.TraceId)
+	return true
+}
+
+func (alarm *Alarm) sendFallbackMessage(msg *AlarmMessage) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	platforms := alarm.platforms.Load()
+	platform := platforms.config.DefaultPlatform
+	start := time.Now()
+	err := platforms.senders[platform].Send(ctx, msg.Card)
+	alarmSendDuration.WithLabelValues(platform).Observe(time.Since(start).Seconds())
+	if err != nil {
+		alarmDegradedSentCounter.WithLabelValues(platform, "failed").Inc()
+		log.Context(ctx).Error("Alarm fallback send error",
+			loghelper.String("title", msg.Card.Title),
+			loghelper.String("msg", msg.Card.Info), loghelper.FieldErr(err))
+		return
+	}
+	alarmDegradedSentCounter.WithLabelValues(platform, "success").Inc()
+}
+
+// fallbackMonitor 降级模式下定期探测Redis，恢复后退出降级模式，积压的消息由fallbackSender转回Redis队列
+func (alarm *Alarm) fallbackMonitor() {
+	defer alarm.wg.Done()
+
+	ticker := time.NewTicker(alarmFallbackProbeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-alarm.ctx.Done():
+			return
+		case <-ticker.C:
+			alarm.fallback.CleanExpired()
+			if !alarm.fallback.IsDegraded() {
+				continue
+			}
+			if err := alarm.messageRepo.Ping(alarm.ctx); err != nil {
+				log.Context(alarm.ctx).Debugf("Alarm redis still unavailable: %v", err)
+				continue
+			}
+			if alarm.fallback.Recover() {
+				alarmDegradedGauge.Set(0)
+				log.Context(alarm.ctx).Infof("Alarm redis recovered, leave degraded mode, %d fallback messages pending", len(alarm.fallback.queue))
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package data
+
+import (
+	"context"
+
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/global"
+)
+
+type alarmInspectRepo struct {
+	messageRepo IAlarmMessageRepo
+}
+
+func NewAlarmInspectRepo(messageRepo IAlarmMessageRepo) biz.IAlarmInspectRepo {
+	return &alarmInspectRepo{messageRepo: messageRepo}
+}
+
+func (repo *alarmInspectRepo) GetQueueStats(ctx context.Context, delayedLimit int) (*biz.AlarmQueueStats, error) {
+	var (
+		stats = &biz.AlarmQueueStats{}
+		err   error
+	)
+	if stats.QueueLength, err = repo.messageRepo.QueueLength(ctx); err != nil {
+		return nil, err
+	}
+	if stats.DelayedSize, err = repo.messageRepo.DelayedQueueSize(ctx); err != nil {
+		return nil, err
+	}
+	delayed, err := repo.messageRepo.ListDelayedMessages(ctx, delayedLimit)
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range delayed {
+		stats.DelayedMessages = append(stats.DelayedMessages, &biz.AlarmQueueMessage{
+			Platform:  msg.Platform,
+			Title:     msg.Card.Title,
+			Info:      msg.Card.Info,
+			TraceId:   msg.Card.TraceId,
+			Retry:     msg.Retry,
+			MaxRetry:  msg.MaxRetry,
+			ExecuteAt: msg.ExecuteAt,
+		})
+	}
+	if stats.FusedMessages, err = repo.messageRepo.ListFusedMessages(ctx, global.GetServiceName()); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,120 @@
+package data
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// 告警消息的处理状态
+const (
+	alarmStatusEnqueued = "enqueued"
+	alarmStatusFused    = "fused"
+	alarmStatusDryRun   = "dry_run"
+	alarmStatusDropped  = "dropped"
+	alarmStatusSilenced = "silenced"
+	alarmStatusAcked    = "acked"
+)
+
+var (
+	alarmMessagesCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "alarm_messages_total",
+		Help: "The number of alarm messages by status (enqueued, fused, dry_run, dropped, silenced, acked)",
+	}, []string{"platform", "status"})
+
+	alarmFuseTriggeredCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "alarm_fuse_triggered_total",
+		Help: "The number of times an ignore-word message exceeded its cooldown times and was fused",
+	})
+
+	alarmSendCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "alarm_send_total",
+		Help: "The number of alarm webhook sends by result",
+	}, []string{"platform", "result"})
+
+	alarmSendDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "alarm_send_duration_seconds",
+		Help:    "The latency of alarm webhook sends",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"platform"})
+
+	alarmRetryCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "alarm_retries_total",
+		Help: "The number of alarm send retries scheduled",
+	}, []string{"platform"})
+
+	alarmRetryExhaustedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "alarm_retry_exhausted_total",
+		Help: "The number of alarm messages dropped after reaching max retry",
+	}, []string{"platform"})
+
+	alarmRateLimitedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "alarm_rate_limited_total",
+		Help: "The number of alarm messages deferred to the batch queue by rate limit",
+	}, []string{"platform"})
+
+	alarmDegradedSentCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "alarm_degraded_sent_total",
+		Help: "The number of alarms sent directly in degraded mode (redis unavailable)",
+	}, []string{"platform", "result"})
+
+	alarmQueueLengthGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "alarm_queue_length",
+		Help: "The number of alarm messages waiting in the redis queue",
+	})
+
+	alarmDelayedQueueSizeGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "alarm_delayed_queue_size",
+		Help: "The number of alarm messages waiting in the redis delayed (retry) queue",
+	})
+
+	alarmFallbackQueueLengthGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "alarm_fallback_queue_length",
+		Help: "The number of alarm messages waiting in the in-process fallback queue",
+	})
+
+	alarmDegradedGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "alarm_degraded",
+		Help: "Whether the alarm pipeline is in degraded mode (1) or not (0)",
+	})
+
+	alarmDelayedProcessedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "alarm_delayed_processed_total",
+		Help: "The number of delayed alarm messages moved back to the main queue",
+	})
+
+	alarmRedisErrorCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "alarm_redis_errors_total",
+		Help: "The number of failed redis operations in the alarm message repo",
+	}, []string{"op"})
+)
+
+// alarmMetricsInterval 队列指标的采集间隔
+const alarmMetricsInterval = 15 * time.Second
+
+// metricsCollector 定期采集Redis队列深度和降级队列长度
+func (alarm *Alarm) metricsCollector() {
+	defer alarm.wg.Done()
+
+	ticker := time.NewTicker(alarmMetricsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-alarm.ctx.Done():
+			return
+		case <-ticker.C:
+			alarmFallbackQueueLengthGauge.Set(float64(len(alarm.fallback.queue)))
+			if alarm.fallback.IsDegraded() {
+				continue
+			}
+			if length, err := alarm.messageRepo.QueueLength(alarm.ctx); err == nil {
+				alarmQueueLengthGauge.Set(float64(length))
+			}
+			if size, err := alarm.messageRepo.DelayedQueueSize(alarm.ctx); err == nil {
+				alarmDelayedQueueSizeGauge.Set(float64(size))
+			}
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/internal/conf"
+)
+
+const (
+	alarmDefaultMaxBatch = 20
+	// alarmBatchFlushInterval 合并队列的检查间隔
+	alarmBatchFlushInterval = time.Second
+	// alarmBatchInfoMaxLen 合并消息中每条告警内容的最大长度
+	alarmBatchInfoMaxLen = 200
+)
+
+func alarmRateLimitBurst(limit *conf.Alarm_RateLimit) int {
+	if limit.GetBurst() > 0 {
+		return int(limit.GetBurst())
+	}
+	return max(1, int(math.Ceil(limit.GetRate())))
+}
+
+func alarmRateLimitMaxBatch(limit *conf.Alarm_RateLimit) int {
+	if limit.GetMaxBatch() > 0 {
+		return int(limit.GetMaxBatch())
+	}
+	return alarmDefaultMaxBatch
+}
+
+// acquireSendToken 获取platform的发送令牌，令牌不足或platform被Retry-After暂停时将消息加入合并队列并返回false
+// 未配置限流的platform按rate=0获取，只检查是否被暂停
+func (alarm *Alarm) acquireSendToken(ctx context.Context, msg *AlarmMessage) bool {
+	limit := alarm.config().RateLimits[msg.Platform]
+	allowed, _, err := alarm.messageRepo.AcquireSendToken(ctx, msg.Platform, limit.GetRate(), alarmRateLimitBurst(limit))
+	if err != nil {
+		// 限流器不可用时不阻塞告警
+		log.Context(ctx).Errorf("Acquire alarm send token error: %v", err)
+		return true
+	}
+	if allowed {
+		return true
+	}
+	alarm.appendBatch(ctx, msg)
+	return false
+}
+
+// onRateLimited webhook返回429：按Retry-After暂停platform，消息进入合并队列，暂停结束后由batchFlusher合并发送
+func (alarm *Alarm) onRateLimited(ctx context.Context, msg *AlarmMessage, retryAfter time.Duration) bool {
+	if err := alarm.messageRepo.BlockPlatform(ctx, msg.Platform, retryAfter); err != nil {
+		// 无法暂停时按普通失败重试
+		log.Context(ctx).Errorf("Block alarm platform %s error: %v", msg.Platform, err)
+		return false
+	}
+	alarm.appendBatch(ctx, msg)
+	return true
+}
+
+func (alarm *Alarm) appendBatch(ctx context.Context, msg *AlarmMessage) {
+	alarmRateLimitedCounter.WithLabelValues(msg.Platform).Inc()
+	if err := alarm.messageRepo.AppendBatchMessage(ctx, msg); err != nil {
+		log.Context(ctx).Errorf("Append alarm message %s to batch error: %v", msg.Card.TraceId, err)
+		alarm.degrade(ctx, err)
+		alarm.fallback.Enqueue(msg)
+	}
+}
+
+// batchFlusher 定期检查各platform的合并队列，获取到令牌后合并发送，限流配置热更新后按新配置发送
+func (alarm *Alarm) batchFlusher() {
+	defer alarm.wg.Done()
+
+	log.Context(alarm.ctx).Info("Alarm batch flusher started")
+
+	ticker := time.NewTicker(alarmBatchFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-alarm.ctx.Done():
+			log.Context(alarm.ctx).Info("Alarm batch flusher stopped")
+			return
+		case <-ticker.C:
+			if alarm.fallback.IsDegraded() {
+				continue
+			}
+			// 未配置限流的platform在Retry-After期间也会积压消息
+			platforms := alarm.platforms.Load()
+			for platform := range platforms.senders {
+				alarm.flushBatch(platform, platforms.config.RateLimits[platform])
+			}
+		}
+	}
+}
+
+// flushBatch limit为nil表示platform未配置限流
+func (alarm *Alarm) flushBatch(platform string, limit *conf.Alarm_RateLimit) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	length, err := alarm.messageRepo.BatchLength(ctx, platform)
+	if err != nil || length == 0 {
+		return
+	}
+	allowed, _, err := alarm.messageRepo.AcquireSendToken(ctx, platform, limit.GetRate(), alarmRateLimitBurst(limit))
+	if err != nil || !allowed {
+		return
+	}
+	messages, err := alarm.messageRepo.PopBatchMessages(ctx, platform, alarmRateLimitMaxBatch(limit))
+	if err != nil {
+		log.Context(ctx).Errorf("Pop alarm batch messages error: %v", err)
+		return
+	}
+	if len(messages) == 0 {
+		return
+	}
+	alarm.deliverMessage(ctx, mergeAlarmMessages(platform, messages))
+}
+
+// mergeAlarmMessages 将多条告警合并为一条，合并消息再次合并时展开为原始告警
+func mergeAlarmMessages(platform string, messages []*AlarmMessage) *AlarmMessage {
+	if len(messages) == 1 {
+		return messages[0]
+	}
+	merged := &AlarmMessage{Platform: platform}
+	for _, msg := range messages {
+		if len(msg.Batch) > 0 {
+			merged.Batch = append(merged.Batch, msg.Batch...)
+		} else {
+			merged.Batch = append(merged.Batch, msg.Card)
+		}
+		merged.MaxRetry = max(merged.MaxRetry, msg.MaxRetry)
+	}
+	merged.Card = newBatchAlarmCard(merged.Batch)
+	return merged
+}
+
+// newBatchAlarmCard 合并卡片只保留每条告警的标题、内容和trace id，不带调用栈
+func newBatchAlarmCard(cards []*AlarmCard) *AlarmCard {
+	first := cards[0]
+	lines := make([]string, 0, len(cards))
+	for i, card := range cards {
+		info := card.Info
+		if runes := []rune(info); len(runes) > alarmBatchInfoMaxLen {
+			info = string(runes[:alarmBatchInfoMaxLen]) + "..."
+		}
+		line := fmt.Sprintf("%d. %s\n%s", i+1, card.Title, info)
+		if card.TraceId != "" {
+			line += fmt.Sprintf(" (trace_id: %s)", card.TraceId)
+		}
+		lines = append(lines, line)
+	}
+	return &AlarmCard{
+		Title:   fmt.Sprintf("%s (+%d alarms batched by rate limit)", first.Title, len(cards)-1),
+		Info:    strings.Join(lines, "\n"),
+		Service: first.Service,
+		Env:     first.Env,
+		Host:    first.Host,
+		Version: first.Version,
+		TraceId: first.TraceId,
+	}
+}
@@ -0,0 +1,251 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/data/dao"
+	"github.com/seanbit/kratos/template/internal/data/model"
+	"github.com/seanbit/kratos/template/internal/global"
+	"github.com/seanbit/kratos/template/internal/infra"
+	"gorm.io/gorm"
+)
+
+const (
+	// alarmSilenceCacheDuration 生效中的静默规则在进程内的缓存时长
+	alarmSilenceCacheDuration = 30 * time.Second
+	// alarmSilenceListLimit 查询包含已过期的静默规则时的返回上限
+	alarmSilenceListLimit = 200
+)
+
+type alarmSilenceRepo struct {
+	dbProvider  infra.PostgresProvider
+	rdbProvider infra.RedisProvider
+	log         *log.Helper
+
+	mu       sync.RWMutex
+	matchers []*biz.AlarmSilenceMatcher
+	loadedAt time.Time
+}
+
+func NewAlarmSilenceRepo(dbProvider infra.PostgresProvider, rdbProvider infra.RedisProvider, logger log.Logger) biz.IAlarmSilenceRepo {
+	return &alarmSilenceRepo{dbProvider: dbProvider, rdbProvider: rdbProvider, log: log.NewHelper(logger)}
+}
+
+func (repo *alarmSilenceRepo) CreateSilence(ctx context.Context, silence *biz.AlarmSilence) error {
+	labels, err := json.Marshal(silence.Labels)
+	if err != nil {
+		return errors.Wrap(err, "data: marshal alarm silence labels")
+	}
+	record := &model.AlarmSilence{
+		TitlePattern: silence.TitlePattern,
+		InfoPattern:  silence.InfoPattern,
+		Labels:       string(labels),
+		Creator:      silence.Creator,
+		Comment:      silence.Comment,
+		StartsAt:     silence.StartsAt,
+		EndsAt:       silence.EndsAt,
+	}
+	if err = dao.Use(repo.dbProvider.GetDB()).AlarmSilence.WithContext(ctx).Create(record); err != nil {
+		return errors.Wrap(err, "data: create alarm silence")
+	}
+	silence.ID = record.ID
+	silence.CreatedAt = record.CreatedAt
+	repo.invalidate()
+	return nil
+}
+
+func (repo *alarmSilenceRepo) ListSilences(ctx context.Context, includeExpired bool) ([]*biz.AlarmSilence, error) {
+	q := dao.Use(repo.dbProvider.GetDB()).AlarmSilence
+	do := q.WithContext(ctx)
+	if includeExpired {
+		do = do.Limit(alarmSilenceListLimit)
+	} else {
+		do = do.Where(q.EndsAt.Gt(time.Now()))
+	}
+	records, err := do.Order(q.ID.Desc()).Find()
+	if err != nil {
+		return nil, errors.Wrap(err, "data: list alarm silences")
+	}
+	silences := make([]*biz.AlarmSilence, 0, len(records))
+	for _, record := range records {
+		silences = append(silences, repo.toBiz(record))
+	}
+	return silences, nil
+}
+
+func (repo *alarmSilenceRepo) ExpireSilence(ctx context.Context, id int64) error {
+	q := dao.Use(repo.dbProvider.GetDB()).AlarmSilence
+	record, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Take()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return biz.ErrAlarmSilenceNotFound
+		}
+		return errors.Wrap(err, "data: get alarm silence")
+	}
+	now := time.Now()
+	if !record.EndsAt.After(now) {
+		return nil
+	}
+	if _, err = q.WithContext(ctx).Where(q.ID.Eq(id)).
+		UpdateSimple(q.EndsAt.Value(now), q.UpdatedAt.Value(now)); err != nil {
+		return errors.Wrap(err, "data: expire alarm silence")
+	}
+	repo.invalidate()
+	return nil
+}
+
+func (repo *alarmSilenceRepo) MatchSilence(ctx context.Context, title, info string, labels map[string]string) (*biz.AlarmSilence, error) {
+	matchers, err := repo.activeMatchers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, matcher := range matchers {
+		if matcher.Silence.IsActive(now) && matcher.Matches(title, info, labels) {
+			return matcher.Silence, nil
+		}
+	}
+	return nil, nil
+}
+
+// activeMatchers 未结束的静默规则，按 alarmSilenceCacheDuration 定期从数据库刷新
+func (repo *alarmSilenceRepo) activeMatchers(ctx context.Context) ([]*biz.AlarmSilenceMatcher, error) {
+	repo.mu.RLock()
+	if time.Since(repo.loadedAt) < alarmSilenceCacheDuration {
+		defer repo.mu.RUnlock()
+		return repo.matchers, nil
+	}
+	repo.mu.RUnlock()
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if time.Since(repo.loadedAt) < alarmSilenceCacheDuration {
+		return repo.matchers, nil
+	}
+	silences, err := repo.ListSilences(ctx, false)
+	if err != nil {
+		// 数据库不可用时继续使用旧的规则
+		return repo.matchers, err
+	}
+	matchers := make([]*biz.AlarmSilenceMatcher, 0, len(silences))
+	for _, silence := range silences {
+		matcher, err := biz.NewAlarmSilenceMatcher(silence)
+		if err != nil {
+			repo.log.Warnf("invalid alarm silence %d: %v", silence.ID, err)
+			continue
+		}
+		matchers = append(matchers, matcher)
+	}
+	repo.matchers = matchers
+	repo.loadedAt = time.Now()
+	return repo.matchers, nil
+}
+
+func (repo *alarmSilenceRepo) invalidate() {
+	repo.mu.Lock()
+	repo.loadedAt = time.Time{}
+	repo.mu.Unlock()
+}
+
+func (repo *alarmSilenceRepo) toBiz(record *model.AlarmSilence) *biz.AlarmSilence {
+	silence := &biz.AlarmSilence{
+		ID:           record.ID,
+		TitlePattern: record.TitlePattern,
+		InfoPattern:  record.InfoPattern,
+		Creator:      record.Creator,
+		Comment:      record.Comment,
+		StartsAt:     record.StartsAt,
+		EndsAt:       record.EndsAt,
+		CreatedAt:    record.CreatedAt,
+	}
+	if record.Labels != "" {
+		if err := json.Unmarshal([]byte(record.Labels), &silence.Labels); err != nil {
+			repo.log.Warnf("invalid alarm silence %d labels: %s", record.ID, record.Labels)
+		}
+	}
+	return silence
+}
+
+func (repo *alarmSilenceRepo) ackKey(fingerprint string) string {
+	return fmt.Sprintf("%s:alarm:ack:%s", global.GetServiceName(), fingerprint)
+}
+
+func (repo *alarmSilenceRepo) AckAlarm(ctx context.Context, ack *biz.AlarmAck, resolveTimeout time.Duration) error {
+	data, err := json.Marshal(ack)
+	if err != nil {
+		return errors.Wrap(err, "data: marshal alarm ack")
+	}
+	if err = repo.rdbProvider.GetRedis().Set(ctx, repo.ackKey(ack.Fingerprint), data, resolveTimeout).Err(); err != nil {
+		return errors.Wrap(err, "data: ack alarm")
+	}
+	return nil
+}
+
+func (repo *alarmSilenceRepo) UnackAlarm(ctx context.Context, fingerprint string) error {
+	deleted, err := repo.rdbProvider.GetRedis().Del(ctx, repo.ackKey(fingerprint)).Result()
+	if err != nil {
+		return errors.Wrap(err, "data: unack alarm")
+	}
+	if deleted == 0 {
+		return biz.ErrAlarmAckNotFound
+	}
+	return nil
+}
+
+func (repo *alarmSilenceRepo) ListAcks(ctx context.Context) ([]*biz.AlarmAck, error) {
+	rdb := repo.rdbProvider.GetRedis()
+	prefix := repo.ackKey("")
+	var keys []string
+	iter := rdb.Scan(ctx, 0, prefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, errors.Wrap(err, "data: scan alarm acks")
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	pipe := rdb.Pipeline()
+	getCmds := make([]*redis.StringCmd, len(keys))
+	ttlCmds := make([]*redis.DurationCmd, len(keys))
+	for i, key := range keys {
+		getCmds[i] = pipe.Get(ctx, key)
+		ttlCmds[i] = pipe.TTL(ctx, key)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errors.Wrap(err, "data: get alarm acks")
+	}
+
+	acks := make([]*biz.AlarmAck, 0, len(keys))
+	for i, key := range keys {
+		if getCmds[i].Err() != nil {
+			continue
+		}
+		ack := &biz.AlarmAck{}
+		if err := json.Unmarshal([]byte(getCmds[i].Val()), ack); err != nil {
+			ack.Fingerprint = strings.TrimPrefix(key, prefix)
+		}
+		ack.TTL = ttlCmds[i].Val()
+		acks = append(acks, ack)
+	}
+	return acks, nil
+}
+
+func (repo *alarmSilenceRepo) TouchAck(ctx context.Context, fingerprint string, resolveTimeout time.Duration) (bool, error) {
+	acked, err := repo.rdbProvider.GetRedis().Expire(ctx, repo.ackKey(fingerprint), resolveTimeout).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "data: touch alarm ack")
+	}
+	return acked, nil
+}
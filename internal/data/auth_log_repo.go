@@ -4,8 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/go-kratos/kratos/v2/log"
-	"github.com/hibiken/asynq"
+	"github.com/pkg/errors"
 	"github.com/seanbit/kratos/template/api/event"
 	"github.com/seanbit/kratos/template/internal/biz"
 	"github.com/seanbit/kratos/template/internal/data/dao"
@@ -16,16 +15,20 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// userLoginEventSchemaVersion event.UserLogin 的schema版本，字段语义变化时递增
+const userLoginEventSchemaVersion = 1
+
 type authLogRepo struct {
 	dbProvider  infra.PostgresProvider
 	rdbProvider infra.RedisProvider
-	asynqClient *asynq.Client
+	outboxRepo  biz.IOutboxRepo
 }
 
-func NewAuthLogRepo(dbProvider infra.PostgresProvider, rdbProvider infra.RedisProvider, asynqClient *asynq.Client) biz.IAuthLogRepo {
-	return &authLogRepo{dbProvider: dbProvider, rdbProvider: rdbProvider, asynqClient: asynqClient}
+func NewAuthLogRepo(dbProvider infra.PostgresProvider, rdbProvider infra.RedisProvider, outboxRepo biz.IOutboxRepo) biz.IAuthLogRepo {
+	return &authLogRepo{dbProvider: dbProvider, rdbProvider: rdbProvider, outboxRepo: outboxRepo}
 }
 
+// PublishUserLoginEvent 写入outbox，由OutboxRelay在事务提交后投递
 func (repo *authLogRepo) PublishUserLoginEvent(ctx context.Context, userLoginLog *biz.UserLoginLog) error {
 	message := &event.UserLogin{
 		AuthType:   userLoginLog.AuthType,
@@ -34,21 +37,30 @@ func (repo *authLogRepo) PublishUserLoginEvent(ctx context.Context, userLoginLog
 		IssueToken: userLoginLog.IssueToken,
 		Timestamp:  timestamppb.New(userLoginLog.LoginTime),
 	}
-	taskType := message.ProtoReflect().Descriptor().FullName()
 	payload, err := proto.Marshal(message)
 	if err != nil {
-		return err
-	}
-	task := asynq.NewTask(string(taskType), payload, asynq.TaskID(ksuid.New().String()))
-	taskInfo, err := repo.asynqClient.EnqueueContext(ctx, task, asynq.MaxRetry(3), asynq.Timeout(time.Second*60))
-	if err != nil {
-		return err
+		return errors.Wrap(err, "data: marshal user login event")
 	}
-	log.Context(ctx).Infof("PublishUserLoginEvent taskInfo: %v", taskInfo)
-	return nil
+	return repo.outboxRepo.Add(ctx, &biz.OutboxEvent{
+		EventId:       ksuid.New().String(),
+		Name:          string(message.ProtoReflect().Descriptor().FullName()),
+		AggregateType: biz.AggregateTypeUser,
+		AggregateId:   userLoginLog.UserId,
+		Payload:       payload,
+		Headers:       newEventHeaders(ctx, userLoginLog.UserId, userLoginEventSchemaVersion),
+	})
 }
 
+// SaveUserLoginLog ID不为0时（事件重放）更新已有记录
 func (repo *authLogRepo) SaveUserLoginLog(ctx context.Context, userLoginLog *model.UserLoginLog) error {
 	q := dao.Use(repo.dbProvider.GetDB()).UserLoginLog
-	return q.WithContext(ctx).Save(userLoginLog)
+	if userLoginLog.ID == 0 {
+		return q.WithContext(ctx).Save(userLoginLog)
+	}
+	_, err := q.WithContext(ctx).Where(q.ID.Eq(userLoginLog.ID)).UpdateSimple(
+		q.IP.Value(userLoginLog.IP),
+		q.Country.Value(userLoginLog.Country),
+		q.UpdatedTime.Value(time.Now()),
+	)
+	return err
 }
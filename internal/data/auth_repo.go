@@ -22,7 +22,7 @@ func NewAuthRepo(dbProvider infra.PostgresProvider, rdbProvider infra.RedisProvi
 }
 
 func (repo *authRepo) GetUserAuthInfo(ctx context.Context, authType, authInfo string) (*model.UserAuthInfo, error) {
-	userAuthInfoQ := dao.Use(repo.dbProvider.GetDB()).UserAuthInfo
+	userAuthInfoQ := dao.Use(dbFromContext(ctx, repo.dbProvider)).UserAuthInfo
 	userAuthInfoDo := userAuthInfoQ.WithContext(ctx)
 	record, err := userAuthInfoDo.Where(
 		userAuthInfoQ.AuthType.Eq(authType),
@@ -38,7 +38,7 @@ func (repo *authRepo) GetUserAuthInfo(ctx context.Context, authType, authInfo st
 }
 
 func (repo *authRepo) GetUserAuthInfoByAuthType(ctx context.Context, userId, authType string) (*model.UserAuthInfo, error) {
-	userAuthInfoQ := dao.Use(repo.dbProvider.GetDB()).UserAuthInfo
+	userAuthInfoQ := dao.Use(dbFromContext(ctx, repo.dbProvider)).UserAuthInfo
 	userAuthInfoDo := userAuthInfoQ.WithContext(ctx)
 	record, err := userAuthInfoDo.Where(
 		userAuthInfoQ.UserID.Eq(userId),
@@ -54,7 +54,7 @@ func (repo *authRepo) GetUserAuthInfoByAuthType(ctx context.Context, userId, aut
 }
 
 func (repo *authRepo) SetUserAuthInfo(ctx context.Context, userAuthInfo *model.UserAuthInfo) error {
-	userAuthInfoQ := dao.Use(repo.dbProvider.GetDB()).UserAuthInfo
+	userAuthInfoQ := dao.Use(dbFromContext(ctx, repo.dbProvider)).UserAuthInfo
 	userAuthInfoDo := userAuthInfoQ.WithContext(ctx)
 	return userAuthInfoDo.Clauses(clause.OnConflict{
 		Columns: []clause.Column{
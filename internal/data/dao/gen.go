@@ -17,29 +17,44 @@ import (
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:              db,
-		AlarmFilterWord: newAlarmFilterWord(db, opts...),
-		UserAuthInfo:    newUserAuthInfo(db, opts...),
-		UserLoginLog:    newUserLoginLog(db, opts...),
+		db:                  db,
+		AlarmFilterWord:     newAlarmFilterWord(db, opts...),
+		AlarmSilence:        newAlarmSilence(db, opts...),
+		EventOutbox:         newEventOutbox(db, opts...),
+		JobRun:              newJobRun(db, opts...),
+		UserAuthInfo:        newUserAuthInfo(db, opts...),
+		UserLoginLog:        newUserLoginLog(db, opts...),
+		WebhookDelivery:     newWebhookDelivery(db, opts...),
+		WebhookSubscription: newWebhookSubscription(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	AlarmFilterWord alarmFilterWord
-	UserAuthInfo    userAuthInfo
-	UserLoginLog    userLoginLog
+	AlarmFilterWord     alarmFilterWord
+	AlarmSilence        alarmSilence
+	EventOutbox         eventOutbox
+	JobRun              jobRun
+	UserAuthInfo        userAuthInfo
+	UserLoginLog        userLoginLog
+	WebhookDelivery     webhookDelivery
+	WebhookSubscription webhookSubscription
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:              db,
-		AlarmFilterWord: q.AlarmFilterWord.clone(db),
-		UserAuthInfo:    q.UserAuthInfo.clone(db),
-		UserLoginLog:    q.UserLoginLog.clone(db),
+		db:                  db,
+		AlarmFilterWord:     q.AlarmFilterWord.clone(db),
+		AlarmSilence:        q.AlarmSilence.clone(db),
+		EventOutbox:         q.EventOutbox.clone(db),
+		JobRun:              q.JobRun.clone(db),
+		UserAuthInfo:        q.UserAuthInfo.clone(db),
+		UserLoginLog:        q.UserLoginLog.clone(db),
+		WebhookDelivery:     q.WebhookDelivery.clone(db),
+		WebhookSubscription: q.WebhookSubscription.clone(db),
 	}
 }
 
@@ -53,24 +68,39 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:              db,
-		AlarmFilterWord: q.AlarmFilterWord.replaceDB(db),
-		UserAuthInfo:    q.UserAuthInfo.replaceDB(db),
-		UserLoginLog:    q.UserLoginLog.replaceDB(db),
+		db:                  db,
+		AlarmFilterWord:     q.AlarmFilterWord.replaceDB(db),
+		AlarmSilence:        q.AlarmSilence.replaceDB(db),
+		EventOutbox:         q.EventOutbox.replaceDB(db),
+		JobRun:              q.JobRun.replaceDB(db),
+		UserAuthInfo:        q.UserAuthInfo.replaceDB(db),
+		UserLoginLog:        q.UserLoginLog.replaceDB(db),
+		WebhookDelivery:     q.WebhookDelivery.replaceDB(db),
+		WebhookSubscription: q.WebhookSubscription.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	AlarmFilterWord IAlarmFilterWordDo
-	UserAuthInfo    IUserAuthInfoDo
-	UserLoginLog    IUserLoginLogDo
+	AlarmFilterWord     IAlarmFilterWordDo
+	AlarmSilence        IAlarmSilenceDo
+	EventOutbox         IEventOutboxDo
+	JobRun              IJobRunDo
+	UserAuthInfo        IUserAuthInfoDo
+	UserLoginLog        IUserLoginLogDo
+	WebhookDelivery     IWebhookDeliveryDo
+	WebhookSubscription IWebhookSubscriptionDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		AlarmFilterWord: q.AlarmFilterWord.WithContext(ctx),
-		UserAuthInfo:    q.UserAuthInfo.WithContext(ctx),
-		UserLoginLog:    q.UserLoginLog.WithContext(ctx),
+		AlarmFilterWord:     q.AlarmFilterWord.WithContext(ctx),
+		AlarmSilence:        q.AlarmSilence.WithContext(ctx),
+		EventOutbox:         q.EventOutbox.WithContext(ctx),
+		JobRun:              q.JobRun.WithContext(ctx),
+		UserAuthInfo:        q.UserAuthInfo.WithContext(ctx),
+		UserLoginLog:        q.UserLoginLog.WithContext(ctx),
+		WebhookDelivery:     q.WebhookDelivery.WithContext(ctx),
+		WebhookSubscription: q.WebhookSubscription.WithContext(ctx),
 	}
 }
 
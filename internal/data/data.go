@@ -6,8 +6,24 @@ import (
 
 // ProviderSet is data providers.
 var ProviderSet = wire.NewSet(
-	NewAlarmMessageRepo, NewAlarm,
+	NewAlarmMessageRepo, NewAlarm, NewAlarmInspectRepo, NewAlarmSilenceRepo,
 	NewAuthRepo, NewAuthLogRepo,
+	NewTransaction, NewOutboxRepo, NewProcessedEventRepo,
+	NewEventBus, NewEventPublisher, NewEventScheduler,
+	NewTaskInspectRepo, NewEventReplayRepo,
+	NewWebhookRepo, NewWebhookSender,
+	NewJobLockRepo, NewJobRunRepo, NewJobControlRepo,
 	NewGeoIP,
 	NewHealthRepo,
 )
+
+// errorMaxLen 错误信息列（last_error、error）保存的最大长度
+const errorMaxLen = 1024
+
+// truncateError 截断保存到数据库的错误信息
+func truncateError(message string) string {
+	if len(message) > errorMaxLen {
+		return message[:errorMaxLen]
+	}
+	return message
+}
@@ -0,0 +1,37 @@
+package data
+
+import (
+	"context"
+
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/global"
+	"github.com/seanbit/kratos/webkit"
+	"github.com/segmentio/ksuid"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newEventHeaders 从ctx生成事件头：当前span的trace context、登录用户、本服务名
+// 关联ID依次沿用正在处理的事件、当前trace ID，都没有时新生成
+func newEventHeaders(ctx context.Context, userId string, schemaVersion int32) *biz.EventHeaders {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	headers := &biz.EventHeaders{
+		Traceparent:   carrier.Get("traceparent"),
+		Tracestate:    carrier.Get("tracestate"),
+		UserId:        userId,
+		Source:        global.GetServiceName(),
+		SchemaVersion: schemaVersion,
+	}
+	if ctxUserId, ok := webkit.UserIdFromContext(ctx); ok && headers.UserId == "" {
+		headers.UserId = ctxUserId
+	}
+	if current := biz.EventHeadersFromContext(ctx); current != nil && current.CorrelationId != "" {
+		headers.CorrelationId = current.CorrelationId
+	} else if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.HasTraceID() {
+		headers.CorrelationId = spanCtx.TraceID().String()
+	} else {
+		headers.CorrelationId = ksuid.New().String()
+	}
+	return headers
+}
@@ -0,0 +1,110 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/infra"
+	"github.com/segmentio/ksuid"
+)
+
+const (
+	// asynqScheduledTaskPrefix 定时事件的TaskID前缀，TaskID为 <前缀><调度key>
+	asynqScheduledTaskPrefix = "scheduled:"
+)
+
+// NewEventScheduler asynq总线使用asynq的ProcessAt，其他总线使用Redis延迟队列
+func NewEventScheduler(config *conf.Server, bus biz.IEventBus, asynqClient *asynq.Client, rdbProvider infra.RedisProvider, logger log.Logger) (biz.IEventScheduler, func(), error) {
+	logger = log.With(logger, "module", "event-scheduler")
+	if bus.Driver() == biz.EventBusDriverAsynq {
+		return newAsynqEventScheduler(config, asynqClient, logger)
+	}
+	scheduler := newRedisEventScheduler(config.GetEventBus(), bus, rdbProvider, logger)
+	return scheduler, scheduler.stop, nil
+}
+
+// prepareScheduledEvent 未指定事件ID时生成，时间戳为计划发布时间
+func prepareScheduledEvent(e *biz.Event, at time.Time) *biz.Event {
+	scheduled := *e
+	if scheduled.Id == "" {
+		scheduled.Id = ksuid.New().String()
+	}
+	if scheduled.Timestamp.IsZero() {
+		scheduled.Timestamp = at
+	}
+	return &scheduled
+}
+
+// asynqEventScheduler 以调度key作为TaskID，asynq保证同一TaskID只入队一次
+type asynqEventScheduler struct {
+	maxRetry    int
+	asynqClient *asynq.Client
+	inspector   *asynq.Inspector
+	log         *log.Helper
+}
+
+func newAsynqEventScheduler(config *conf.Server, asynqClient *asynq.Client, logger log.Logger) (*asynqEventScheduler, func(), error) {
+	redisConnOpts, err := asynq.ParseRedisURI(config.GetAsynq().GetRedisUri())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "data: parse asynq redis uri")
+	}
+	scheduler := &asynqEventScheduler{
+		maxRetry:    eventBusMaxRetry(config.GetEventBus()),
+		asynqClient: asynqClient,
+		inspector:   asynq.NewInspector(redisConnOpts),
+		log:         log.NewHelper(logger),
+	}
+	cleanup := func() {
+		if err := scheduler.inspector.Close(); err != nil {
+			scheduler.log.Errorf("close asynq inspector: %v", err)
+		}
+	}
+	return scheduler, cleanup, nil
+}
+
+func (s *asynqEventScheduler) ScheduleAt(ctx context.Context, key string, e *biz.Event, at time.Time) (bool, error) {
+	e = prepareScheduledEvent(e, at)
+	payload, err := marshalEventEnvelope(e)
+	if err != nil {
+		return false, err
+	}
+	task := asynq.NewTask(e.Name, payload, asynq.TaskID(asynqScheduledTaskPrefix+key))
+	if _, err = s.asynqClient.EnqueueContext(ctx, task, asynq.ProcessAt(at),
+		asynq.MaxRetry(s.maxRetry), asynq.Timeout(eventHandleTimeout)); err != nil {
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			log.Context(ctx).Infof("Event %s already scheduled with key %s", e.Name, key)
+			return false, nil
+		}
+		return false, errors.Wrap(err, "data: schedule event")
+	}
+	log.Context(ctx).Infof("Schedule event %s(%s) with key %s at %s", e.Name, e.Id, key, at.Format(time.RFC3339))
+	return true, nil
+}
+
+func (s *asynqEventScheduler) ScheduleIn(ctx context.Context, key string, e *biz.Event, delay time.Duration) (bool, error) {
+	return s.ScheduleAt(ctx, key, e, time.Now().Add(delay))
+}
+
+// Cancel 任务所在的队列取决于入队时的配置，依次在各队列中查找
+func (s *asynqEventScheduler) Cancel(ctx context.Context, key string) (bool, error) {
+	queues, err := s.inspector.Queues()
+	if err != nil {
+		return false, errors.Wrap(err, "data: list asynq queues")
+	}
+	for _, queue := range queues {
+		if err = s.inspector.DeleteTask(queue, asynqScheduledTaskPrefix+key); err != nil {
+			if errors.Is(err, asynq.ErrTaskNotFound) || errors.Is(err, asynq.ErrQueueNotFound) {
+				continue
+			}
+			return false, errors.Wrap(err, "data: cancel scheduled event")
+		}
+		log.Context(ctx).Infof("Cancel scheduled event with key %s in queue %s", key, queue)
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,191 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/global"
+	"github.com/seanbit/kratos/template/internal/infra"
+)
+
+const (
+	defaultEventSchedulePollInterval = time.Second
+	// eventScheduleBatchSize 每次取出的到期事件数
+	eventScheduleBatchSize = 100
+	// eventScheduleLease 取出后未确认发布的事件在lease后重新到期，避免实例退出丢失事件
+	eventScheduleLease = 30 * time.Second
+	// eventScheduleRetryDelay 发布失败后的重试间隔
+	eventScheduleRetryDelay = 5 * time.Second
+)
+
+// eventScheduleAddScript 调度key不存在时保存事件并加入到期队列，返回1；已存在返回0
+// KEYS[1] 到期队列（zset），KEYS[2] 事件数据（hash），ARGV[1] 调度key，ARGV[2] 到期时间（unix毫秒），ARGV[3] 事件信封
+var eventScheduleAddScript = redis.NewScript(`
+if redis.call('HEXISTS', KEYS[2], ARGV[1]) == 1 then
+	return 0
+end
+redis.call('HSET', KEYS[2], ARGV[1], ARGV[3])
+redis.call('ZADD', KEYS[1], ARGV[2], ARGV[1])
+return 1
+`)
+
+// eventScheduleClaimScript 取出到期的事件并将其到期时间推迟lease，返回 [key1, data1, key2, data2 ...]
+// KEYS[1] 到期队列，KEYS[2] 事件数据，ARGV[1] 当前时间（unix毫秒），ARGV[2] 数量，ARGV[3] lease到期时间（unix毫秒）
+var eventScheduleClaimScript = redis.NewScript(`
+local due = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, tonumber(ARGV[2]))
+local out = {}
+for _, key in ipairs(due) do
+	local data = redis.call('HGET', KEYS[2], key)
+	if data then
+		redis.call('ZADD', KEYS[1], ARGV[3], key)
+		table.insert(out, key)
+		table.insert(out, data)
+	else
+		redis.call('ZREM', KEYS[1], key)
+	end
+end
+return out
+`)
+
+// eventScheduleRemoveScript 删除调度，返回是否存在
+// KEYS[1] 到期队列，KEYS[2] 事件数据，ARGV[1] 调度key
+var eventScheduleRemoveScript = redis.NewScript(`
+redis.call('ZREM', KEYS[1], ARGV[1])
+return redis.call('HDEL', KEYS[2], ARGV[1])
+`)
+
+// redisEventScheduler 基于Redis延迟队列：各实例扫描到期事件并发布到事件总线，至少发布一次，重复发布由总线或消费方按事件ID去重
+type redisEventScheduler struct {
+	bus          biz.IEventPublisher
+	rdbProvider  infra.RedisProvider
+	pollInterval time.Duration
+	log          *log.Helper
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+}
+
+func newRedisEventScheduler(config *conf.Server_EventBus, bus biz.IEventPublisher, rdbProvider infra.RedisProvider, logger log.Logger) *redisEventScheduler {
+	pollInterval := config.GetSchedulePollInterval().AsDuration()
+	if pollInterval <= 0 {
+		pollInterval = defaultEventSchedulePollInterval
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	scheduler := &redisEventScheduler{
+		bus:          bus,
+		rdbProvider:  rdbProvider,
+		pollInterval: pollInterval,
+		log:          log.NewHelper(logger),
+		cancel:       cancel,
+	}
+	scheduler.wg.Add(1)
+	go scheduler.poll(ctx)
+	return scheduler
+}
+
+func (s *redisEventScheduler) stop() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// keys 到期队列和事件数据使用相同的hash tag，集群模式下位于同一slot
+func (s *redisEventScheduler) keys() []string {
+	prefix := fmt.Sprintf("{%s:event:scheduled}", global.GetServiceName())
+	return []string{prefix + ":due", prefix + ":data"}
+}
+
+func (s *redisEventScheduler) ScheduleAt(ctx context.Context, key string, e *biz.Event, at time.Time) (bool, error) {
+	e = prepareScheduledEvent(e, at)
+	data, err := marshalEventEnvelope(e)
+	if err != nil {
+		return false, err
+	}
+	added, err := eventScheduleAddScript.Run(ctx, s.rdbProvider.GetRedis(), s.keys(), key, at.UnixMilli(), data).Int()
+	if err != nil {
+		return false, errors.Wrap(err, "data: schedule event")
+	}
+	if added == 0 {
+		log.Context(ctx).Infof("Event %s already scheduled with key %s", e.Name, key)
+		return false, nil
+	}
+	log.Context(ctx).Infof("Schedule event %s(%s) with key %s at %s", e.Name, e.Id, key, at.Format(time.RFC3339))
+	return true, nil
+}
+
+func (s *redisEventScheduler) ScheduleIn(ctx context.Context, key string, e *biz.Event, delay time.Duration) (bool, error) {
+	return s.ScheduleAt(ctx, key, e, time.Now().Add(delay))
+}
+
+func (s *redisEventScheduler) Cancel(ctx context.Context, key string) (bool, error) {
+	removed, err := eventScheduleRemoveScript.Run(ctx, s.rdbProvider.GetRedis(), s.keys(), key).Int()
+	if err != nil {
+		return false, errors.Wrap(err, "data: cancel scheduled event")
+	}
+	if removed > 0 {
+		log.Context(ctx).Infof("Cancel scheduled event with key %s", key)
+	}
+	return removed > 0, nil
+}
+
+func (s *redisEventScheduler) poll(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// 取满一批时继续取，直到没有到期事件
+			for ctx.Err() == nil {
+				n, err := s.publishDue(ctx)
+				if err != nil {
+					s.log.Errorf("publish scheduled events: %v", err)
+				}
+				if n < eventScheduleBatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// publishDue 发布到期的事件，返回取出的事件数
+func (s *redisEventScheduler) publishDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	result, err := eventScheduleClaimScript.Run(ctx, s.rdbProvider.GetRedis(), s.keys(),
+		now.UnixMilli(), eventScheduleBatchSize, now.Add(eventScheduleLease).UnixMilli()).StringSlice()
+	if err != nil {
+		return 0, errors.Wrap(err, "data: claim scheduled events")
+	}
+	rdb := s.rdbProvider.GetRedis()
+	keys := s.keys()
+	for i := 0; i+1 < len(result); i += 2 {
+		key := result[i]
+		e, err := unmarshalEventEnvelope([]byte(result[i+1]))
+		if err != nil {
+			// 无法解码的事件重试无意义，直接丢弃
+			s.log.Errorf("drop scheduled event %s: %v", key, err)
+			_ = eventScheduleRemoveScript.Run(ctx, rdb, keys, key).Err()
+			continue
+		}
+		if err = s.bus.Publish(ctx, e); err != nil {
+			s.log.Warnf("publish scheduled event %s(%s) with key %s: %v", e.Name, e.Id, key, err)
+			_ = rdb.ZAdd(ctx, keys[0], redis.Z{Score: float64(now.Add(eventScheduleRetryDelay).UnixMilli()), Member: key}).Err()
+			continue
+		}
+		if err = eventScheduleRemoveScript.Run(ctx, rdb, keys, key).Err(); err != nil {
+			// lease到期后会重新发布，由总线或消费方按事件ID去重
+			s.log.Warnf("remove published scheduled event %s: %v", key, err)
+		}
+	}
+	return len(result) / 2, nil
+}
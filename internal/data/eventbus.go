@@ -0,0 +1,91 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/api/event"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultEventBusMaxRetry = 3
+	// eventHandleTimeout 单个事件的处理超时
+	eventHandleTimeout = 60 * time.Second
+	// eventMaxRetryDelay 处理失败后重新投递的最大间隔
+	eventMaxRetryDelay = time.Minute
+)
+
+// NewEventBus 按 conf.Server.EventBus.Driver 创建事件总线，默认asynq
+func NewEventBus(config *conf.Server, asynqClient *asynq.Client, logger log.Logger) (biz.IEventBus, func(), error) {
+	busConfig := config.GetEventBus()
+	logger = log.With(logger, "module", "eventbus")
+	var (
+		bus     biz.IEventBus
+		cleanup func()
+		err     error
+	)
+	switch busConfig.GetDriver() {
+	case "", biz.EventBusDriverAsynq:
+		bus, err = newAsynqEventBus(config, asynqClient, logger)
+		cleanup = func() {}
+	case biz.EventBusDriverNats:
+		bus, cleanup, err = newNatsEventBus(busConfig, logger)
+	case biz.EventBusDriverKafka:
+		bus, cleanup, err = newKafkaEventBus(busConfig, logger)
+	default:
+		err = fmt.Errorf("unsupported event bus driver: %s", busConfig.GetDriver())
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	log.NewHelper(logger).Infof("event bus driver: %s", bus.Driver())
+	return bus, cleanup, nil
+}
+
+// NewEventPublisher 事件的发布方只依赖 biz.IEventPublisher
+func NewEventPublisher(bus biz.IEventBus) biz.IEventPublisher {
+	return bus
+}
+
+func eventBusMaxRetry(config *conf.Server_EventBus) int {
+	if maxRetry := config.GetMaxRetry(); maxRetry > 0 {
+		return int(maxRetry)
+	}
+	return defaultEventBusMaxRetry
+}
+
+// eventRetryDelay 第attempt次处理失败后的重试间隔：1s, 2s, 4s ... 最大1分钟
+func eventRetryDelay(attempt int) time.Duration {
+	return min(time.Duration(1<<min(max(attempt-1, 0), 16))*time.Second, eventMaxRetryDelay)
+}
+
+// marshalEventEnvelope 事件在总线上以 event.Event 信封传递
+func marshalEventEnvelope(e *biz.Event) ([]byte, error) {
+	data, err := proto.Marshal(biz.NewEventEnvelope(e))
+	if err != nil {
+		return nil, errors.Wrap(err, "data: marshal event envelope")
+	}
+	return data, nil
+}
+
+func unmarshalEventEnvelope(data []byte) (*biz.Event, error) {
+	envelope := &event.Event{}
+	if err := proto.Unmarshal(data, envelope); err != nil {
+		return nil, errors.Wrapf(biz.ErrInvalidEventPayload, "unmarshal event envelope: %v", err)
+	}
+	return biz.FromEventEnvelope(envelope), nil
+}
+
+// handleEvent 以独立的超时调用订阅者的处理函数
+func handleEvent(ctx context.Context, handler biz.EventHandler, e *biz.Event) error {
+	ctx, cancel := context.WithTimeout(ctx, eventHandleTimeout)
+	defer cancel()
+	return handler(ctx, e)
+}
@@ -0,0 +1,116 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/api/event"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+	asynqtransport "github.com/seanbit/kratos/webkit/transport/asynq"
+	"google.golang.org/protobuf/proto"
+)
+
+// asynqEventBus 基于asynq任务队列：每个事件只会被一个订阅组处理，不保证同一Key的顺序
+type asynqEventBus struct {
+	config      *conf.Server_ASYNQ
+	maxRetry    int
+	asynqClient *asynq.Client
+	log         *log.Helper
+	subscribed  atomic.Bool
+}
+
+func newAsynqEventBus(config *conf.Server, asynqClient *asynq.Client, logger log.Logger) (*asynqEventBus, error) {
+	if config.GetAsynq().GetRedisUri() == "" {
+		return nil, errors.New("asynq redis_uri is required by asynq event bus")
+	}
+	return &asynqEventBus{
+		config:      config.GetAsynq(),
+		maxRetry:    eventBusMaxRetry(config.GetEventBus()),
+		asynqClient: asynqClient,
+		log:         log.NewHelper(logger),
+	}, nil
+}
+
+func (b *asynqEventBus) Driver() string {
+	return biz.EventBusDriverAsynq
+}
+
+// Publish 以完整的事件信封作为task payload、事件ID作为TaskID入队，重复发布时asynq返回冲突，视为已发布
+func (b *asynqEventBus) Publish(ctx context.Context, e *biz.Event) error {
+	payload, err := marshalEventEnvelope(e)
+	if err != nil {
+		return err
+	}
+	task := asynq.NewTask(e.Name, payload, asynq.TaskID(e.Id))
+	taskInfo, err := b.asynqClient.EnqueueContext(ctx, task, asynq.MaxRetry(b.maxRetry), asynq.Timeout(eventHandleTimeout))
+	if err != nil {
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			log.Context(ctx).Infof("Event %s(%s) already enqueued", e.Name, e.Id)
+			return nil
+		}
+		return errors.Wrap(err, "data: enqueue event")
+	}
+	log.Context(ctx).Infof("Publish event taskInfo: %v", taskInfo)
+	return nil
+}
+
+// Subscribe asynq没有订阅组的概念，只支持一个订阅者，处理所有入队的事件
+func (b *asynqEventBus) Subscribe(ctx context.Context, group string, names []string, handler biz.EventHandler) error {
+	if !b.subscribed.CompareAndSwap(false, true) {
+		return errors.New("asynq event bus supports a single subscriber group")
+	}
+	redisConnOpts, err := asynq.ParseRedisURI(b.config.GetRedisUri())
+	if err != nil {
+		return err
+	}
+	serverConfig := asynq.Config{
+		Concurrency: int(b.config.GetConcurrency()),
+		Logger:      asynqtransport.NewAsynqLogger(b.log),
+		RetryDelayFunc: func(n int, err error, task *asynq.Task) time.Duration {
+			return eventRetryDelay(n + 1)
+		},
+	}
+	if len(b.config.GetQueues()) > 0 {
+		serverConfig.Queues = make(map[string]int, len(b.config.GetQueues()))
+		for name, priority := range b.config.GetQueues() {
+			serverConfig.Queues[name] = int(priority)
+		}
+	}
+	server := asynq.NewServer(redisConnOpts, serverConfig)
+	if err = server.Start(asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		err := handler(ctx, decodeTaskEvent(task))
+		// 未注册的事件和无法解码的事件重试无意义，直接归档，注册处理器后可在归档中重新执行
+		if biz.IsPermanentEventError(err) {
+			return fmt.Errorf("%w: %w", err, asynq.SkipRetry)
+		}
+		return err
+	})); err != nil {
+		return err
+	}
+	b.log.Infof("asynq event subscriber %s started", group)
+	<-ctx.Done()
+	server.Shutdown()
+	return nil
+}
+
+// decodeTaskEvent task payload为事件信封；升级前入队的task payload为事件本身，按旧格式处理
+func decodeTaskEvent(task *asynq.Task) *biz.Event {
+	envelope := &event.Event{}
+	if err := proto.Unmarshal(task.Payload(), envelope); err != nil || envelope.Name != task.Type() {
+		envelope = &event.Event{Name: task.Type(), Payload: task.Payload()}
+	}
+	e := biz.FromEventEnvelope(envelope)
+	if rw := task.ResultWriter(); e.Id == "" && rw != nil {
+		e.Id = rw.TaskID()
+	}
+	if e.Timestamp.IsZero() {
+		e.Timestamp = time.Now()
+	}
+	return e
+}
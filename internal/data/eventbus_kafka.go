@@ -0,0 +1,208 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/global"
+)
+
+const (
+	defaultKafkaTopicPrefix = "events"
+	defaultKafkaVersion     = "2.8.0"
+	// kafkaHeaderEventId 消息头中的事件ID，便于不解码信封排查
+	kafkaHeaderEventId = "event-id"
+	// kafkaRejoinInterval 消费组会话异常结束后重新加入的间隔
+	kafkaRejoinInterval = 5 * time.Second
+)
+
+// kafkaEventBus 基于Kafka：每个订阅组是一个consumer group，以事件Key作为分区键保证同一Key的顺序
+type kafkaEventBus struct {
+	config       *conf.Server_EventBus_Kafka
+	maxRetry     int
+	saramaConfig *sarama.Config
+	producer     sarama.SyncProducer
+	log          *log.Helper
+}
+
+func newKafkaEventBus(config *conf.Server_EventBus, logger log.Logger) (*kafkaEventBus, func(), error) {
+	kafkaConfig := config.GetKafka()
+	if len(kafkaConfig.GetBrokers()) == 0 {
+		return nil, nil, errors.New("kafka brokers are required by kafka event bus")
+	}
+	saramaConfig, err := newSaramaConfig(kafkaConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	producer, err := sarama.NewSyncProducer(kafkaConfig.GetBrokers(), saramaConfig)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "data: create kafka producer")
+	}
+	bus := &kafkaEventBus{
+		config:       kafkaConfig,
+		maxRetry:     eventBusMaxRetry(config),
+		saramaConfig: saramaConfig,
+		producer:     producer,
+		log:          log.NewHelper(logger),
+	}
+	cleanup := func() {
+		if err := producer.Close(); err != nil {
+			bus.log.Errorf("close kafka producer: %v", err)
+		}
+	}
+	return bus, cleanup, nil
+}
+
+func newSaramaConfig(config *conf.Server_EventBus_Kafka) (*sarama.Config, error) {
+	version := config.GetVersion()
+	if version == "" {
+		version = defaultKafkaVersion
+	}
+	kafkaVersion, err := sarama.ParseKafkaVersion(version)
+	if err != nil {
+		return nil, errors.Wrap(err, "data: parse kafka version")
+	}
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.ClientID = global.GetServiceName()
+	saramaConfig.Version = kafkaVersion
+	saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+	saramaConfig.Producer.Return.Successes = true
+	saramaConfig.Producer.Partitioner = sarama.NewHashPartitioner
+	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+	saramaConfig.Consumer.Return.Errors = true
+	return saramaConfig, nil
+}
+
+func (b *kafkaEventBus) topic(name string) string {
+	prefix := b.config.GetTopicPrefix()
+	if prefix == "" {
+		prefix = defaultKafkaTopicPrefix
+	}
+	return prefix + "." + name
+}
+
+func (b *kafkaEventBus) Driver() string {
+	return biz.EventBusDriverKafka
+}
+
+// Publish 以事件Key作为消息key；Kafka不按事件ID去重，重复消费由订阅方的幂等处理
+func (b *kafkaEventBus) Publish(ctx context.Context, e *biz.Event) error {
+	data, err := marshalEventEnvelope(e)
+	if err != nil {
+		return err
+	}
+	key := e.Key
+	if key == "" {
+		key = e.Id
+	}
+	partition, offset, err := b.producer.SendMessage(&sarama.ProducerMessage{
+		Topic:   b.topic(e.Name),
+		Key:     sarama.StringEncoder(key),
+		Value:   sarama.ByteEncoder(data),
+		Headers: []sarama.RecordHeader{{Key: []byte(kafkaHeaderEventId), Value: []byte(e.Id)}},
+	})
+	if err != nil {
+		return errors.Wrap(err, "data: publish event to kafka")
+	}
+	log.Context(ctx).Infof("Publish event %s(%s) to kafka partition %d offset %d", e.Name, e.Id, partition, offset)
+	return nil
+}
+
+func (b *kafkaEventBus) Subscribe(ctx context.Context, group string, names []string, handler biz.EventHandler) error {
+	if len(names) == 0 {
+		return errors.New("kafka event bus requires event names to subscribe")
+	}
+	topics := make([]string, 0, len(names))
+	for _, name := range names {
+		topics = append(topics, b.topic(name))
+	}
+	consumerGroup, err := sarama.NewConsumerGroup(b.config.GetBrokers(), group, b.saramaConfig)
+	if err != nil {
+		return errors.Wrap(err, "data: create kafka consumer group")
+	}
+	defer func() {
+		if err := consumerGroup.Close(); err != nil {
+			b.log.Errorf("close kafka consumer group %s: %v", group, err)
+		}
+	}()
+	go func() {
+		for err := range consumerGroup.Errors() {
+			b.log.Warnf("kafka consumer group %s: %v", group, err)
+		}
+	}()
+
+	b.log.Infof("kafka event subscriber %s started", group)
+	groupHandler := &kafkaGroupHandler{bus: b, handler: handler}
+	for ctx.Err() == nil {
+		// 发生rebalance时Consume返回，需要重新加入
+		if err = consumerGroup.Consume(ctx, topics, groupHandler); err != nil {
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return nil
+			}
+			b.log.Errorf("kafka consumer group %s: %v", group, err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(kafkaRejoinInterval):
+			}
+		}
+	}
+	return nil
+}
+
+type kafkaGroupHandler struct {
+	bus     *kafkaEventBus
+	handler biz.EventHandler
+}
+
+func (h *kafkaGroupHandler) Setup(sarama.ConsumerGroupSession) error   { return nil }
+func (h *kafkaGroupHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+// ConsumeClaim 按分区顺序处理：失败时原地重试，超过重试次数后记录并跳过，不阻塞后续事件
+func (h *kafkaGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ctx := session.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			if !h.handleMessage(ctx, msg) {
+				return nil
+			}
+			session.MarkMessage(msg, "")
+		}
+	}
+}
+
+// handleMessage 返回false表示会话已结束，消息未处理完，不提交offset
+func (h *kafkaGroupHandler) handleMessage(ctx context.Context, msg *sarama.ConsumerMessage) bool {
+	e, err := unmarshalEventEnvelope(msg.Value)
+	if err != nil {
+		h.bus.log.Errorf("skip event on %s/%d@%d: %v", msg.Topic, msg.Partition, msg.Offset, err)
+		return true
+	}
+	for attempt := 1; ; attempt++ {
+		err = handleEvent(ctx, h.handler, e)
+		if err == nil {
+			return true
+		}
+		if biz.IsPermanentEventError(err) || attempt > h.bus.maxRetry {
+			h.bus.log.Errorf("skip event %s(%s) on %s/%d@%d after %d attempts: %v",
+				e.Name, e.Id, msg.Topic, msg.Partition, msg.Offset, attempt, err)
+			return true
+		}
+		h.bus.log.Warnf("event %s(%s) failed (attempt %d): %v", e.Name, e.Id, attempt, err)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(eventRetryDelay(attempt)):
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/global"
+)
+
+const (
+	defaultNatsStream        = "EVENTS"
+	defaultNatsSubjectPrefix = "events"
+	defaultNatsAckWait       = 60 * time.Second
+	defaultNatsMaxAge        = 7 * 24 * time.Hour
+	// natsDuplicateWindow 按事件ID去重的时间窗口
+	natsDuplicateWindow = 10 * time.Minute
+	natsSetupTimeout    = 10 * time.Second
+)
+
+// natsEventBus 基于NATS JetStream：每个订阅组是一个durable consumer，各组独立确认
+type natsEventBus struct {
+	config   *conf.Server_EventBus_NATS
+	maxRetry int
+	conn     *nats.Conn
+	js       jetstream.JetStream
+	log      *log.Helper
+}
+
+func newNatsEventBus(config *conf.Server_EventBus, logger log.Logger) (*natsEventBus, func(), error) {
+	natsConfig := config.GetNats()
+	if natsConfig.GetUrl() == "" {
+		return nil, nil, errors.New("nats url is required by nats event bus")
+	}
+	conn, err := nats.Connect(natsConfig.GetUrl(), nats.Name(global.GetServiceName()), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "data: connect nats")
+	}
+	bus := &natsEventBus{config: natsConfig, maxRetry: eventBusMaxRetry(config), conn: conn, log: log.NewHelper(logger)}
+	if bus.js, err = jetstream.New(conn); err != nil {
+		conn.Close()
+		return nil, nil, errors.Wrap(err, "data: create jetstream context")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), natsSetupTimeout)
+	defer cancel()
+	if _, err = bus.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       bus.stream(),
+		Subjects:   []string{bus.subjectPrefix() + ".>"},
+		MaxAge:     bus.maxAge(),
+		Duplicates: natsDuplicateWindow,
+		Storage:    jetstream.FileStorage,
+	}); err != nil {
+		conn.Close()
+		return nil, nil, errors.Wrap(err, "data: create jetstream stream")
+	}
+	cleanup := func() {
+		if err := conn.Drain(); err != nil {
+			bus.log.Errorf("drain nats connection: %v", err)
+		}
+	}
+	return bus, cleanup, nil
+}
+
+func (b *natsEventBus) stream() string {
+	if stream := b.config.GetStream(); stream != "" {
+		return stream
+	}
+	return defaultNatsStream
+}
+
+func (b *natsEventBus) subjectPrefix() string {
+	if prefix := b.config.GetSubjectPrefix(); prefix != "" {
+		return prefix
+	}
+	return defaultNatsSubjectPrefix
+}
+
+func (b *natsEventBus) ackWait() time.Duration {
+	if ackWait := b.config.GetAckWait().AsDuration(); ackWait > 0 {
+		return ackWait
+	}
+	return defaultNatsAckWait
+}
+
+func (b *natsEventBus) maxAge() time.Duration {
+	if maxAge := b.config.GetMaxAge().AsDuration(); maxAge > 0 {
+		return maxAge
+	}
+	return defaultNatsMaxAge
+}
+
+func (b *natsEventBus) subject(name string) string {
+	return b.subjectPrefix() + "." + name
+}
+
+func (b *natsEventBus) Driver() string {
+	return biz.EventBusDriverNats
+}
+
+// Publish 以事件ID作为Nats-Msg-Id，去重窗口内重复发布只保存一次
+func (b *natsEventBus) Publish(ctx context.Context, e *biz.Event) error {
+	data, err := marshalEventEnvelope(e)
+	if err != nil {
+		return err
+	}
+	msg := nats.NewMsg(b.subject(e.Name))
+	msg.Data = data
+	ack, err := b.js.PublishMsg(ctx, msg, jetstream.WithMsgID(e.Id))
+	if err != nil {
+		return errors.Wrap(err, "data: publish event to jetstream")
+	}
+	if ack.Duplicate {
+		log.Context(ctx).Infof("Event %s(%s) already published", e.Name, e.Id)
+	}
+	return nil
+}
+
+func (b *natsEventBus) Subscribe(ctx context.Context, group string, names []string, handler biz.EventHandler) error {
+	consumerConfig := jetstream.ConsumerConfig{
+		Durable:       natsConsumerName(group),
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       b.ackWait(),
+		MaxDeliver:    b.maxRetry + 1,
+		DeliverPolicy: jetstream.DeliverAllPolicy,
+	}
+	for _, name := range names {
+		consumerConfig.FilterSubjects = append(consumerConfig.FilterSubjects, b.subject(name))
+	}
+	consumer, err := b.js.CreateOrUpdateConsumer(ctx, b.stream(), consumerConfig)
+	if err != nil {
+		return errors.Wrap(err, "data: create jetstream consumer")
+	}
+	consumeCtx, err := consumer.Consume(func(msg jetstream.Msg) {
+		b.handleMessage(ctx, msg, handler)
+	}, jetstream.ConsumeErrHandler(func(_ jetstream.ConsumeContext, err error) {
+		b.log.Warnf("jetstream consumer %s: %v", consumerConfig.Durable, err)
+	}))
+	if err != nil {
+		return errors.Wrap(err, "data: consume jetstream")
+	}
+	b.log.Infof("nats event subscriber %s started", consumerConfig.Durable)
+	<-ctx.Done()
+	consumeCtx.Drain()
+	<-consumeCtx.Closed()
+	return nil
+}
+
+func (b *natsEventBus) handleMessage(ctx context.Context, msg jetstream.Msg, handler biz.EventHandler) {
+	e, err := unmarshalEventEnvelope(msg.Data())
+	if err == nil {
+		err = handleEvent(ctx, handler, e)
+	}
+	if err == nil {
+		if err = msg.Ack(); err != nil {
+			b.log.Warnf("ack event on %s: %v", msg.Subject(), err)
+		}
+		return
+	}
+	if biz.IsPermanentEventError(err) {
+		b.log.Errorf("terminate event on %s: %v", msg.Subject(), err)
+		_ = msg.Term()
+		return
+	}
+	attempt := 1
+	if metadata, metaErr := msg.Metadata(); metaErr == nil {
+		attempt = int(metadata.NumDelivered)
+	}
+	if attempt > b.maxRetry {
+		// 达到MaxDeliver后服务端不再投递，事件保留在stream中
+		b.log.Errorf("event on %s failed after %d attempts: %v", msg.Subject(), attempt, err)
+	} else {
+		b.log.Warnf("event on %s failed (attempt %d): %v", msg.Subject(), attempt, err)
+	}
+	_ = msg.NakWithDelay(eventRetryDelay(attempt))
+}
+
+// natsConsumerName durable名不能包含 . * > 和空白字符
+func natsConsumerName(group string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '*', '>', ' ', '\t', '\n', '\r':
+			return '_'
+		}
+		return r
+	}, group)
+}
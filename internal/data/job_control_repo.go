@@ -0,0 +1,66 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/global"
+	"github.com/seanbit/kratos/template/internal/infra"
+)
+
+type jobControlRepo struct {
+	rdbProvider infra.RedisProvider
+}
+
+func NewJobControlRepo(rdbProvider infra.RedisProvider) biz.IJobControlRepo {
+	return &jobControlRepo{rdbProvider: rdbProvider}
+}
+
+// pausedKey 已暂停的任务（hash），field为任务名
+func (repo *jobControlRepo) pausedKey() string {
+	return fmt.Sprintf("%s:crontab:paused", global.GetServiceName())
+}
+
+func (repo *jobControlRepo) Pause(ctx context.Context, name string, pause *biz.JobPause) error {
+	data, err := json.Marshal(pause)
+	if err != nil {
+		return errors.Wrap(err, "data: marshal job pause")
+	}
+	if err = repo.rdbProvider.GetRedis().HSet(ctx, repo.pausedKey(), name, data).Err(); err != nil {
+		return errors.Wrapf(err, "data: pause job %s", name)
+	}
+	return nil
+}
+
+func (repo *jobControlRepo) Resume(ctx context.Context, name string) error {
+	if err := repo.rdbProvider.GetRedis().HDel(ctx, repo.pausedKey(), name).Err(); err != nil {
+		return errors.Wrapf(err, "data: resume job %s", name)
+	}
+	return nil
+}
+
+func (repo *jobControlRepo) IsPaused(ctx context.Context, name string) (bool, error) {
+	paused, err := repo.rdbProvider.GetRedis().HExists(ctx, repo.pausedKey(), name).Result()
+	if err != nil {
+		return false, errors.Wrapf(err, "data: check job %s paused", name)
+	}
+	return paused, nil
+}
+
+func (repo *jobControlRepo) ListPaused(ctx context.Context) (map[string]*biz.JobPause, error) {
+	values, err := repo.rdbProvider.GetRedis().HGetAll(ctx, repo.pausedKey()).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "data: list paused jobs")
+	}
+	paused := make(map[string]*biz.JobPause, len(values))
+	for name, value := range values {
+		pause := &biz.JobPause{}
+		// 内容损坏时仍视为已暂停
+		_ = json.Unmarshal([]byte(value), pause)
+		paused[name] = pause
+	}
+	return paused, nil
+}
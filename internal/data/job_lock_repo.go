@@ -0,0 +1,92 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/global"
+	"github.com/seanbit/kratos/template/internal/infra"
+)
+
+// jobLockAcquireScript 锁不存在时生成新的fencing token并加锁，返回token；已被持有时返回0
+// KEYS[1] 锁，KEYS[2] fencing token计数器，ARGV[1] ttl（毫秒）
+var jobLockAcquireScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return 0
+end
+local token = redis.call('INCR', KEYS[2])
+redis.call('SET', KEYS[1], token, 'PX', ARGV[1])
+return token
+`)
+
+// jobLockRenewScript 锁仍为token持有时续期，返回1；否则返回0
+// KEYS[1] 锁，ARGV[1] token，ARGV[2] ttl（毫秒）
+var jobLockRenewScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// jobLockReleaseScript 锁仍为token持有时删除
+// KEYS[1] 锁，ARGV[1] token
+var jobLockReleaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+type jobLockRepo struct {
+	rdbProvider infra.RedisProvider
+}
+
+func NewJobLockRepo(rdbProvider infra.RedisProvider) biz.IJobLockRepo {
+	return &jobLockRepo{rdbProvider: rdbProvider}
+}
+
+// keys 锁与计数器使用相同的hash tag，保证在同一个slot
+func (repo *jobLockRepo) keys(key string) []string {
+	prefix := fmt.Sprintf("{%s:crontab:%s}", global.GetServiceName(), key)
+	return []string{prefix + ":lock", prefix + ":fence"}
+}
+
+// claimKey 认领只有一个key，到期后不留下计数器
+func (repo *jobLockRepo) claimKey(key string) string {
+	return fmt.Sprintf("%s:crontab:%s:claim", global.GetServiceName(), key)
+}
+
+func (repo *jobLockRepo) Acquire(ctx context.Context, key string, ttl time.Duration) (int64, bool, error) {
+	token, err := jobLockAcquireScript.Run(ctx, repo.rdbProvider.GetRedis(), repo.keys(key), ttl.Milliseconds()).Int64()
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "data: acquire job lock %s", key)
+	}
+	return token, token > 0, nil
+}
+
+func (repo *jobLockRepo) Renew(ctx context.Context, key string, token int64, ttl time.Duration) (bool, error) {
+	renewed, err := jobLockRenewScript.Run(ctx, repo.rdbProvider.GetRedis(), repo.keys(key)[:1], token, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return false, errors.Wrapf(err, "data: renew job lock %s", key)
+	}
+	return renewed == 1, nil
+}
+
+func (repo *jobLockRepo) Release(ctx context.Context, key string, token int64) error {
+	if err := jobLockReleaseScript.Run(ctx, repo.rdbProvider.GetRedis(), repo.keys(key)[:1], token).Err(); err != nil {
+		return errors.Wrapf(err, "data: release job lock %s", key)
+	}
+	return nil
+}
+
+func (repo *jobLockRepo) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	ok, err := repo.rdbProvider.GetRedis().SetNX(ctx, repo.claimKey(key), 1, ttl).Result()
+	if err != nil {
+		return false, errors.Wrapf(err, "data: claim job %s", key)
+	}
+	return ok, nil
+}
@@ -0,0 +1,139 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/data/dao"
+	"github.com/seanbit/kratos/template/internal/data/model"
+	"github.com/seanbit/kratos/template/internal/infra"
+)
+
+// 任务执行状态
+const (
+	jobRunStatusRunning   int16 = 0
+	jobRunStatusSucceeded int16 = 1
+	jobRunStatusFailed    int16 = 2
+	jobRunStatusCancelled int16 = 3
+)
+
+var jobRunStatusCodes = map[string]int16{
+	biz.JobRunRunning:   jobRunStatusRunning,
+	biz.JobRunSucceeded: jobRunStatusSucceeded,
+	biz.JobRunFailed:    jobRunStatusFailed,
+	biz.JobRunCancelled: jobRunStatusCancelled,
+}
+
+type jobRunRepo struct {
+	dbProvider infra.PostgresProvider
+}
+
+func NewJobRunRepo(dbProvider infra.PostgresProvider) biz.IJobRunRepo {
+	return &jobRunRepo{dbProvider: dbProvider}
+}
+
+func (repo *jobRunRepo) Create(ctx context.Context, run *biz.JobRun) error {
+	now := time.Now()
+	record := &model.JobRun{
+		JobName:      run.JobName,
+		Shard:        int32(run.Shard),
+		Shards:       int32(run.Shards),
+		InstanceID:   run.InstanceId,
+		FencingToken: run.FencingToken,
+		Status:       jobRunStatusCodes[run.Status],
+		StartedAt:    run.StartedAt,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	q := dao.Use(repo.dbProvider.GetDB()).JobRun
+	if err := q.WithContext(ctx).Omit(q.FinishedAt).Create(record); err != nil {
+		return errors.Wrap(err, "data: create job run")
+	}
+	run.Id = record.ID
+	return nil
+}
+
+func (repo *jobRunRepo) Finish(ctx context.Context, run *biz.JobRun) error {
+	q := dao.Use(repo.dbProvider.GetDB()).JobRun
+	if _, err := q.WithContext(ctx).Where(q.ID.Eq(run.Id)).UpdateSimple(
+		q.Status.Value(jobRunStatusCodes[run.Status]),
+		q.Error.Value(truncateError(run.Error)),
+		q.DurationMs.Value(run.Duration.Milliseconds()),
+		q.FinishedAt.Value(run.FinishedAt),
+		q.UpdatedAt.Value(time.Now()),
+	); err != nil {
+		return errors.Wrap(err, "data: finish job run")
+	}
+	return nil
+}
+
+func (repo *jobRunRepo) ListRuns(ctx context.Context, jobName string, page, pageSize int) ([]*biz.JobRun, int64, error) {
+	q := dao.Use(repo.dbProvider.GetDB()).JobRun
+	do := q.WithContext(ctx)
+	if jobName != "" {
+		do = do.Where(q.JobName.Eq(jobName))
+	}
+	records, total, err := do.Order(q.StartedAt.Desc(), q.ID.Desc()).FindByPage((page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "data: list job runs")
+	}
+	runs := make([]*biz.JobRun, 0, len(records))
+	for _, record := range records {
+		runs = append(runs, toJobRun(record))
+	}
+	return runs, total, nil
+}
+
+func (repo *jobRunRepo) ListRecentStatuses(ctx context.Context, jobName string, limit int) ([]string, error) {
+	q := dao.Use(repo.dbProvider.GetDB()).JobRun
+	records, err := q.WithContext(ctx).Select(q.Status).
+		Where(q.JobName.Eq(jobName), q.Status.In(jobRunStatusSucceeded, jobRunStatusFailed)).
+		Order(q.StartedAt.Desc(), q.ID.Desc()).
+		Limit(limit).
+		Find()
+	if err != nil {
+		return nil, errors.Wrap(err, "data: list job recent statuses")
+	}
+	statuses := make([]string, 0, len(records))
+	for _, record := range records {
+		statuses = append(statuses, jobRunStatusName(record.Status))
+	}
+	return statuses, nil
+}
+
+func (repo *jobRunRepo) DeleteStartedBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	q := dao.Use(repo.dbProvider.GetDB()).JobRun
+	sub := q.WithContext(ctx).Select(q.ID).Where(q.StartedAt.Lt(before)).Limit(limit)
+	result, err := q.WithContext(ctx).Where(q.Columns(q.ID).In(sub)).Delete()
+	if err != nil {
+		return 0, errors.Wrap(err, "data: delete job runs")
+	}
+	return result.RowsAffected, nil
+}
+
+func jobRunStatusName(status int16) string {
+	for name, code := range jobRunStatusCodes {
+		if code == status {
+			return name
+		}
+	}
+	return ""
+}
+
+func toJobRun(record *model.JobRun) *biz.JobRun {
+	return &biz.JobRun{
+		Id:           record.ID,
+		JobName:      record.JobName,
+		Shard:        int(record.Shard),
+		Shards:       int(record.Shards),
+		InstanceId:   record.InstanceID,
+		FencingToken: record.FencingToken,
+		Status:       jobRunStatusName(record.Status),
+		Error:        record.Error,
+		Duration:     time.Duration(record.DurationMs) * time.Millisecond,
+		StartedAt:    record.StartedAt,
+		FinishedAt:   record.FinishedAt,
+	}
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	biz "github.com/seanbit/kratos/template/internal/biz"
 	data "github.com/seanbit/kratos/template/internal/data"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,6 +43,80 @@ func (m *MockIAlarmMessageRepo) EXPECT() *MockIAlarmMessageRepoMockRecorder {
 	return m.recorder
 }
 
+// AcquireSendToken mocks base method.
+func (m *MockIAlarmMessageRepo) AcquireSendToken(ctx context.Context, platform string, rate float64, burst int) (bool, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireSendToken", ctx, platform, rate, burst)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AcquireSendToken indicates an expected call of AcquireSendToken.
+func (mr *MockIAlarmMessageRepoMockRecorder) AcquireSendToken(ctx, platform, rate, burst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireSendToken", reflect.TypeOf((*MockIAlarmMessageRepo)(nil).AcquireSendToken), ctx, platform, rate, burst)
+}
+
+// AppendBatchMessage mocks base method.
+func (m *MockIAlarmMessageRepo) AppendBatchMessage(ctx context.Context, msg *data.AlarmMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendBatchMessage", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendBatchMessage indicates an expected call of AppendBatchMessage.
+func (mr *MockIAlarmMessageRepoMockRecorder) AppendBatchMessage(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendBatchMessage", reflect.TypeOf((*MockIAlarmMessageRepo)(nil).AppendBatchMessage), ctx, msg)
+}
+
+// BatchLength mocks base method.
+func (m *MockIAlarmMessageRepo) BatchLength(ctx context.Context, platform string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchLength", ctx, platform)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchLength indicates an expected call of BatchLength.
+func (mr *MockIAlarmMessageRepoMockRecorder) BatchLength(ctx, platform any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchLength", reflect.TypeOf((*MockIAlarmMessageRepo)(nil).BatchLength), ctx, platform)
+}
+
+// BlockPlatform mocks base method.
+func (m *MockIAlarmMessageRepo) BlockPlatform(ctx context.Context, platform string, duration time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockPlatform", ctx, platform, duration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockPlatform indicates an expected call of BlockPlatform.
+func (mr *MockIAlarmMessageRepoMockRecorder) BlockPlatform(ctx, platform, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockPlatform", reflect.TypeOf((*MockIAlarmMessageRepo)(nil).BlockPlatform), ctx, platform, duration)
+}
+
+// DelayedQueueSize mocks base method.
+func (m *MockIAlarmMessageRepo) DelayedQueueSize(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelayedQueueSize", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DelayedQueueSize indicates an expected call of DelayedQueueSize.
+func (mr *MockIAlarmMessageRepoMockRecorder) DelayedQueueSize(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelayedQueueSize", reflect.TypeOf((*MockIAlarmMessageRepo)(nil).DelayedQueueSize), ctx)
+}
+
 // DequeueMessage mocks base method.
 func (m *MockIAlarmMessageRepo) DequeueMessage(ctx context.Context) (*data.AlarmMessage, error) {
 	m.ctrl.T.Helper()
@@ -144,6 +219,65 @@ func (mr *MockIAlarmMessageRepoMockRecorder) IsMessageFusing(ctx, serviceName, m
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMessageFusing", reflect.TypeOf((*MockIAlarmMessageRepo)(nil).IsMessageFusing), ctx, serviceName, message)
 }
 
+// ListDelayedMessages mocks base method.
+func (m *MockIAlarmMessageRepo) ListDelayedMessages(ctx context.Context, limit int) ([]*data.DelayedAlarmMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDelayedMessages", ctx, limit)
+	ret0, _ := ret[0].([]*data.DelayedAlarmMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDelayedMessages indicates an expected call of ListDelayedMessages.
+func (mr *MockIAlarmMessageRepoMockRecorder) ListDelayedMessages(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDelayedMessages", reflect.TypeOf((*MockIAlarmMessageRepo)(nil).ListDelayedMessages), ctx, limit)
+}
+
+// ListFusedMessages mocks base method.
+func (m *MockIAlarmMessageRepo) ListFusedMessages(ctx context.Context, serviceName string) ([]*biz.FusedAlarmMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFusedMessages", ctx, serviceName)
+	ret0, _ := ret[0].([]*biz.FusedAlarmMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFusedMessages indicates an expected call of ListFusedMessages.
+func (mr *MockIAlarmMessageRepoMockRecorder) ListFusedMessages(ctx, serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFusedMessages", reflect.TypeOf((*MockIAlarmMessageRepo)(nil).ListFusedMessages), ctx, serviceName)
+}
+
+// Ping mocks base method.
+func (m *MockIAlarmMessageRepo) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockIAlarmMessageRepoMockRecorder) Ping(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockIAlarmMessageRepo)(nil).Ping), ctx)
+}
+
+// PopBatchMessages mocks base method.
+func (m *MockIAlarmMessageRepo) PopBatchMessages(ctx context.Context, platform string, limit int) ([]*data.AlarmMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopBatchMessages", ctx, platform, limit)
+	ret0, _ := ret[0].([]*data.AlarmMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopBatchMessages indicates an expected call of PopBatchMessages.
+func (mr *MockIAlarmMessageRepoMockRecorder) PopBatchMessages(ctx, platform, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopBatchMessages", reflect.TypeOf((*MockIAlarmMessageRepo)(nil).PopBatchMessages), ctx, platform, limit)
+}
+
 // ProcessDelayedMessages mocks base method.
 func (m *MockIAlarmMessageRepo) ProcessDelayedMessages(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -157,3 +291,18 @@ func (mr *MockIAlarmMessageRepoMockRecorder) ProcessDelayedMessages(ctx any) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessDelayedMessages", reflect.TypeOf((*MockIAlarmMessageRepo)(nil).ProcessDelayedMessages), ctx)
 }
+
+// QueueLength mocks base method.
+func (m *MockIAlarmMessageRepo) QueueLength(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueLength", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueLength indicates an expected call of QueueLength.
+func (mr *MockIAlarmMessageRepoMockRecorder) QueueLength(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueLength", reflect.TypeOf((*MockIAlarmMessageRepo)(nil).QueueLength), ctx)
+}
@@ -0,0 +1,171 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/data/dao"
+	"github.com/seanbit/kratos/template/internal/data/model"
+	"github.com/seanbit/kratos/template/internal/infra"
+)
+
+// outbox事件状态
+const (
+	outboxStatusPending   int16 = 0
+	outboxStatusPublished int16 = 1
+	outboxStatusFailed    int16 = 2
+)
+
+// outboxClaimPendingSQL 取出到期的待投递事件并推迟其下次投递时间：同一聚合存在更早的待投递事件时跳过，保证按写入顺序投递
+// 取出后事件仍为待投递状态，投递完成前同一聚合的后续事件不会被取出
+var outboxClaimPendingSQL = fmt.Sprintf(`UPDATE %[1]s SET attempts = attempts + 1, next_attempt_at = ?, updated_at = ?
+WHERE id IN (
+	SELECT o.id FROM %[1]s o
+	WHERE o.status = ? AND o.next_attempt_at <= ?
+	AND NOT EXISTS (
+		SELECT 1 FROM %[1]s p
+		WHERE p.aggregate_type = o.aggregate_type AND p.aggregate_id = o.aggregate_id
+		AND p.status = ? AND p.id < o.id
+	)
+	ORDER BY o.id
+	LIMIT ?
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *`, model.TableNameEventOutbox)
+
+type outboxRepo struct {
+	dbProvider infra.PostgresProvider
+}
+
+func NewOutboxRepo(dbProvider infra.PostgresProvider) biz.IOutboxRepo {
+	return &outboxRepo{dbProvider: dbProvider}
+}
+
+// Add 写入outbox，需与业务数据在同一事务中调用
+func (repo *outboxRepo) Add(ctx context.Context, events ...*biz.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	now := time.Now()
+	records := make([]*model.EventOutbox, 0, len(events))
+	for _, event := range events {
+		headers, err := json.Marshal(event.Headers)
+		if err != nil || event.Headers == nil {
+			headers = []byte("{}")
+		}
+		records = append(records, &model.EventOutbox{
+			EventID:       event.EventId,
+			EventName:     event.Name,
+			AggregateType: event.AggregateType,
+			AggregateID:   event.AggregateId,
+			Payload:       event.Payload,
+			Headers:       string(headers),
+			Status:        outboxStatusPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+	}
+	q := dao.Use(dbFromContext(ctx, repo.dbProvider)).EventOutbox
+	if err := q.WithContext(ctx).Omit(q.PublishedAt).Create(records...); err != nil {
+		return errors.Wrap(err, "data: add outbox events")
+	}
+	for i, record := range records {
+		events[i].ID = record.ID
+		events[i].CreatedAt = record.CreatedAt
+	}
+	return nil
+}
+
+func (repo *outboxRepo) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*biz.OutboxEvent, error) {
+	now := time.Now()
+	var records []*model.EventOutbox
+	err := repo.dbProvider.GetDB().WithContext(ctx).
+		Raw(outboxClaimPendingSQL, now.Add(lease), now, outboxStatusPending, now, outboxStatusPending, limit).
+		Scan(&records).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "data: claim pending outbox events")
+	}
+	// RETURNING不保证顺序
+	sort.Slice(records, func(i, j int) bool { return records[i].ID < records[j].ID })
+	events := make([]*biz.OutboxEvent, 0, len(records))
+	for _, record := range records {
+		events = append(events, toOutboxEvent(record))
+	}
+	return events, nil
+}
+
+func toOutboxEvent(record *model.EventOutbox) *biz.OutboxEvent {
+	event := &biz.OutboxEvent{
+		ID:            record.ID,
+		EventId:       record.EventID,
+		Name:          record.EventName,
+		AggregateType: record.AggregateType,
+		AggregateId:   record.AggregateID,
+		Payload:       record.Payload,
+		Headers:       &biz.EventHeaders{},
+		Attempts:      int(record.Attempts),
+		CreatedAt:     record.CreatedAt,
+	}
+	if record.Headers != "" {
+		// 头信息损坏不影响投递
+		_ = json.Unmarshal([]byte(record.Headers), event.Headers)
+	}
+	return event
+}
+
+func (repo *outboxRepo) MarkPublished(ctx context.Context, id int64) error {
+	q := dao.Use(dbFromContext(ctx, repo.dbProvider)).EventOutbox
+	now := time.Now()
+	if _, err := q.WithContext(ctx).Where(q.ID.Eq(id)).UpdateSimple(
+		q.Status.Value(outboxStatusPublished),
+		q.PublishedAt.Value(now),
+		q.UpdatedAt.Value(now),
+	); err != nil {
+		return errors.Wrap(err, "data: mark outbox event published")
+	}
+	return nil
+}
+
+func (repo *outboxRepo) MarkRetry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, lastErr string) error {
+	q := dao.Use(dbFromContext(ctx, repo.dbProvider)).EventOutbox
+	if _, err := q.WithContext(ctx).Where(q.ID.Eq(id)).UpdateSimple(
+		q.Attempts.Value(int32(attempts)),
+		q.NextAttemptAt.Value(nextAttemptAt),
+		q.LastError.Value(truncateError(lastErr)),
+		q.UpdatedAt.Value(time.Now()),
+	); err != nil {
+		return errors.Wrap(err, "data: mark outbox event retry")
+	}
+	return nil
+}
+
+func (repo *outboxRepo) MarkFailed(ctx context.Context, id int64, attempts int, lastErr string) error {
+	q := dao.Use(dbFromContext(ctx, repo.dbProvider)).EventOutbox
+	if _, err := q.WithContext(ctx).Where(q.ID.Eq(id)).UpdateSimple(
+		q.Status.Value(outboxStatusFailed),
+		q.Attempts.Value(int32(attempts)),
+		q.LastError.Value(truncateError(lastErr)),
+		q.UpdatedAt.Value(time.Now()),
+	); err != nil {
+		return errors.Wrap(err, "data: mark outbox event failed")
+	}
+	return nil
+}
+
+func (repo *outboxRepo) DeletePublishedBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	q := dao.Use(repo.dbProvider.GetDB()).EventOutbox
+	sub := q.WithContext(ctx).Select(q.ID).
+		Where(q.Status.Eq(outboxStatusPublished), q.PublishedAt.Lt(before)).
+		Limit(limit)
+	result, err := q.WithContext(ctx).Where(q.Columns(q.ID).In(sub)).Delete()
+	if err != nil {
+		return 0, errors.Wrap(err, "data: delete published outbox events")
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,119 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/global"
+	"github.com/seanbit/kratos/template/internal/infra"
+)
+
+// 事件处理记录的状态
+const (
+	eventStatusCompleted = "completed"
+	eventStatusFailed    = "failed"
+)
+
+// eventErrorMaxLen 处理记录中保存的错误信息最大长度
+const eventErrorMaxLen = 512
+
+// eventClaimScript 取得事件处理权：已完成返回1，处理中返回2，否则标记为处理中并返回0
+// KEYS[1] 记录key，ARGV[1] 事件名，ARGV[2] 当前时间（unix秒），ARGV[3] lease（毫秒）
+var eventClaimScript = redis.NewScript(`
+local status = redis.call('HGET', KEYS[1], 'status')
+if status == 'completed' then
+	return 1
+end
+if status == 'processing' then
+	return 2
+end
+redis.call('HSET', KEYS[1], 'status', 'processing', 'name', ARGV[1], 'updated_at', ARGV[2])
+redis.call('HINCRBY', KEYS[1], 'attempts', 1)
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+return 0
+`)
+
+type processedEventRepo struct {
+	rdbProvider infra.RedisProvider
+}
+
+func NewProcessedEventRepo(rdbProvider infra.RedisProvider) biz.IProcessedEventRepo {
+	return &processedEventRepo{rdbProvider: rdbProvider}
+}
+
+func (repo *processedEventRepo) key(eventId string) string {
+	return fmt.Sprintf("%s:event:processed:%s", global.GetServiceName(), eventId)
+}
+
+func (repo *processedEventRepo) Claim(ctx context.Context, eventId, name string, lease time.Duration) (biz.EventProcessState, error) {
+	state, err := eventClaimScript.Run(ctx, repo.rdbProvider.GetRedis(), []string{repo.key(eventId)},
+		name, time.Now().Unix(), lease.Milliseconds()).Int()
+	if err != nil {
+		return 0, errors.Wrap(err, "data: claim event")
+	}
+	switch state {
+	case 1:
+		return biz.EventProcessCompleted, nil
+	case 2:
+		return biz.EventProcessInProgress, nil
+	default:
+		return biz.EventProcessClaimed, nil
+	}
+}
+
+func (repo *processedEventRepo) Complete(ctx context.Context, eventId string, ttl time.Duration) error {
+	key := repo.key(eventId)
+	_, err := repo.rdbProvider.GetRedis().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "status", eventStatusCompleted, "updated_at", time.Now().Unix())
+		pipe.HDel(ctx, key, "error")
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "data: complete event")
+	}
+	return nil
+}
+
+func (repo *processedEventRepo) Fail(ctx context.Context, eventId string, cause error, ttl time.Duration) error {
+	message := cause.Error()
+	if len(message) > eventErrorMaxLen {
+		message = message[:eventErrorMaxLen]
+	}
+	key := repo.key(eventId)
+	_, err := repo.rdbProvider.GetRedis().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "status", eventStatusFailed, "error", message, "updated_at", time.Now().Unix())
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "data: fail event")
+	}
+	return nil
+}
+
+func (repo *processedEventRepo) Get(ctx context.Context, eventId string) (*biz.EventProcessRecord, error) {
+	fields, err := repo.rdbProvider.GetRedis().HGetAll(ctx, repo.key(eventId)).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "data: get event record")
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	record := &biz.EventProcessRecord{
+		EventId: eventId,
+		Name:    fields["name"],
+		Status:  fields["status"],
+		Error:   fields["error"],
+	}
+	record.Attempts, _ = strconv.Atoi(fields["attempts"])
+	if updatedAt, err := strconv.ParseInt(fields["updated_at"], 10, 64); err == nil {
+		record.UpdatedAt = time.Unix(updatedAt, 0)
+	}
+	return record, nil
+}
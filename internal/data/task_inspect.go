@@ -0,0 +1,228 @@
+package data
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/api/event"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/pkg/pbhelper"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// taskInspectRepo 基于asynq Inspector的任务查询与操作，同时定期采集队列深度指标
+type taskInspectRepo struct {
+	inspector *asynq.Inspector
+	queues    []string
+	log       *log.Helper
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+func NewTaskInspectRepo(config *conf.Server, logger log.Logger) (biz.ITaskInspectRepo, func(), error) {
+	redisConnOpts, err := asynq.ParseRedisURI(config.GetAsynq().GetRedisUri())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "data: parse asynq redis uri")
+	}
+	queues := make([]string, 0, len(config.GetAsynq().GetQueues()))
+	for name := range config.GetAsynq().GetQueues() {
+		queues = append(queues, name)
+	}
+	if len(queues) == 0 {
+		queues = append(queues, "default")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	repo := &taskInspectRepo{
+		inspector: asynq.NewInspector(redisConnOpts),
+		queues:    queues,
+		log:       log.NewHelper(log.With(logger, "module", "data/task-inspect")),
+		cancel:    cancel,
+	}
+	repo.wg.Add(1)
+	go repo.metricsCollector(ctx)
+	cleanup := func() {
+		repo.cancel()
+		repo.wg.Wait()
+		if err := repo.inspector.Close(); err != nil {
+			repo.log.Errorf("close asynq inspector: %v", err)
+		}
+	}
+	return repo, cleanup, nil
+}
+
+func (repo *taskInspectRepo) ListQueues(ctx context.Context) ([]*biz.TaskQueueInfo, error) {
+	names, err := repo.inspector.Queues()
+	if err != nil {
+		return nil, errors.Wrap(err, "data: list asynq queues")
+	}
+	queues := make([]*biz.TaskQueueInfo, 0, len(names))
+	for _, name := range names {
+		info, err := repo.GetQueue(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		queues = append(queues, info)
+	}
+	return queues, nil
+}
+
+func (repo *taskInspectRepo) GetQueue(ctx context.Context, queue string) (*biz.TaskQueueInfo, error) {
+	info, err := repo.inspector.GetQueueInfo(queue)
+	if err != nil {
+		return nil, toTaskError(err)
+	}
+	return &biz.TaskQueueInfo{
+		Queue:     info.Queue,
+		Size:      info.Size,
+		Pending:   info.Pending,
+		Active:    info.Active,
+		Scheduled: info.Scheduled,
+		Retry:     info.Retry,
+		Archived:  info.Archived,
+		Completed: info.Completed,
+		Processed: info.Processed,
+		Failed:    info.Failed,
+		Latency:   info.Latency,
+		Paused:    info.Paused,
+	}, nil
+}
+
+func (repo *taskInspectRepo) ListTasks(ctx context.Context, queue, state string, page, pageSize int) ([]*biz.TaskInfo, error) {
+	opts := []asynq.ListOption{asynq.Page(page), asynq.PageSize(pageSize)}
+	var (
+		infos []*asynq.TaskInfo
+		err   error
+	)
+	switch state {
+	case biz.TaskStatePending:
+		infos, err = repo.inspector.ListPendingTasks(queue, opts...)
+	case biz.TaskStateActive:
+		infos, err = repo.inspector.ListActiveTasks(queue, opts...)
+	case biz.TaskStateScheduled:
+		infos, err = repo.inspector.ListScheduledTasks(queue, opts...)
+	case biz.TaskStateRetry:
+		infos, err = repo.inspector.ListRetryTasks(queue, opts...)
+	case biz.TaskStateArchived:
+		infos, err = repo.inspector.ListArchivedTasks(queue, opts...)
+	case biz.TaskStateCompleted:
+		infos, err = repo.inspector.ListCompletedTasks(queue, opts...)
+	default:
+		return nil, biz.ErrTaskActionInvalid.WithCause(fmt.Errorf("unknown task state: %s", state))
+	}
+	if err != nil {
+		return nil, toTaskError(err)
+	}
+	tasks := make([]*biz.TaskInfo, 0, len(infos))
+	for _, info := range infos {
+		tasks = append(tasks, toBizTaskInfo(info))
+	}
+	return tasks, nil
+}
+
+func (repo *taskInspectRepo) ApplyAction(ctx context.Context, action, queue, id string) error {
+	var err error
+	switch action {
+	case biz.TaskActionRun:
+		err = repo.inspector.RunTask(queue, id)
+	case biz.TaskActionArchive:
+		err = repo.inspector.ArchiveTask(queue, id)
+	case biz.TaskActionDelete:
+		err = repo.inspector.DeleteTask(queue, id)
+	default:
+		return biz.ErrTaskActionInvalid.WithCause(fmt.Errorf("unknown task action: %s", action))
+	}
+	if err != nil {
+		return toTaskError(err)
+	}
+	log.Context(ctx).Infof("Task %s/%s %s", queue, id, action)
+	return nil
+}
+
+func (repo *taskInspectRepo) ApplyActionAll(ctx context.Context, action, queue, state string) (int, error) {
+	var (
+		count int
+		err   error
+	)
+	switch action + ":" + state {
+	case biz.TaskActionRun + ":" + biz.TaskStateScheduled:
+		count, err = repo.inspector.RunAllScheduledTasks(queue)
+	case biz.TaskActionRun + ":" + biz.TaskStateRetry:
+		count, err = repo.inspector.RunAllRetryTasks(queue)
+	case biz.TaskActionRun + ":" + biz.TaskStateArchived:
+		count, err = repo.inspector.RunAllArchivedTasks(queue)
+	case biz.TaskActionArchive + ":" + biz.TaskStatePending:
+		count, err = repo.inspector.ArchiveAllPendingTasks(queue)
+	case biz.TaskActionArchive + ":" + biz.TaskStateScheduled:
+		count, err = repo.inspector.ArchiveAllScheduledTasks(queue)
+	case biz.TaskActionArchive + ":" + biz.TaskStateRetry:
+		count, err = repo.inspector.ArchiveAllRetryTasks(queue)
+	case biz.TaskActionDelete + ":" + biz.TaskStatePending:
+		count, err = repo.inspector.DeleteAllPendingTasks(queue)
+	case biz.TaskActionDelete + ":" + biz.TaskStateScheduled:
+		count, err = repo.inspector.DeleteAllScheduledTasks(queue)
+	case biz.TaskActionDelete + ":" + biz.TaskStateRetry:
+		count, err = repo.inspector.DeleteAllRetryTasks(queue)
+	case biz.TaskActionDelete + ":" + biz.TaskStateArchived:
+		count, err = repo.inspector.DeleteAllArchivedTasks(queue)
+	case biz.TaskActionDelete + ":" + biz.TaskStateCompleted:
+		count, err = repo.inspector.DeleteAllCompletedTasks(queue)
+	default:
+		return 0, biz.ErrTaskActionInvalid.WithCause(fmt.Errorf("%s all %s tasks is not supported", action, state))
+	}
+	if err != nil {
+		return 0, toTaskError(err)
+	}
+	log.Context(ctx).Infof("Task %s all %s tasks in %s: %d", action, state, queue, count)
+	return count, nil
+}
+
+func toTaskError(err error) error {
+	switch {
+	case errors.Is(err, asynq.ErrQueueNotFound):
+		return biz.ErrTaskQueueNotFound.WithCause(err)
+	case errors.Is(err, asynq.ErrTaskNotFound):
+		return biz.ErrTaskNotFound.WithCause(err)
+	}
+	return errors.Wrap(err, "data: asynq inspector")
+}
+
+func toBizTaskInfo(info *asynq.TaskInfo) *biz.TaskInfo {
+	task := &biz.TaskInfo{
+		Id:            info.ID,
+		Queue:         info.Queue,
+		Type:          info.Type,
+		State:         info.State.String(),
+		MaxRetry:      info.MaxRetry,
+		Retried:       info.Retried,
+		LastErr:       info.LastErr,
+		LastFailedAt:  info.LastFailedAt,
+		NextProcessAt: info.NextProcessAt,
+	}
+	task.EventId, task.Payload, task.DecodeError = decodeTaskPayload(info.Type, info.Payload)
+	return task
+}
+
+// decodeTaskPayload 按任务类型（事件名）将payload解码为JSON，兼容升级前不带信封的payload
+func decodeTaskPayload(taskType string, data []byte) (eventId, payload, decodeErr string) {
+	envelope := &event.Event{}
+	if err := proto.Unmarshal(data, envelope); err == nil && envelope.Name == taskType {
+		eventId, data = envelope.Id, envelope.Payload
+	}
+	message, err := pbhelper.CreateProtoMessageByName(taskType)
+	if err == nil {
+		if err = proto.Unmarshal(data, message); err == nil {
+			var out []byte
+			if out, err = protojson.Marshal(message); err == nil {
+				return eventId, string(out), ""
+			}
+		}
+	}
+	return eventId, base64.StdEncoding.EncodeToString(data), err.Error()
+}
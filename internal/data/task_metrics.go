@@ -0,0 +1,64 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/seanbit/kratos/template/internal/biz"
+)
+
+var (
+	asynqQueueSizeGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "asynq_queue_size",
+		Help: "The number of tasks in the asynq queue by state",
+	}, []string{"queue", "state"})
+
+	asynqQueueLatencyGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "asynq_queue_latency_seconds",
+		Help: "How long the oldest pending task in the asynq queue has been waiting",
+	}, []string{"queue"})
+)
+
+// taskMetricsInterval 队列深度指标的采集间隔
+const taskMetricsInterval = 15 * time.Second
+
+// metricsCollector 定期采集 conf.Server.Asynq.Queues 中各队列的深度和延迟
+func (repo *taskInspectRepo) metricsCollector(ctx context.Context) {
+	defer repo.wg.Done()
+
+	ticker := time.NewTicker(taskMetricsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, queue := range repo.queues {
+				info, err := repo.inspector.GetQueueInfo(queue)
+				if err != nil {
+					// 队列中还没有任务时不存在
+					if !errors.Is(err, asynq.ErrQueueNotFound) {
+						repo.log.Warnf("collect asynq queue %s metrics: %v", queue, err)
+					}
+					continue
+				}
+				for state, size := range map[string]int{
+					biz.TaskStatePending:   info.Pending,
+					biz.TaskStateActive:    info.Active,
+					biz.TaskStateScheduled: info.Scheduled,
+					biz.TaskStateRetry:     info.Retry,
+					biz.TaskStateArchived:  info.Archived,
+					biz.TaskStateCompleted: info.Completed,
+				} {
+					asynqQueueSizeGauge.WithLabelValues(queue, state).Set(float64(size))
+				}
+				asynqQueueLatencyGauge.WithLabelValues(queue).Set(info.Latency.Seconds())
+			}
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	bizmocks "github.com/seanbit/kratos/template/internal/biz/mocks"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/data"
+	"github.com/seanbit/kratos/template/internal/data/mocks"
+	"github.com/seanbit/kratos/template/internal/global"
+	"go.uber.org/mock/gomock"
+)
+
+// alarmTestQueue 模拟Redis中的主队列与各platform的合并队列
+type alarmTestQueue struct {
+	mu       sync.Mutex
+	messages []*data.AlarmMessage
+	batches  map[string][]*data.AlarmMessage
+}
+
+func (q *alarmTestQueue) Push(msg *data.AlarmMessage) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.messages = append(q.messages, msg)
+}
+
+func (q *alarmTestQueue) dequeue(ctx context.Context) (*data.AlarmMessage, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.messages) == 0 {
+		return nil, redis.Nil
+	}
+	msg := q.messages[0]
+	q.messages = q.messages[1:]
+	return msg, nil
+}
+
+func (q *alarmTestQueue) appendBatch(ctx context.Context, msg *data.AlarmMessage) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.batches[msg.Platform] = append(q.batches[msg.Platform], msg)
+	return nil
+}
+
+func (q *alarmTestQueue) batchLength(ctx context.Context, platform string) (int64, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return int64(len(q.batches[platform])), nil
+}
+
+func (q *alarmTestQueue) popBatch(ctx context.Context, platform string, limit int) ([]*data.AlarmMessage, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	n := min(limit, len(q.batches[platform]))
+	messages := q.batches[platform][:n]
+	q.batches[platform] = q.batches[platform][n:]
+	return messages, nil
+}
+
+// newAlarmTestRepos 主队列与合并队列由alarmTestQueue模拟，不涉及测试点的Redis调用均返回空结果
+func newAlarmTestRepos(ctrl *gomock.Controller) (*mocks.MockIAlarmMessageRepo, *bizmocks.MockIAlarmSilenceRepo, *alarmTestQueue) {
+	global.SetConfig(&conf.Bootstrap{Name: "alarm-test"})
+
+	queue := &alarmTestQueue{batches: make(map[string][]*data.AlarmMessage)}
+	messageRepo := mocks.NewMockIAlarmMessageRepo(ctrl)
+	messageRepo.EXPECT().IsIgnoreMessage(gomock.Any(), gomock.Any()).Return(false, 0).AnyTimes()
+	messageRepo.EXPECT().IsMessageFusing(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	messageRepo.EXPECT().DequeueMessage(gomock.Any()).DoAndReturn(queue.dequeue).AnyTimes()
+	messageRepo.EXPECT().ProcessDelayedMessages(gomock.Any()).Return(nil).AnyTimes()
+	messageRepo.EXPECT().QueueLength(gomock.Any()).Return(int64(0), nil).AnyTimes()
+	messageRepo.EXPECT().DelayedQueueSize(gomock.Any()).Return(int64(0), nil).AnyTimes()
+	messageRepo.EXPECT().AppendBatchMessage(gomock.Any(), gomock.Any()).DoAndReturn(queue.appendBatch).AnyTimes()
+	messageRepo.EXPECT().BatchLength(gomock.Any(), gomock.Any()).DoAndReturn(queue.batchLength).AnyTimes()
+	messageRepo.EXPECT().PopBatchMessages(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(queue.popBatch).AnyTimes()
+
+	silenceRepo := bizmocks.NewMockIAlarmSilenceRepo(ctrl)
+	silenceRepo.EXPECT().MatchSilence(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	silenceRepo.EXPECT().TouchAck(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	return messageRepo, silenceRepo, queue
+}
+
+// Redis不可用时直接发送，发送阻塞期间积压的消息在Redis恢复后转回Redis队列
+func TestAlarm_FallbackDrainOnRecovery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	messageRepo, silenceRepo, _ := newAlarmTestRepos(ctrl)
+
+	var (
+		redisDown atomic.Bool
+		recovered = make(chan struct{})
+		once      sync.Once
+		mu        sync.Mutex
+		requeued  []string
+	)
+	redisDown.Store(true)
+	messageRepo.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, msg *data.AlarmMessage) error {
+		if redisDown.Load() {
+			return errors.New("connection refused")
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		requeued = append(requeued, msg.Card.Info)
+		return nil
+	}).AnyTimes()
+	messageRepo.EXPECT().Ping(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		if redisDown.Load() {
+			return errors.New("connection refused")
+		}
+		once.Do(func() { close(recovered) })
+		return nil
+	}).AnyTimes()
+
+	var sent atomic.Int32
+	release := make(chan struct{})
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent.Add(1)
+		<-release
+	}))
+	defer webhook.Close()
+
+	alarm, cleanup, err := data.NewAlarm(&conf.Alarm{DefaultPlatform: "web", WebHooks: map[string]string{"web": webhook.URL}},
+		messageRepo, silenceRepo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	// 第一条由fallbackSender直接发送并阻塞，其余积压在降级队列
+	for _, info := range []string{"first", "second", "third"} {
+		alarm.SendBizMessage(context.Background(), "fallback", info)
+	}
+	redisDown.Store(false)
+	select {
+	case <-recovered:
+	case <-time.After(10 * time.Second):
+		t.Fatal("redis recovery not probed")
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		mu.Lock()
+		n := len(requeued)
+		mu.Unlock()
+		if n == 2 {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if strings.Join(requeued, ",") != "second,third" {
+		t.Errorf("expected pending messages requeued to redis, got %v", requeued)
+	}
+	if sent.Load() != 1 {
+		t.Errorf("expected only the first message sent directly, got %d", sent.Load())
+	}
+}
@@ -0,0 +1,196 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/data"
+	"github.com/seanbit/kratos/template/internal/data/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+// alarmTestWebhook 记录收到的请求体，按顺序返回预设的状态码，用完后返回200，429时Retry-After为3秒
+type alarmTestWebhook struct {
+	*httptest.Server
+	mu       sync.Mutex
+	statuses []int
+	bodies   []string
+}
+
+func newAlarmTestWebhook(statuses ...int) *alarmTestWebhook {
+	webhook := &alarmTestWebhook{statuses: statuses}
+	webhook.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		webhook.mu.Lock()
+		defer webhook.mu.Unlock()
+		webhook.bodies = append(webhook.bodies, string(body))
+		if len(webhook.statuses) > 0 {
+			status := webhook.statuses[0]
+			webhook.statuses = webhook.statuses[1:]
+			if status == http.StatusTooManyRequests {
+				w.Header().Set("Retry-After", "3")
+			}
+			w.WriteHeader(status)
+		}
+	}))
+	return webhook
+}
+
+func (webhook *alarmTestWebhook) Requests() []string {
+	webhook.mu.Lock()
+	defer webhook.mu.Unlock()
+	return append([]string(nil), webhook.bodies...)
+}
+
+// waitRequests 等待webhook收到n个请求
+func (webhook *alarmTestWebhook) waitRequests(t *testing.T, n int, timeout time.Duration) []string {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if requests := webhook.Requests(); len(requests) >= n {
+			return requests
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatalf("expected %d webhook requests, got %d", n, len(webhook.Requests()))
+	return nil
+}
+
+// alarmTestRateLimiter 模拟令牌桶：BlockPlatform期间拒绝，rate>0时按burst=1每秒放行一个
+type alarmTestRateLimiter struct {
+	mu           sync.Mutex
+	blockedUntil map[string]time.Time
+	lastAllowed  map[string]time.Time
+	rates        []float64
+}
+
+func newAlarmTestRateLimiter(messageRepo *mocks.MockIAlarmMessageRepo) *alarmTestRateLimiter {
+	limiter := &alarmTestRateLimiter{blockedUntil: make(map[string]time.Time), lastAllowed: make(map[string]time.Time)}
+	messageRepo.EXPECT().BlockPlatform(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, platform string, duration time.Duration) error {
+			limiter.mu.Lock()
+			defer limiter.mu.Unlock()
+			limiter.blockedUntil[platform] = time.Now().Add(duration)
+			return nil
+		}).AnyTimes()
+	messageRepo.EXPECT().AcquireSendToken(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, platform string, rate float64, burst int) (bool, time.Duration, error) {
+			limiter.mu.Lock()
+			defer limiter.mu.Unlock()
+			limiter.rates = append(limiter.rates, rate)
+			now := time.Now()
+			if wait := limiter.blockedUntil[platform].Sub(now); wait > 0 {
+				return false, wait, nil
+			}
+			if rate <= 0 {
+				return true, 0, nil
+			}
+			if wait := limiter.lastAllowed[platform].Add(time.Second).Sub(now); wait > 0 {
+				return false, wait, nil
+			}
+			limiter.lastAllowed[platform] = now
+			return true, 0, nil
+		}).AnyTimes()
+	return limiter
+}
+
+func (limiter *alarmTestRateLimiter) Rates() []float64 {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	return append([]float64(nil), limiter.rates...)
+}
+
+// 未配置限流的platform返回429后按Retry-After暂停，期间的消息在暂停结束后合并发送，不走指数退避重试
+func TestAlarm_RetryAfterWithoutRateLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	messageRepo, silenceRepo, queue := newAlarmTestRepos(ctrl)
+	newAlarmTestRateLimiter(messageRepo)
+	messageRepo.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, msg *data.AlarmMessage) error {
+		queue.Push(msg)
+		return nil
+	}).AnyTimes()
+	messageRepo.EXPECT().EnqueueDelayedMessage(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	webhook := newAlarmTestWebhook(http.StatusTooManyRequests)
+	defer webhook.Close()
+	alarm, cleanup, err := data.NewAlarm(&conf.Alarm{DefaultPlatform: "web", WebHooks: map[string]string{"web": webhook.URL}},
+		messageRepo, silenceRepo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	alarm.SendBizMessage(context.Background(), "limited", "first")
+	// 等待429的消息进入合并队列，此时platform已被暂停
+	for deadline := time.Now().Add(3 * time.Second); time.Now().Before(deadline); time.Sleep(50 * time.Millisecond) {
+		if n, _ := queue.batchLength(context.Background(), "web"); n == 1 {
+			break
+		}
+	}
+	alarm.SendBizMessage(context.Background(), "limited", "second")
+
+	requests := webhook.waitRequests(t, 2, 6*time.Second)
+	time.Sleep(1500 * time.Millisecond)
+	if requests = webhook.Requests(); len(requests) != 2 {
+		t.Fatalf("expected 2 webhook requests, got %d: %v", len(requests), requests)
+	}
+	if !strings.Contains(requests[1], "+1 alarms batched") || !strings.Contains(requests[1], "first") || !strings.Contains(requests[1], "second") {
+		t.Errorf("expected messages merged after retry-after, got %s", requests[1])
+	}
+}
+
+// 热更新增加的限流配置生效，超出令牌的消息由batchFlusher按新配置合并发送
+func TestAlarm_RateLimitAddedByReload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	messageRepo, silenceRepo, queue := newAlarmTestRepos(ctrl)
+	limiter := newAlarmTestRateLimiter(messageRepo)
+	var enqueued atomic.Int32
+	messageRepo.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, msg *data.AlarmMessage) error {
+		enqueued.Add(1)
+		queue.Push(msg)
+		return nil
+	}).AnyTimes()
+
+	webhook := newAlarmTestWebhook()
+	defer webhook.Close()
+	config := &conf.Alarm{DefaultPlatform: "web", WebHooks: map[string]string{"web": webhook.URL}}
+	alarm, cleanup, err := data.NewAlarm(config, messageRepo, silenceRepo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	reloaded := &conf.Alarm{
+		DefaultPlatform: "web",
+		WebHooks:        config.WebHooks,
+		RateLimits:      map[string]*conf.Alarm_RateLimit{"web": {Rate: 1}},
+	}
+	if err := alarm.(*data.Alarm).Reload(reloaded); err != nil {
+		t.Fatal(err)
+	}
+	for _, info := range []string{"first", "second", "third"} {
+		alarm.SendBizMessage(context.Background(), "reloaded", info)
+	}
+
+	requests := webhook.waitRequests(t, 2, 5*time.Second)
+	if !strings.Contains(requests[1], "+1 alarms batched") {
+		t.Errorf("expected remaining messages merged, got %s", requests[1])
+	}
+	for _, rate := range limiter.Rates() {
+		if rate != 1 {
+			t.Errorf("expected reloaded rate 1, got %v", rate)
+		}
+	}
+	if enqueued.Load() != 3 {
+		t.Errorf("expected 3 messages enqueued, got %d", enqueued.Load())
+	}
+}
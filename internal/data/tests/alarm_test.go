@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	bizmocks "github.com/seanbit/kratos/template/internal/biz/mocks"
 	"github.com/seanbit/kratos/template/internal/data"
 	"github.com/seanbit/kratos/template/internal/data/mocks"
 	"github.com/seanbit/kratos/template/internal/global"
@@ -46,8 +47,16 @@ func TestAlarm_SendMessageWithMock(t *testing.T) {
 			return testData.timesCounter > testData.cooldownTimes, nil
 		}).AnyTimes()
 
+	silenceRepo := bizmocks.NewMockIAlarmSilenceRepo(ctrl)
+	silenceRepo.EXPECT().MatchSilence(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	silenceRepo.EXPECT().TouchAck(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+
 	global.GetConfig().Alarm.DryRun = true
-	alarm := data.NewAlarmRepo(global.GetConfig().Alarm, messageRepo)
+	alarm, cleanup, err := data.NewAlarm(global.GetConfig().Alarm, messageRepo, silenceRepo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
 	for i := 0; i <= testData.cooldownTimes; i++ {
 		t.Logf("send times: %d", i+1)
 		alarm.SendMessage(context.TODO(), testData.Platform, testData.Title, testData.Message)
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seanbit/kratos/template/internal/biz"
+	bizmocks "github.com/seanbit/kratos/template/internal/biz/mocks"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/data"
+	"github.com/seanbit/kratos/template/internal/global"
+	"github.com/seanbit/kratos/webkit"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+)
+
+// publishUserLoginEvent 返回写入outbox的事件头
+func publishUserLoginEvent(t *testing.T, ctx context.Context, userId string) *biz.EventHeaders {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var headers *biz.EventHeaders
+	outboxRepo := bizmocks.NewMockIOutboxRepo(ctrl)
+	outboxRepo.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, events ...*biz.OutboxEvent) error {
+		headers = events[0].Headers
+		return nil
+	})
+	repo := data.NewAuthLogRepo(nil, nil, outboxRepo)
+	err := repo.PublishUserLoginEvent(ctx, &biz.UserLoginLog{UserId: userId, AuthType: "wallet", LoginTime: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return headers
+}
+
+func TestPublishUserLoginEvent_Headers(t *testing.T) {
+	global.SetConfig(&conf.Bootstrap{Name: "event-test"})
+
+	traceId, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanId, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceId, SpanID: spanId, TraceFlags: trace.FlagsSampled,
+	}))
+	headers := publishUserLoginEvent(t, ctx, "user-1")
+	if headers.Traceparent != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+		t.Errorf("unexpected traceparent: %q", headers.Traceparent)
+	}
+	if headers.UserId != "user-1" || headers.Source != "event-test" || headers.SchemaVersion != 1 {
+		t.Errorf("unexpected headers: %+v", headers)
+	}
+	// 没有正在处理的事件时以trace ID作为关联ID
+	if headers.CorrelationId != traceId.String() {
+		t.Errorf("expected trace id as correlation id, got %q", headers.CorrelationId)
+	}
+
+	// 处理事件中产生的事件沿用其关联ID，用户ID为空时取登录用户
+	ctx = biz.NewEventHeadersContext(ctx, &biz.EventHeaders{CorrelationId: "correlation-1"})
+	ctx = webkit.NewUserInfoContext(ctx, &webkit.UserInfo{UserId: "user-2"})
+	headers = publishUserLoginEvent(t, ctx, "")
+	if headers.CorrelationId != "correlation-1" || headers.UserId != "user-2" {
+		t.Errorf("unexpected headers: %+v", headers)
+	}
+
+	// 没有trace时生成新的关联ID
+	headers = publishUserLoginEvent(t, context.Background(), "user-3")
+	if headers.Traceparent != "" || headers.CorrelationId == "" {
+		t.Errorf("unexpected headers: %+v", headers)
+	}
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+	"github.com/seanbit/kratos/template/internal/biz"
+	bizmocks "github.com/seanbit/kratos/template/internal/biz/mocks"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/data"
+	"github.com/seanbit/kratos/template/internal/global"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// testRedisProvider 使用miniredis的RedisProvider
+type testRedisProvider struct {
+	rdb *redis.Client
+}
+
+func (p *testRedisProvider) GetRedis() redis.UniversalClient { return p.rdb }
+func (p *testRedisProvider) Close()                          { _ = p.rdb.Close() }
+
+// 非asynq总线：到期后发布，同一key只调度一次，取消后不发布
+func TestEventScheduler_Redis(t *testing.T) {
+	global.SetConfig(&conf.Bootstrap{Name: "scheduler-test"})
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mr := miniredis.RunT(t)
+	rdbProvider := &testRedisProvider{rdb: redis.NewClient(&redis.Options{Addr: mr.Addr()})}
+	defer rdbProvider.Close()
+
+	var (
+		mu        sync.Mutex
+		published = make(map[string]time.Time)
+	)
+	bus := bizmocks.NewMockIEventBus(ctrl)
+	bus.EXPECT().Driver().Return(biz.EventBusDriverNats)
+	bus.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, e *biz.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		published[e.Name] = time.Now()
+		return nil
+	}).AnyTimes()
+	config := &conf.Server{EventBus: &conf.Server_EventBus{SchedulePollInterval: durationpb.New(50 * time.Millisecond)}}
+	scheduler, cleanup, err := data.NewEventScheduler(config, bus, nil, rdbProvider, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	ctx := context.Background()
+	at := time.Now().Add(500 * time.Millisecond)
+	if ok, err := scheduler.ScheduleAt(ctx, "reminder", &biz.Event{Name: "event.Reminder"}, at); !ok || err != nil {
+		t.Fatalf("schedule: %v, %v", ok, err)
+	}
+	if ok, err := scheduler.ScheduleIn(ctx, "reminder", &biz.Event{Name: "event.Duplicated"}, 0); ok || err != nil {
+		t.Errorf("duplicated schedule should be merged: %v, %v", ok, err)
+	}
+	if ok, err := scheduler.ScheduleAt(ctx, "cancelled", &biz.Event{Name: "event.Cancelled"}, at); !ok || err != nil {
+		t.Fatalf("schedule: %v, %v", ok, err)
+	}
+	if ok, err := scheduler.Cancel(ctx, "cancelled"); !ok || err != nil {
+		t.Errorf("cancel: %v, %v", ok, err)
+	}
+	if ok, _ := scheduler.Cancel(ctx, "cancelled"); ok {
+		t.Error("cancel twice should return false")
+	}
+
+	time.Sleep(time.Until(at) + 500*time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	if len(published) != 1 {
+		t.Fatalf("expected only the scheduled event published, got %v", published)
+	}
+	if fired, ok := published["event.Reminder"]; !ok || fired.Before(at) {
+		t.Errorf("expected event published after %s, got %s", at, fired)
+	}
+	// 发布后删除调度，同一key可以再次调度
+	if ok, err := scheduler.ScheduleAt(ctx, "reminder", &biz.Event{Name: "event.Reminder"}, at); !ok || err != nil {
+		t.Errorf("schedule after published: %v, %v", ok, err)
+	}
+}
+
+// asynq总线：以ProcessAt入队，取消时在任务所在的队列中删除
+func TestEventScheduler_Asynq(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mr := miniredis.RunT(t)
+	asynqClient := asynq.NewClient(asynq.RedisClientOpt{Addr: mr.Addr()})
+	defer asynqClient.Close()
+	inspector := asynq.NewInspector(asynq.RedisClientOpt{Addr: mr.Addr()})
+	defer inspector.Close()
+
+	bus := bizmocks.NewMockIEventBus(ctrl)
+	bus.EXPECT().Driver().Return(biz.EventBusDriverAsynq)
+	config := &conf.Server{Asynq: &conf.Server_ASYNQ{RedisUri: "redis://" + mr.Addr()}}
+	scheduler, cleanup, err := data.NewEventScheduler(config, bus, asynqClient, nil, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	ctx := context.Background()
+	at := time.Now().Add(time.Hour).Truncate(time.Second)
+	if ok, err := scheduler.ScheduleAt(ctx, "reminder", &biz.Event{Name: "event.Reminder"}, at); !ok || err != nil {
+		t.Fatalf("schedule: %v, %v", ok, err)
+	}
+	if ok, err := scheduler.ScheduleIn(ctx, "reminder", &biz.Event{Name: "event.Reminder"}, time.Minute); ok || err != nil {
+		t.Errorf("duplicated schedule should be merged: %v, %v", ok, err)
+	}
+	tasks, err := inspector.ListScheduledTasks("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 1 || !tasks[0].NextProcessAt.Equal(at) {
+		t.Fatalf("expected one task scheduled at %s, got %v", at, tasks)
+	}
+
+	// 任务在其他队列时同样可以取消
+	task := asynq.NewTask("event.Other", nil, asynq.TaskID("scheduled:other"))
+	if _, err = asynqClient.Enqueue(task, asynq.ProcessAt(at), asynq.Queue("low")); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"reminder", "other"} {
+		if ok, err := scheduler.Cancel(ctx, key); !ok || err != nil {
+			t.Errorf("cancel %s: %v, %v", key, ok, err)
+		}
+	}
+	if ok, err := scheduler.Cancel(ctx, "reminder"); ok || err != nil {
+		t.Errorf("cancel twice: %v, %v", ok, err)
+	}
+}
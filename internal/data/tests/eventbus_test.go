@@ -0,0 +1,212 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/go-kratos/kratos/v2/log"
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/seanbit/kratos/template/api/event"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/data"
+	"github.com/seanbit/kratos/template/internal/global"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const testEventName = "event.UserLogin"
+
+func runEmbeddedNats(t *testing.T) *natsserver.Server {
+	ns, err := natsserver.NewServer(&natsserver.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go ns.Start()
+	if !ns.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server not ready")
+	}
+	t.Cleanup(ns.Shutdown)
+	return ns
+}
+
+// eventCollector 按订阅者收集收到的事件ID
+type eventCollector struct {
+	mu       sync.Mutex
+	received map[string][]string
+}
+
+func (c *eventCollector) handler(subscriber string) biz.EventHandler {
+	return func(ctx context.Context, e *biz.Event) error {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.received[subscriber] = append(c.received[subscriber], e.Id)
+		return nil
+	}
+}
+
+func (c *eventCollector) count(subscriber string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.received[subscriber])
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	deadline := time.Now().Add(10 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timeout waiting for condition")
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestNatsEventBus_Subscribers(t *testing.T) {
+	global.SetConfig(&conf.Bootstrap{Name: "eventbus-test"})
+	ns := runEmbeddedNats(t)
+
+	bus, cleanup, err := data.NewEventBus(&conf.Server{EventBus: &conf.Server_EventBus{
+		Driver: biz.EventBusDriverNats,
+		Nats:   &conf.Server_EventBus_NATS{Url: ns.ClientURL()},
+	}}, nil, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	collector := &eventCollector{received: make(map[string][]string)}
+	// audit组和login组各自收到全部事件，login组的两个实例分摊事件
+	subscribers := map[string]string{"audit": "audit", "login-1": "login", "login-2": "login"}
+	var wg sync.WaitGroup
+	for subscriber, group := range subscribers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := bus.Subscribe(ctx, group, []string{testEventName}, collector.handler(subscriber)); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	// 等待durable consumer创建完成
+	time.Sleep(200 * time.Millisecond)
+
+	const total = 10
+	for i := 0; i < total; i++ {
+		e := &biz.Event{Id: fmt.Sprintf("e%d", i), Name: testEventName, Key: "user:1", Payload: []byte("{}"), Timestamp: time.Now()}
+		if err = bus.Publish(ctx, e); err != nil {
+			t.Fatal(err)
+		}
+		// 重复发布同一事件只保存一次
+		if err = bus.Publish(ctx, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	waitFor(t, func() bool {
+		return collector.count("audit") == total && collector.count("login-1")+collector.count("login-2") == total
+	})
+	time.Sleep(200 * time.Millisecond)
+	if collector.count("audit") != total {
+		t.Errorf("audit group received %d events, want %d", collector.count("audit"), total)
+	}
+	if login := collector.count("login-1") + collector.count("login-2"); login != total {
+		t.Errorf("login group received %d events, want %d", login, total)
+	}
+	cancel()
+	wg.Wait()
+}
+
+func TestKafkaEventBus_PublishAndSubscribe(t *testing.T) {
+	global.SetConfig(&conf.Bootstrap{Name: "eventbus-test"})
+	const topic = "events." + testEventName
+
+	envelope, err := proto.Marshal(&event.Event{
+		Id:        "k1",
+		Name:      testEventName,
+		Payload:   []byte("{}"),
+		Timestamp: timestamppb.Now(),
+		Headers:   &event.Headers{Source: "upstream", SchemaVersion: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader(topic, 0, broker.BrokerID()),
+		"ProduceRequest": sarama.NewMockProduceResponse(t),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).
+			SetOffset(topic, 0, sarama.OffsetOldest, 0).
+			SetOffset(topic, 0, sarama.OffsetNewest, 1),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).
+			SetCoordinator(sarama.CoordinatorGroup, "audit", broker),
+		"HeartbeatRequest": sarama.NewMockHeartbeatResponse(t),
+		"JoinGroupRequest": sarama.NewMockJoinGroupResponse(t).SetGroupProtocol(sarama.RangeBalanceStrategyName),
+		"SyncGroupRequest": sarama.NewMockSyncGroupResponse(t).SetMemberAssignment(&sarama.ConsumerGroupMemberAssignment{
+			Topics: map[string][]int32{topic: {0}},
+		}),
+		"OffsetFetchRequest": sarama.NewMockOffsetFetchResponse(t).
+			SetOffset("audit", topic, 0, 0, "", sarama.ErrNoError).SetError(sarama.ErrNoError),
+		"FetchRequest":        sarama.NewMockFetchResponse(t, 1).SetMessage(topic, 0, 0, sarama.ByteEncoder(envelope)),
+		"OffsetCommitRequest": sarama.NewMockOffsetCommitResponse(t),
+		"LeaveGroupRequest":   sarama.NewMockLeaveGroupResponse(t),
+	})
+
+	bus, cleanup, err := data.NewEventBus(&conf.Server{EventBus: &conf.Server_EventBus{
+		Driver: biz.EventBusDriverKafka,
+		Kafka:  &conf.Server_EventBus_Kafka{Brokers: []string{broker.Addr()}, Version: "2.0.0"},
+	}}, nil, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err = bus.Publish(ctx, &biz.Event{Id: "p1", Name: testEventName, Key: "user:1", Payload: []byte("{}"), Timestamp: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(chan *biz.Event, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		err := bus.Subscribe(ctx, "audit", []string{testEventName}, func(ctx context.Context, e *biz.Event) error {
+			select {
+			case received <- e:
+			default:
+			}
+			return nil
+		})
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+
+	select {
+	case e := <-received:
+		if e.Id != "k1" || e.Name != testEventName || e.Headers == nil || e.Headers.Source != "upstream" {
+			t.Errorf("unexpected event: %+v", e)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for kafka event")
+	}
+	cancel()
+	<-done
+}
@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/data"
+	"github.com/seanbit/kratos/template/internal/global"
+)
+
+// 每次定时执行加锁并认领计划执行时间，认领过期后只保留锁的fencing token计数器，key的数量不随执行次数增长
+func TestJobLockRepo_Claim(t *testing.T) {
+	global.SetConfig(&conf.Bootstrap{Name: "job-lock-test"})
+	mr := miniredis.RunT(t)
+	rdbProvider := &testRedisProvider{rdb: redis.NewClient(&redis.Options{Addr: mr.Addr()})}
+	defer rdbProvider.Close()
+	repo := data.NewJobLockRepo(rdbProvider)
+	ctx := context.Background()
+	ttl := time.Minute
+
+	fireTime := time.Now().Truncate(time.Second)
+	for i := 0; i < 3; i++ {
+		token, ok, err := repo.Acquire(ctx, "report", ttl)
+		if err != nil || !ok {
+			t.Fatalf("acquire: ok %v, err %v", ok, err)
+		}
+		claimKey := fmt.Sprintf("report:fired:%d", fireTime.Add(time.Duration(i)*time.Second).Unix())
+		if ok, err = repo.Claim(ctx, claimKey, ttl); err != nil || !ok {
+			t.Fatalf("claim: ok %v, err %v", ok, err)
+		}
+		// 同一次计划执行只能认领一次
+		if ok, err = repo.Claim(ctx, claimKey, ttl); err != nil || ok {
+			t.Fatalf("claim again: ok %v, err %v", ok, err)
+		}
+		if err = repo.Release(ctx, "report", token); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mr.FastForward(ttl + time.Second)
+	keys := mr.Keys()
+	if len(keys) != 1 || !strings.HasSuffix(keys[0], ":fence") {
+		t.Errorf("expected only the fencing token counter left, got %v", keys)
+	}
+}
@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/data"
+)
+
+func TestWebhookSender_PrivateNetwork(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	// 回环地址及解析到回环地址的域名都被拒绝
+	sender := data.NewWebhookSender(&conf.Data{})
+	localhost := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+	for _, url := range []string{server.URL, localhost, "http://[::1]:80", "http://169.254.169.254/latest/meta-data", "http://10.0.0.1"} {
+		if _, err := sender.Send(ctx, url, http.Header{}, nil, time.Second); err == nil || !strings.Contains(err.Error(), "is not allowed") {
+			t.Errorf("%s: expected address not allowed, got %v", url, err)
+		}
+	}
+
+	sender = data.NewWebhookSender(&conf.Data{Webhook: &conf.Data_Webhook{AllowPrivateNetwork: true}})
+	resp, err := sender.Send(ctx, server.URL, http.Header{}, nil, time.Second)
+	if err != nil || resp.Code != http.StatusAccepted {
+		t.Errorf("expected private network allowed, got %+v, %v", resp, err)
+	}
+}
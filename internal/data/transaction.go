@@ -0,0 +1,34 @@
+package data
+
+import (
+	"context"
+
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/infra"
+	"gorm.io/gorm"
+)
+
+type contextTxKey struct{}
+
+type transaction struct {
+	dbProvider infra.PostgresProvider
+}
+
+func NewTransaction(dbProvider infra.PostgresProvider) biz.ITransaction {
+	return &transaction{dbProvider: dbProvider}
+}
+
+// InTx 在事务中执行fn，ctx中已有事务时使用savepoint嵌套
+func (t *transaction) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return dbFromContext(ctx, t.dbProvider).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, contextTxKey{}, tx))
+	})
+}
+
+// dbFromContext 优先使用ctx中的事务，repo的写操作都应通过它获取db
+func dbFromContext(ctx context.Context, dbProvider infra.PostgresProvider) *gorm.DB {
+	if tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return dbProvider.GetDB()
+}
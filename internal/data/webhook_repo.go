@@ -0,0 +1,361 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/data/dao"
+	"github.com/seanbit/kratos/template/internal/data/model"
+	"github.com/seanbit/kratos/template/internal/infra"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// webhook投递状态
+const (
+	webhookStatusPending   int16 = 0
+	webhookStatusSucceeded int16 = 1
+	webhookStatusFailed    int16 = 2
+)
+
+// webhookClaimDueSQL 取出到期的待投递记录并推迟其下次投递时间，多实例间不会取到相同的记录
+var webhookClaimDueSQL = fmt.Sprintf(`UPDATE %[1]s SET next_attempt_at = ?, updated_at = ?
+WHERE id IN (
+	SELECT id FROM %[1]s
+	WHERE status = ? AND next_attempt_at <= ?
+	ORDER BY next_attempt_at
+	LIMIT ?
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *`, model.TableNameWebhookDelivery)
+
+type webhookRepo struct {
+	dbProvider infra.PostgresProvider
+}
+
+func NewWebhookRepo(dbProvider infra.PostgresProvider) biz.IWebhookRepo {
+	return &webhookRepo{dbProvider: dbProvider}
+}
+
+func (repo *webhookRepo) CreateSubscription(ctx context.Context, subscription *biz.WebhookSubscription) error {
+	record, err := toWebhookSubscriptionModel(subscription)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	record.CreatedAt, record.UpdatedAt = now, now
+	q := dao.Use(repo.dbProvider.GetDB()).WebhookSubscription
+	if err = q.WithContext(ctx).Create(record); err != nil {
+		return errors.Wrap(err, "data: create webhook subscription")
+	}
+	subscription.Id = record.ID
+	subscription.CreatedAt, subscription.UpdatedAt = now, now
+	return nil
+}
+
+func (repo *webhookRepo) UpdateSubscription(ctx context.Context, subscription *biz.WebhookSubscription) error {
+	record, err := toWebhookSubscriptionModel(subscription)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	q := dao.Use(repo.dbProvider.GetDB()).WebhookSubscription
+	result, err := q.WithContext(ctx).Where(q.ID.Eq(subscription.Id)).UpdateSimple(
+		q.Name.Value(record.Name),
+		q.URL.Value(record.URL),
+		q.EventTypes.Value(record.EventTypes),
+		q.Secret.Value(record.Secret),
+		q.Enabled.Value(record.Enabled),
+		q.Description.Value(record.Description),
+		q.UpdatedAt.Value(now),
+	)
+	if err != nil {
+		return errors.Wrap(err, "data: update webhook subscription")
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrWebhookSubscriptionNotFound
+	}
+	subscription.UpdatedAt = now
+	return nil
+}
+
+// DeleteSubscription 未结束的投递记录会在投递时标记为失败
+func (repo *webhookRepo) DeleteSubscription(ctx context.Context, id int64) error {
+	q := dao.Use(repo.dbProvider.GetDB()).WebhookSubscription
+	result, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Delete()
+	if err != nil {
+		return errors.Wrap(err, "data: delete webhook subscription")
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrWebhookSubscriptionNotFound
+	}
+	return nil
+}
+
+func (repo *webhookRepo) GetSubscription(ctx context.Context, id int64) (*biz.WebhookSubscription, error) {
+	q := dao.Use(repo.dbProvider.GetDB()).WebhookSubscription
+	record, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Take()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrWebhookSubscriptionNotFound
+		}
+		return nil, errors.Wrap(err, "data: get webhook subscription")
+	}
+	return toWebhookSubscription(record), nil
+}
+
+func (repo *webhookRepo) ListSubscriptions(ctx context.Context) ([]*biz.WebhookSubscription, error) {
+	q := dao.Use(repo.dbProvider.GetDB()).WebhookSubscription
+	records, err := q.WithContext(ctx).Order(q.ID).Find()
+	if err != nil {
+		return nil, errors.Wrap(err, "data: list webhook subscriptions")
+	}
+	subscriptions := make([]*biz.WebhookSubscription, 0, len(records))
+	for _, record := range records {
+		subscriptions = append(subscriptions, toWebhookSubscription(record))
+	}
+	return subscriptions, nil
+}
+
+func (repo *webhookRepo) ListSubscriptionsByEvent(ctx context.Context, name string) ([]*biz.WebhookSubscription, error) {
+	eventTypes, _ := json.Marshal([]string{name})
+	var records []*model.WebhookSubscription
+	err := repo.dbProvider.GetDB().WithContext(ctx).
+		Where("enabled AND event_types @> ?::jsonb", string(eventTypes)).
+		Order("id").
+		Find(&records).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "data: list webhook subscriptions by event")
+	}
+	subscriptions := make([]*biz.WebhookSubscription, 0, len(records))
+	for _, record := range records {
+		subscriptions = append(subscriptions, toWebhookSubscription(record))
+	}
+	return subscriptions, nil
+}
+
+// AddDeliveries 依赖 (subscription_id, event_id) 唯一索引去重
+func (repo *webhookRepo) AddDeliveries(ctx context.Context, deliveries ...*biz.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	now := time.Now()
+	records := make([]*model.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		records = append(records, &model.WebhookDelivery{
+			SubscriptionID: delivery.SubscriptionId,
+			EventID:        delivery.EventId,
+			EventName:      delivery.EventName,
+			Payload:        string(delivery.Payload),
+			Status:         webhookStatusPending,
+			NextAttemptAt:  delivery.NextAttemptAt,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		})
+	}
+	q := dao.Use(dbFromContext(ctx, repo.dbProvider)).WebhookDelivery
+	err := q.WithContext(ctx).Omit(q.DeliveredAt).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: q.SubscriptionID.ColumnName().String()}, {Name: q.EventID.ColumnName().String()}},
+		DoNothing: true,
+	}).Create(records...)
+	if err != nil {
+		return errors.Wrap(err, "data: add webhook deliveries")
+	}
+	return nil
+}
+
+func (repo *webhookRepo) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*biz.WebhookDelivery, error) {
+	now := time.Now()
+	var records []*model.WebhookDelivery
+	err := repo.dbProvider.GetDB().WithContext(ctx).
+		Raw(webhookClaimDueSQL, now.Add(lease), now, webhookStatusPending, now, limit).
+		Scan(&records).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "data: claim due webhook deliveries")
+	}
+	deliveries := make([]*biz.WebhookDelivery, 0, len(records))
+	for _, record := range records {
+		deliveries = append(deliveries, toWebhookDelivery(record))
+	}
+	return deliveries, nil
+}
+
+func (repo *webhookRepo) MarkSucceeded(ctx context.Context, id int64, attempts int, resp *biz.WebhookResponse) error {
+	q := dao.Use(repo.dbProvider.GetDB()).WebhookDelivery
+	now := time.Now()
+	code, duration := webhookResponseColumns(resp)
+	if _, err := q.WithContext(ctx).Where(q.ID.Eq(id)).UpdateSimple(
+		q.Status.Value(webhookStatusSucceeded),
+		q.Attempts.Value(int32(attempts)),
+		q.ResponseCode.Value(code),
+		q.DurationMs.Value(duration),
+		q.LastError.Value(""),
+		q.DeliveredAt.Value(now),
+		q.UpdatedAt.Value(now),
+	); err != nil {
+		return errors.Wrap(err, "data: mark webhook delivery succeeded")
+	}
+	return nil
+}
+
+func (repo *webhookRepo) MarkRetry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, resp *biz.WebhookResponse, lastErr string) error {
+	q := dao.Use(repo.dbProvider.GetDB()).WebhookDelivery
+	code, duration := webhookResponseColumns(resp)
+	if _, err := q.WithContext(ctx).Where(q.ID.Eq(id)).UpdateSimple(
+		q.Attempts.Value(int32(attempts)),
+		q.NextAttemptAt.Value(nextAttemptAt),
+		q.ResponseCode.Value(code),
+		q.DurationMs.Value(duration),
+		q.LastError.Value(truncateError(lastErr)),
+		q.UpdatedAt.Value(time.Now()),
+	); err != nil {
+		return errors.Wrap(err, "data: mark webhook delivery retry")
+	}
+	return nil
+}
+
+func (repo *webhookRepo) MarkFailed(ctx context.Context, id int64, attempts int, resp *biz.WebhookResponse, lastErr string) error {
+	q := dao.Use(repo.dbProvider.GetDB()).WebhookDelivery
+	code, duration := webhookResponseColumns(resp)
+	if _, err := q.WithContext(ctx).Where(q.ID.Eq(id)).UpdateSimple(
+		q.Status.Value(webhookStatusFailed),
+		q.Attempts.Value(int32(attempts)),
+		q.ResponseCode.Value(code),
+		q.DurationMs.Value(duration),
+		q.LastError.Value(truncateError(lastErr)),
+		q.UpdatedAt.Value(time.Now()),
+	); err != nil {
+		return errors.Wrap(err, "data: mark webhook delivery failed")
+	}
+	return nil
+}
+
+func (repo *webhookRepo) ResetDelivery(ctx context.Context, id int64) error {
+	q := dao.Use(repo.dbProvider.GetDB()).WebhookDelivery
+	now := time.Now()
+	result, err := q.WithContext(ctx).Where(q.ID.Eq(id)).UpdateSimple(
+		q.Status.Value(webhookStatusPending),
+		q.Attempts.Value(0),
+		q.NextAttemptAt.Value(now),
+		q.UpdatedAt.Value(now),
+	)
+	if err != nil {
+		return errors.Wrap(err, "data: reset webhook delivery")
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrWebhookDeliveryNotFound
+	}
+	return nil
+}
+
+func (repo *webhookRepo) ListDeliveries(ctx context.Context, subscriptionId int64, status string, page, pageSize int) ([]*biz.WebhookDelivery, int64, error) {
+	q := dao.Use(repo.dbProvider.GetDB()).WebhookDelivery
+	do := q.WithContext(ctx).Where(q.SubscriptionID.Eq(subscriptionId))
+	if status != "" {
+		code, ok := webhookStatusCodes[status]
+		if !ok {
+			return nil, 0, biz.ErrWebhookSubscriptionInvalid.WithCause(fmt.Errorf("unknown delivery status: %s", status))
+		}
+		do = do.Where(q.Status.Eq(code))
+	}
+	records, total, err := do.Order(q.ID.Desc()).FindByPage((page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "data: list webhook deliveries")
+	}
+	deliveries := make([]*biz.WebhookDelivery, 0, len(records))
+	for _, record := range records {
+		deliveries = append(deliveries, toWebhookDelivery(record))
+	}
+	return deliveries, total, nil
+}
+
+func (repo *webhookRepo) DeleteFinishedBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	q := dao.Use(repo.dbProvider.GetDB()).WebhookDelivery
+	sub := q.WithContext(ctx).Select(q.ID).
+		Where(q.Status.Neq(webhookStatusPending), q.UpdatedAt.Lt(before)).
+		Limit(limit)
+	result, err := q.WithContext(ctx).Where(q.Columns(q.ID).In(sub)).Delete()
+	if err != nil {
+		return 0, errors.Wrap(err, "data: delete finished webhook deliveries")
+	}
+	return result.RowsAffected, nil
+}
+
+var webhookStatusCodes = map[string]int16{
+	biz.WebhookDeliveryPending:   webhookStatusPending,
+	biz.WebhookDeliverySucceeded: webhookStatusSucceeded,
+	biz.WebhookDeliveryFailed:    webhookStatusFailed,
+}
+
+func webhookStatusName(status int16) string {
+	for name, code := range webhookStatusCodes {
+		if code == status {
+			return name
+		}
+	}
+	return ""
+}
+
+func webhookResponseColumns(resp *biz.WebhookResponse) (code int32, durationMs int32) {
+	if resp == nil {
+		return 0, 0
+	}
+	return int32(resp.Code), int32(resp.Duration.Milliseconds())
+}
+
+func toWebhookSubscriptionModel(subscription *biz.WebhookSubscription) (*model.WebhookSubscription, error) {
+	eventTypes := subscription.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	data, err := json.Marshal(eventTypes)
+	if err != nil {
+		return nil, errors.Wrap(err, "data: marshal webhook event types")
+	}
+	return &model.WebhookSubscription{
+		ID:          subscription.Id,
+		Name:        subscription.Name,
+		URL:         subscription.Url,
+		EventTypes:  string(data),
+		Secret:      subscription.Secret,
+		Enabled:     subscription.Enabled,
+		Description: subscription.Description,
+	}, nil
+}
+
+func toWebhookSubscription(record *model.WebhookSubscription) *biz.WebhookSubscription {
+	subscription := &biz.WebhookSubscription{
+		Id:          record.ID,
+		Name:        record.Name,
+		Url:         record.URL,
+		Secret:      record.Secret,
+		Enabled:     record.Enabled,
+		Description: record.Description,
+		CreatedAt:   record.CreatedAt,
+		UpdatedAt:   record.UpdatedAt,
+	}
+	_ = json.Unmarshal([]byte(record.EventTypes), &subscription.EventTypes)
+	return subscription
+}
+
+func toWebhookDelivery(record *model.WebhookDelivery) *biz.WebhookDelivery {
+	return &biz.WebhookDelivery{
+		Id:             record.ID,
+		SubscriptionId: record.SubscriptionID,
+		EventId:        record.EventID,
+		EventName:      record.EventName,
+		Payload:        []byte(record.Payload),
+		Status:         webhookStatusName(record.Status),
+		Attempts:       int(record.Attempts),
+		ResponseCode:   int(record.ResponseCode),
+		LastError:      record.LastError,
+		Duration:       time.Duration(record.DurationMs) * time.Millisecond,
+		NextAttemptAt:  record.NextAttemptAt,
+		DeliveredAt:    record.DeliveredAt,
+		CreatedAt:      record.CreatedAt,
+	}
+}
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+)
+
+// webhookDrainBodyMaxLen 丢弃响应body时最多读取的长度，读完后连接可以复用
+const webhookDrainBodyMaxLen = 64 * 1024
+
+type webhookSender struct {
+	client *http.Client
+}
+
+func NewWebhookSender(config *conf.Data) biz.IWebhookSender {
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	if !config.GetWebhook().GetAllowPrivateNetwork() {
+		dialer.Control = webhookDialControl
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// 经代理时只能检查代理的地址，webhook直接连接订阅方
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &webhookSender{client: &http.Client{
+		Transport: transport,
+		// 不跟随重定向，避免签名的payload被转发到其他地址
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// webhookDialControl 在DNS解析后检查连接的地址，拒绝回环、内网、链路本地和未指定地址，避免订阅地址被用来访问内部服务
+func webhookDialControl(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return errors.Wrapf(err, "data: parse webhook address %s", address)
+	}
+	addr := addrPort.Addr().Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsUnspecified() {
+		return fmt.Errorf("data: webhook address %s is not allowed", addr)
+	}
+	return nil
+}
+
+func (s *webhookSender) Send(ctx context.Context, url string, header http.Header, body []byte, timeout time.Duration) (*biz.WebhookResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "data: new webhook request")
+	}
+	req.Header = header
+	start := time.Now()
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return &biz.WebhookResponse{Duration: time.Since(start)}, errors.Wrap(err, "data: send webhook")
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, webhookDrainBodyMaxLen))
+	return &biz.WebhookResponse{Code: resp.StatusCode, Duration: time.Since(start)}, nil
+}
@@ -1,59 +1,68 @@
 package global
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"buf.build/go/protovalidate"
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/robfig/cron/v3"
 	"github.com/seanbit/kratos/template/internal/conf"
 	"github.com/seanbit/kratos/webkit/thirds/aws"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"gopkg.in/yaml.v3"
 )
 
-var (
-	cnf  *conf.Bootstrap
-	lock sync.Mutex
-)
+// cnf 当前生效的配置，热更新时整体替换，取得的配置不应修改
+var cnf atomic.Pointer[conf.Bootstrap]
 
 func SetConfig(c *conf.Bootstrap) {
-	lock.Lock()
-	defer lock.Unlock()
-	cnf = c
-	os.Setenv("ENV", cnf.Env.String())
+	cnf.Store(c)
+	os.Setenv("ENV", c.Env.String())
 }
 
 func GetConfig() *conf.Bootstrap {
-	lock.Lock()
-	defer lock.Unlock()
-
-	return cnf
+	return cnf.Load()
 }
 
 func InitConfig(confSrc, confPath, secretFile string) (clean func()) {
+	// 配置中的 ${vault:path#key}、${file:/path}、${awssm:name} 由resolver解析
+	resolver, err := NewSecretResolverFromEnv()
+	if err != nil {
+		panic(err)
+	}
 	// set local env
 	if secretFile != "" {
-		if err := LoadSecretFromFile(confPath, secretFile); err != nil {
+		if err := LoadSecretFromFile(confPath, secretFile, resolver); err != nil {
 			panic(fmt.Sprintf("load secret from file failed: %v", err))
 		}
 	}
 
-	var src config.Source
-	switch confSrc {
-	case "file":
-		src = file.NewSource(confPath)
-	case "aws-appconfig":
-		src = aws.NewConfigSource()
-	default:
-		panic("unknown config source")
+	src, err := newConfigSource(confSrc, confPath)
+	if err != nil {
+		panic(err)
 	}
 	// 加载配置
+	decoder := warnUnknownKeys(newEnvDecoder(resolver), secretFile)
 	c := config.New(
 		config.WithSource(src),
-		config.WithDecoder(envDecoder),
+		config.WithDecoder(decoder),
 	)
-	clean = func() { _ = c.Close() }
+	ctx, cancel := context.WithCancel(context.Background())
+	clean = func() {
+		cancel()
+		_ = c.Close()
+	}
 
 	if err := c.Load(); err != nil {
 		panic(err)
@@ -70,10 +79,96 @@ func InitConfig(confSrc, confPath, secretFile string) (clean func()) {
 	}
 
 	SetConfig(&bc)
+	// 配置源变化时热更新
+	watchConfig(c)
+	// 密钥变化时重新读取配置源并热更新
+	if resolver.TTL() > 0 {
+		go refreshSecrets(ctx, resolver, func() error {
+			if secretFile != "" {
+				if err := LoadSecretFromFile(confPath, secretFile, resolver); err != nil {
+					return err
+				}
+			}
+			next, err := decodeSource(src, decoder)
+			if err != nil {
+				return err
+			}
+			return ReloadConfig(next)
+		})
+	}
 
 	return
 }
 
+func newConfigSource(confSrc, confPath string) (config.Source, error) {
+	switch confSrc {
+	case "file":
+		return file.NewSource(confPath), nil
+	case "aws-appconfig":
+		return aws.NewConfigSource(), nil
+	default:
+		return nil, fmt.Errorf("unknown config source: %s", confSrc)
+	}
+}
+
+// refreshSecrets 每ttl重新读取已缓存的密钥，有值变化时调用reload
+func refreshSecrets(ctx context.Context, resolver *SecretResolver, reload func() error) {
+	ticker := time.NewTicker(resolver.TTL())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if !resolver.Refresh() {
+			continue
+		}
+		if err := reload(); err != nil {
+			log.Errorf("secrets changed but config not reloaded: %v", err)
+			continue
+		}
+		log.Infof("config reloaded after secrets changed")
+	}
+}
+
+// decodeSource 重新读取配置源并解码，合并方式与kratos config一致：后加载的key覆盖先加载的
+func decodeSource(src config.Source, decoder config.Decoder) (*conf.Bootstrap, error) {
+	kvs, err := src.Load()
+	if err != nil {
+		return nil, err
+	}
+	merged := make(map[string]interface{})
+	for _, kv := range kvs {
+		next := make(map[string]interface{})
+		if err := decoder(kv, next); err != nil {
+			return nil, err
+		}
+		mergeConfigMap(merged, next)
+	}
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	var bc conf.Bootstrap
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, &bc); err != nil {
+		return nil, err
+	}
+	return &bc, nil
+}
+
+func mergeConfigMap(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if sub, ok := v.(map[string]interface{}); ok {
+			if exist, ok := dst[k].(map[string]interface{}); ok {
+				mergeConfigMap(exist, sub)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
 // ConfigValidationError 配置验证错误
 type ConfigValidationError struct {
 	Field   string
@@ -85,88 +180,88 @@ func (e *ConfigValidationError) Error() string {
 }
 
 // ValidateConfig 验证配置完整性和有效性
-// 在服务启动前调用，确保必要配置项存在
+// 在服务启动前及热更新时调用，规则见conf.proto中的buf.validate注解
 func ValidateConfig(bc *conf.Bootstrap) error {
-	var errors []string
+	return joinConfigErrors(ConfigErrors(bc))
+}
 
-	// 验证服务名称
-	if bc.Name == "" {
-		errors = append(errors, "name: service name is required")
-	}
+// ConfigErrors 返回配置的所有错误：conf.proto中声明的规则由protovalidate执行，无法用CEL表达的规则（cron spec、时区）在此检查
+func ConfigErrors(bc *conf.Bootstrap) []*ConfigValidationError {
+	errs := protoConfigErrors(bc, "")
+	return append(errs, crontabConfigErrors(bc.GetCrontab())...)
+}
 
-	// 验证 Server 配置
-	if bc.Server == nil {
-		errors = append(errors, "server: server configuration is required")
-	} else {
-		if bc.Server.Http == nil || bc.Server.Http.Addr == "" {
-			errors = append(errors, "server.http.addr: HTTP server address is required")
-		}
-	}
+// ValidateCrontabConfig 验证crontab配置，配置热更新时使用
+func ValidateCrontabConfig(c *conf.Crontab) error {
+	errs := protoConfigErrors(c, "crontab.")
+	return joinConfigErrors(append(errs, crontabConfigErrors(c)...))
+}
 
-	// 验证 Data 配置
-	if bc.Data == nil {
-		errors = append(errors, "data: data configuration is required")
-	} else {
-		// 验证数据库配置
-		if bc.Data.Database == nil {
-			errors = append(errors, "data.database: database configuration is required")
-		} else {
-			if bc.Data.Database.Driver == "" {
-				errors = append(errors, "data.database.driver: database driver is required")
-			}
-		}
+// configValidator conf.proto的规则在首次使用时编译
+var configValidator = sync.OnceValue(func() protovalidate.Validator {
+	validator, err := protovalidate.New()
+	if err != nil {
+		panic(fmt.Sprintf("init config validator: %v", err))
+	}
+	return validator
+})
 
-		// 验证 Redis 配置
-		if bc.Data.Redis == nil {
-			errors = append(errors, "data.redis: redis configuration is required")
-		} else {
-			if bc.Data.Redis.Addr == "" {
-				errors = append(errors, "data.redis.addr: redis address is required")
-			}
+// protoConfigErrors 执行conf.proto中的buf.validate规则，Field为yaml中的字段路径
+func protoConfigErrors(msg proto.Message, prefix string) []*ConfigValidationError {
+	err := configValidator().Validate(msg)
+	if err == nil {
+		return nil
+	}
+	var verr *protovalidate.ValidationError
+	if !errors.As(err, &verr) {
+		return []*ConfigValidationError{{Field: strings.TrimSuffix(prefix, "."), Message: err.Error()}}
+	}
+	errs := make([]*ConfigValidationError, 0, len(verr.Violations))
+	for _, violation := range verr.Violations {
+		field := protovalidate.FieldPathString(violation.Proto.GetField())
+		if field == "" {
+			// 消息级规则没有字段路径，用规则id
+			field = violation.Proto.GetRuleId()
 		}
+		errs = append(errs, &ConfigValidationError{Field: prefix + field, Message: violation.Proto.GetMessage()})
 	}
+	return errs
+}
 
-	// 验证 Auth 配置
-	if bc.Auth == nil {
-		errors = append(errors, "auth: auth configuration is required")
-	} else {
-		if bc.Auth.JwtKey_25519 == "" {
-			errors = append(errors, "auth.jwt_key_25519: JWT key is required")
-		}
-		if bc.Auth.LoginExpires == nil || bc.Auth.LoginExpires.AsDuration() <= 0 {
-			errors = append(errors, "auth.login_expires: login expiration duration is required")
-		}
+func joinConfigErrors(errs []*ConfigValidationError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Field+": "+err.Message)
 	}
+	return fmt.Errorf("configuration errors:\n  - %s", joinStrings(messages, "\n  - "))
+}
 
-	// 验证 Alarm 配置（如果启用）
-	if bc.Alarm != nil && !bc.Alarm.DryRun {
-		if bc.Alarm.DefaultPlatform == "" {
-			errors = append(errors, "alarm.default_platform: default platform is required when alarm is enabled")
-		}
-		if len(bc.Alarm.WebHooks) == 0 {
-			errors = append(errors, "alarm.web_hooks: at least one webhook is required when alarm is enabled")
-		} else if bc.Alarm.DefaultPlatform != "" {
-			if _, ok := bc.Alarm.WebHooks[bc.Alarm.DefaultPlatform]; !ok {
-				errors = append(errors, fmt.Sprintf("alarm.web_hooks: webhook for default platform '%s' is not configured", bc.Alarm.DefaultPlatform))
-			}
+// cronSpecParser 与crontab包一致，spec包含秒
+var cronSpecParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+func crontabConfigErrors(c *conf.Crontab) []*ConfigValidationError {
+	var errs []*ConfigValidationError
+	if tz := c.GetTimezone(); tz != "" {
+		if _, err := time.LoadLocation(tz); err != nil {
+			errs = append(errs, &ConfigValidationError{Field: "crontab.timezone", Message: fmt.Sprintf("unknown timezone '%s'", tz)})
 		}
 	}
-
-	// 验证 Tracing 配置（如果配置了 host）
-	if bc.Tracing != nil && bc.Tracing.Host != "" {
-		if bc.Tracing.Port <= 0 {
-			errors = append(errors, "tracing.port: tracing port must be positive when host is configured")
+	for name, job := range c.GetJobs() {
+		if spec := job.GetSpec(); spec != "" {
+			if _, err := cronSpecParser.Parse(spec); err != nil {
+				errs = append(errs, &ConfigValidationError{Field: fmt.Sprintf("crontab.jobs[%q].spec", name), Message: fmt.Sprintf("invalid spec '%s': %v", spec, err)})
+			}
 		}
-		if bc.Tracing.Type == "" {
-			errors = append(errors, "tracing.type: tracing type is required when host is configured")
+		if tz := job.GetTimezone(); tz != "" {
+			if _, err := time.LoadLocation(tz); err != nil {
+				errs = append(errs, &ConfigValidationError{Field: fmt.Sprintf("crontab.jobs[%q].timezone", name), Message: fmt.Sprintf("unknown timezone '%s'", tz)})
+			}
 		}
 	}
-
-	if len(errors) > 0 {
-		return fmt.Errorf("configuration errors:\n  - %s", joinStrings(errors, "\n  - "))
-	}
-
-	return nil
+	return errs
 }
 
 // joinStrings 连接字符串切片
@@ -181,33 +276,40 @@ func joinStrings(strs []string, sep string) string {
 	return result
 }
 
-func envDecoder(kv *config.KeyValue, v map[string]interface{}) error {
-	// 用 yaml.v3 解码
-	if err := yaml.Unmarshal(kv.Value, &v); err != nil {
-		return err
-	}
-	// 遍历替换所有字符串里的 ${ENV_VAR}
-	var replaceEnv func(interface{}) interface{}
-	replaceEnv = func(val interface{}) interface{} {
-		switch vv := val.(type) {
-		case string:
-			return os.ExpandEnv(vv)
-		case map[string]interface{}:
-			for k, v2 := range vv {
-				vv[k] = replaceEnv(v2)
-			}
-			return vv
-		case []interface{}:
-			for i, v2 := range vv {
-				vv[i] = replaceEnv(v2)
+// newEnvDecoder yaml解码后展开所有字符串里的 ${ENV_VAR} 与 ${scheme:ref} 密钥引用，密钥读取失败时返回error
+func newEnvDecoder(resolver *SecretResolver) config.Decoder {
+	return func(kv *config.KeyValue, v map[string]interface{}) error {
+		// 用 yaml.v3 解码
+		if err := yaml.Unmarshal(kv.Value, &v); err != nil {
+			return err
+		}
+		var errs []error
+		var replaceEnv func(interface{}) interface{}
+		replaceEnv = func(val interface{}) interface{} {
+			switch vv := val.(type) {
+			case string:
+				expanded, err := resolver.Expand(vv)
+				if err != nil {
+					errs = append(errs, err)
+				}
+				return expanded
+			case map[string]interface{}:
+				for k, v2 := range vv {
+					vv[k] = replaceEnv(v2)
+				}
+				return vv
+			case []interface{}:
+				for i, v2 := range vv {
+					vv[i] = replaceEnv(v2)
+				}
+				return vv
+			default:
+				return val
 			}
-			return vv
-		default:
-			return val
 		}
+		for k, v2 := range v {
+			v[k] = replaceEnv(v2)
+		}
+		return errors.Join(errs...)
 	}
-	for k, v2 := range v {
-		v[k] = replaceEnv(v2)
-	}
-	return nil
 }
@@ -0,0 +1,159 @@
+package global
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ConfigCheckResult 配置检查结果
+type ConfigCheckResult struct {
+	// Errors 解析或验证失败的字段，服务无法以此配置启动
+	Errors []*ConfigValidationError
+	// UnknownKeys conf.proto中不存在的yaml字段，格式为 文件名:字段路径，加载时会被忽略
+	UnknownKeys []string
+}
+
+// CheckConfig 读取配置目录（或文件）并返回所有错误与未知字段，不监听变化也不修改当前配置
+// secretFile不为空时先加载密钥文件，该文件不参与未知字段检查
+func CheckConfig(confPath, secretFile string) (*ConfigCheckResult, error) {
+	resolver, err := NewSecretResolverFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if secretFile != "" {
+		if err := LoadSecretFromFile(confPath, secretFile, resolver); err != nil {
+			return nil, fmt.Errorf("load secret from file failed: %w", err)
+		}
+	}
+	kvs, err := file.NewSource(confPath).Load()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ConfigCheckResult{}
+	decoder := newEnvDecoder(resolver)
+	merged := make(map[string]interface{})
+	for _, kv := range kvs {
+		v := make(map[string]interface{})
+		if err := decoder(kv, v); err != nil {
+			result.Errors = append(result.Errors, &ConfigValidationError{Field: kv.Key, Message: err.Error()})
+			continue
+		}
+		if !isSecretFile(kv, secretFile) {
+			for _, key := range UnknownConfigKeys(v) {
+				result.UnknownKeys = append(result.UnknownKeys, kv.Key+":"+key)
+			}
+		}
+		mergeConfigMap(merged, v)
+	}
+	if len(result.Errors) > 0 {
+		return result, nil
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	var bc conf.Bootstrap
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, &bc); err != nil {
+		// 类型错误时无法继续验证，如duration写成"168h"
+		result.Errors = append(result.Errors, &ConfigValidationError{Field: filepath.Base(confPath), Message: err.Error()})
+		return result, nil
+	}
+	result.Errors = ConfigErrors(&bc)
+	return result, nil
+}
+
+// UnknownConfigKeys 返回v中conf.Bootstrap不存在的字段路径，字段名按proto名或json名匹配
+func UnknownConfigKeys(v map[string]interface{}) []string {
+	return unknownConfigKeys((&conf.Bootstrap{}).ProtoReflect().Descriptor(), v, "")
+}
+
+func unknownConfigKeys(md protoreflect.MessageDescriptor, v map[string]interface{}, prefix string) []string {
+	var unknown []string
+	for _, key := range sortedKeys(v) {
+		path := prefix + key
+		fd := md.Fields().ByName(protoreflect.Name(key))
+		if fd == nil {
+			fd = md.Fields().ByJSONName(key)
+		}
+		if fd == nil {
+			unknown = append(unknown, path)
+			continue
+		}
+		switch {
+		case fd.IsMap():
+			values, _ := v[key].(map[string]interface{})
+			if !isConfigMessage(fd.MapValue().Message()) {
+				continue
+			}
+			for _, name := range sortedKeys(values) {
+				if sub, ok := values[name].(map[string]interface{}); ok {
+					unknown = append(unknown, unknownConfigKeys(fd.MapValue().Message(), sub, fmt.Sprintf("%s.%s.", path, name))...)
+				}
+			}
+		case isConfigMessage(fd.Message()) && fd.IsList():
+			items, _ := v[key].([]interface{})
+			for i, item := range items {
+				if sub, ok := item.(map[string]interface{}); ok {
+					unknown = append(unknown, unknownConfigKeys(fd.Message(), sub, fmt.Sprintf("%s[%d].", path, i))...)
+				}
+			}
+		case isConfigMessage(fd.Message()):
+			if sub, ok := v[key].(map[string]interface{}); ok {
+				unknown = append(unknown, unknownConfigKeys(fd.Message(), sub, path+".")...)
+			}
+		}
+	}
+	return unknown
+}
+
+// isConfigMessage 是否为需要继续检查子字段的消息，Duration等well-known类型在yaml中是标量
+func isConfigMessage(md protoreflect.MessageDescriptor) bool {
+	return md != nil && !strings.HasPrefix(string(md.FullName()), "google.protobuf.")
+}
+
+func sortedKeys(v map[string]interface{}) []string {
+	keys := make([]string, 0, len(v))
+	for key := range v {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// isSecretFile 配置目录中的密钥文件也会被配置源读取，其中是环境变量而非配置字段
+func isSecretFile(kv *config.KeyValue, secretFile string) bool {
+	if secretFile == "" {
+		return false
+	}
+	if !strings.HasSuffix(secretFile, ".yaml") {
+		secretFile += ".yaml"
+	}
+	return kv.Key == filepath.Base(secretFile)
+}
+
+// warnUnknownKeys 解码后对conf.proto中不存在的字段打印警告，这些字段加载时会被忽略
+func warnUnknownKeys(decoder config.Decoder, secretFile string) config.Decoder {
+	return func(kv *config.KeyValue, v map[string]interface{}) error {
+		if err := decoder(kv, v); err != nil {
+			return err
+		}
+		if !isSecretFile(kv, secretFile) {
+			for _, key := range UnknownConfigKeys(v) {
+				log.Warnf("config %s: unknown key %s is ignored", kv.Key, key)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,191 @@
+package global
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/seanbit/kratos/template/internal/conf"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RedactedValue 敏感字段脱敏后的值，未配置的敏感字段保持为空
+const RedactedValue = "******"
+
+// LoadConfig 读取配置源并解码，不验证、不监听变化也不修改当前配置，用于命令行查看配置
+// secretFile中的变量会设置到当前进程的环境变量
+func LoadConfig(confSrc, confPath, secretFile string) (*conf.Bootstrap, error) {
+	resolver, err := NewSecretResolverFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if secretFile != "" {
+		if err := LoadSecretFromFile(confPath, secretFile, resolver); err != nil {
+			return nil, fmt.Errorf("load secret from file failed: %w", err)
+		}
+	}
+	src, err := newConfigSource(confSrc, confPath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeSource(src, newEnvDecoder(resolver))
+}
+
+// RedactConfig 返回脱敏后的副本，conf.proto中标记(sensitive)的字段替换为RedactedValue
+func RedactConfig(bc *conf.Bootstrap) *conf.Bootstrap {
+	redacted := proto.Clone(bc).(*conf.Bootstrap)
+	redactMessage(redacted.ProtoReflect())
+	return redacted
+}
+
+func redactMessage(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case isSensitive(fd):
+			redactField(m, fd, v)
+		case fd.IsMap():
+			if isConfigMessage(fd.MapValue().Message()) {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redactMessage(mv.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if isConfigMessage(fd.Message()) {
+				for i := 0; i < v.List().Len(); i++ {
+					redactMessage(v.List().Get(i).Message())
+				}
+			}
+		case isConfigMessage(fd.Message()):
+			redactMessage(v.Message())
+		}
+		return true
+	})
+}
+
+// redactField 字符串替换为RedactedValue，其他类型的敏感字段直接清空
+func redactField(m protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	redacted := protoreflect.ValueOfString(RedactedValue)
+	switch {
+	case fd.IsMap() && fd.MapValue().Kind() == protoreflect.StringKind:
+		v.Map().Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+			v.Map().Set(k, redacted)
+			return true
+		})
+	case fd.IsList() && fd.Kind() == protoreflect.StringKind:
+		for i := 0; i < v.List().Len(); i++ {
+			v.List().Set(i, redacted)
+		}
+	case !fd.IsMap() && !fd.IsList() && fd.Kind() == protoreflect.StringKind:
+		m.Set(fd, redacted)
+	default:
+		m.Clear(fd)
+	}
+}
+
+func isSensitive(fd protoreflect.FieldDescriptor) bool {
+	return proto.GetExtension(fd.Options(), conf.E_Sensitive).(bool)
+}
+
+// ConfigDiff 两份配置中不同的字段，敏感字段的值已脱敏
+type ConfigDiff struct {
+	// Path 字段路径，如 alarm.web_hooks["lark"]
+	Path string
+	// Old 为空表示old中未配置或为空字符串
+	Old string
+	// New 为空表示next中未配置或为空字符串
+	New string
+}
+
+// DiffConfig 按字段路径比较两份配置，敏感字段只报告是否变化
+func DiffConfig(old, next *conf.Bootstrap) []*ConfigDiff {
+	oldValues, nextValues := flattenConfig(old), flattenConfig(next)
+	paths := make([]string, 0, len(oldValues)+len(nextValues))
+	for path := range oldValues {
+		paths = append(paths, path)
+	}
+	for path := range nextValues {
+		if _, ok := oldValues[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	slices.Sort(paths)
+
+	var diffs []*ConfigDiff
+	for _, path := range paths {
+		o, n := oldValues[path], nextValues[path]
+		if o.value == n.value {
+			continue
+		}
+		diffs = append(diffs, &ConfigDiff{Path: path, Old: o.String(), New: n.String()})
+	}
+	return diffs
+}
+
+type configValue struct {
+	value     string
+	sensitive bool
+}
+
+func (v configValue) String() string {
+	if v.sensitive && v.value != "" {
+		return RedactedValue
+	}
+	return v.value
+}
+
+// flattenConfig 展开为 字段路径 -> 值，未配置的标量按零值输出，未配置的消息与map、列表元素不输出
+func flattenConfig(bc *conf.Bootstrap) map[string]configValue {
+	values := make(map[string]configValue)
+	flattenMessage(bc.ProtoReflect(), "", false, values)
+	return values
+}
+
+func flattenMessage(m protoreflect.Message, prefix string, sensitive bool, values map[string]configValue) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Message() != nil && !m.Has(fd) {
+			continue
+		}
+		v := m.Get(fd)
+		path := prefix + string(fd.Name())
+		fieldSensitive := sensitive || isSensitive(fd)
+		switch {
+		case fd.IsMap():
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				flattenValue(fd.MapValue(), mv, fmt.Sprintf("%s[%q]", path, k.String()), fieldSensitive, values)
+				return true
+			})
+		case fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				flattenValue(fd, v.List().Get(i), fmt.Sprintf("%s[%d]", path, i), fieldSensitive, values)
+			}
+		default:
+			flattenValue(fd, v, path, fieldSensitive, values)
+		}
+	}
+}
+
+func flattenValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, path string, sensitive bool, values map[string]configValue) {
+	var value string
+	switch {
+	case isConfigMessage(fd.Message()):
+		flattenMessage(v.Message(), path+".", sensitive, values)
+		return
+	case fd.Message() != nil:
+		// Duration等well-known类型按yaml中的写法输出，如"3600s"
+		encoded, _ := protojson.Marshal(v.Message().Interface())
+		value = strings.Trim(string(encoded), `"`)
+	case fd.Kind() == protoreflect.EnumKind:
+		value = fmt.Sprint(v.Enum())
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			value = string(ev.Name())
+		}
+	default:
+		value = v.String()
+	}
+	values[path] = configValue{value: value, sensitive: sensitive}
+}
@@ -1,5 +1,12 @@
 package global
 
+import "os"
+
+var (
+	version     string
+	hostname, _ = os.Hostname()
+)
+
 func GetEnv() string {
 	return GetConfig().Env.String()
 }
@@ -7,3 +14,16 @@ func GetEnv() string {
 func GetServiceName() string {
 	return GetConfig().Name
 }
+
+// SetVersion 设置服务版本号（编译时注入的main.Version）
+func SetVersion(v string) {
+	version = v
+}
+
+func GetVersion() string {
+	return version
+}
+
+func GetHost() string {
+	return hostname
+}
@@ -0,0 +1,99 @@
+package global
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"google.golang.org/protobuf/proto"
+)
+
+// ConfigSubscriber 配置热更新时回调，old为更新前的配置；返回error时整体回滚
+// 回滚时已应用的订阅者以相反的参数(next, old)再次回调
+type ConfigSubscriber func(old, next *conf.Bootstrap) error
+
+type configSubscriber struct {
+	name string
+	fn   ConfigSubscriber
+}
+
+var (
+	subscribers   []*configSubscriber
+	subscribeLock sync.Mutex
+	// reloadLock 串行执行热更新
+	reloadLock sync.Mutex
+)
+
+// SubscribeConfig 订阅配置热更新，按订阅顺序回调；返回的函数取消订阅
+func SubscribeConfig(name string, fn ConfigSubscriber) (unsubscribe func()) {
+	subscriber := &configSubscriber{name: name, fn: fn}
+	subscribeLock.Lock()
+	subscribers = append(subscribers, subscriber)
+	subscribeLock.Unlock()
+	return func() {
+		subscribeLock.Lock()
+		defer subscribeLock.Unlock()
+		for i, s := range subscribers {
+			if s == subscriber {
+				subscribers = append(subscribers[:i:i], subscribers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// ReloadConfig 验证并应用新的配置：验证失败时保持当前配置；
+// 验证通过后原子替换全局配置并依次通知订阅者，任一订阅者失败时恢复全局配置并回滚已应用的订阅者
+func ReloadConfig(next *conf.Bootstrap) error {
+	reloadLock.Lock()
+	defer reloadLock.Unlock()
+
+	old := GetConfig()
+	if proto.Equal(old, next) {
+		return nil
+	}
+	if err := ValidateConfig(next); err != nil {
+		return err
+	}
+
+	subscribeLock.Lock()
+	notify := append([]*configSubscriber(nil), subscribers...)
+	subscribeLock.Unlock()
+
+	SetConfig(next)
+	for i, subscriber := range notify {
+		if err := subscriber.fn(old, next); err != nil {
+			SetConfig(old)
+			for j := i - 1; j >= 0; j-- {
+				if rbErr := notify[j].fn(next, old); rbErr != nil {
+					log.Errorf("config rollback %s failed: %v", notify[j].name, rbErr)
+				}
+			}
+			return fmt.Errorf("apply config to %s: %w", subscriber.name, err)
+		}
+	}
+	return nil
+}
+
+// watchConfig 监听配置源中已有的顶层key，任一变化时重新读取完整配置并热更新
+func watchConfig(c config.Config) {
+	observer := func(key string, _ config.Value) {
+		var next conf.Bootstrap
+		if err := c.Scan(&next); err != nil {
+			log.Errorf("config %s changed but scan failed, keep current config: %v", key, err)
+			return
+		}
+		if err := ReloadConfig(&next); err != nil {
+			log.Errorf("config %s changed but not applied, keep current config: %v", key, err)
+			return
+		}
+		log.Infof("config reloaded after %s changed", key)
+	}
+	fields := (&conf.Bootstrap{}).ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		// 启动时不存在的key无法监听，新增后需重启生效
+		_ = c.Watch(string(fields.Get(i).Name()), observer)
+	}
+}
@@ -1,13 +1,13 @@
 package global
 
 import (
-	"bufio"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
 )
 
 // 自定义错误类型
@@ -109,47 +109,56 @@ func ReadSecretYaml(path, fileName string) ([]byte, error) {
 	return reader.ReadSecret(path, fileName)
 }
 
-func LoadSecretFromFile(flagconf, fileName string) error {
-	reader := NewSecretReader()
-	secretPath, err := reader.GetSecretFilePath(flagconf, fileName)
+// LoadSecretFromFile 读取secret文件中的 KEY: value 并设置为环境变量
+// 文件按YAML解析，值须为标量，支持引号、冒号与多行字符串；值中的 ${scheme:ref} 由resolver解析
+func LoadSecretFromFile(flagconf, fileName string, resolver *SecretResolver) error {
+	content, err := ReadSecretYaml(flagconf, fileName)
 	if err != nil {
 		return err
 	}
-
-	file, err := os.Open(secretPath)
+	secrets, err := ParseSecretYaml(content)
 	if err != nil {
-		return errors.Wrap(err, "Open file error")
+		return err
 	}
-	defer file.Close() // Ensure the file is closed when the function exits
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-		if strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimSpace(line), "#") {
-			continue
+	for key, value := range secrets {
+		if resolver != nil {
+			if value, err = resolver.Expand(value); err != nil {
+				return errors.Wrapf(err, "secret %s", key)
+			}
 		}
-
-		parts := strings.SplitN(line, ": ", 2)
-		if len(parts) != 2 {
-			return errors.Wrapf(err, "Skipping invalid line: %s", line)
-		}
-
-		key := strings.TrimSpace(parts[0])
-		value := strings.TrimSpace(parts[1])
-		value = strings.TrimLeft(value, "\"")
-		value = strings.TrimRight(value, "\"")
-
-		err = os.Setenv(key, value)
-		if err != nil {
+		if err := os.Setenv(key, value); err != nil {
 			return errors.Wrap(err, "Setenv error")
 		}
 	}
 
-	// Check for any errors encountered during scanning
-	if err = scanner.Err(); err != nil {
-		return errors.Wrap(err, "Error reading file")
-	}
-
 	fmt.Println("All local environment variables from the file have been set")
 	return nil
 }
+
+// ParseSecretYaml 解析secret文件，顶层须为 KEY: 标量 的映射，空值为空字符串
+func ParseSecretYaml(content []byte) (map[string]string, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return nil, errors.Wrap(err, "parse secret yaml")
+	}
+	secrets := make(map[string]string)
+	if len(root.Content) == 0 {
+		return secrets, nil
+	}
+	mapping := root.Content[0]
+	if mapping.Kind != yaml.MappingNode {
+		return nil, errors.New("secret yaml must be a mapping of KEY: value")
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key, value := mapping.Content[i], mapping.Content[i+1]
+		if value.Kind != yaml.ScalarNode {
+			return nil, errors.Errorf("secret %s at line %d: value must be a scalar", key.Value, value.Line)
+		}
+		if value.Tag == "!!null" {
+			secrets[key.Value] = ""
+			continue
+		}
+		secrets[key.Value] = value.Value
+	}
+	return secrets, nil
+}
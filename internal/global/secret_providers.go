@@ -0,0 +1,204 @@
+package global
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+)
+
+// secretHTTPTimeout 读取远端密钥的请求超时
+const secretHTTPTimeout = 5 * time.Second
+
+// fileSecretProvider ${file:/run/secrets/x}，读取文件内容，去掉末尾换行
+type fileSecretProvider struct{}
+
+func NewFileSecretProvider() SecretProvider {
+	return fileSecretProvider{}
+}
+
+func (fileSecretProvider) Resolve(ctx context.Context, ref string) (string, error) {
+	content, err := os.ReadFile(ref)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+// vaultSecretProvider ${vault:mount/path#key}，读取KV v2引擎中的secret
+type vaultSecretProvider struct {
+	addr      string
+	token     string
+	namespace string
+	client    *http.Client
+}
+
+func NewVaultSecretProvider(addr, token, namespace string) SecretProvider {
+	return &vaultSecretProvider{
+		addr:      strings.TrimRight(addr, "/"),
+		token:     token,
+		namespace: namespace,
+		client:    &http.Client{Timeout: secretHTTPTimeout},
+	}
+}
+
+func (p *vaultSecretProvider) Resolve(ctx context.Context, ref string) (string, error) {
+	if p.addr == "" {
+		return "", fmt.Errorf("%s is not set", EnvVaultAddr)
+	}
+	path, key, ok := strings.Cut(ref, "#")
+	mount, secret, _ := strings.Cut(strings.Trim(path, "/"), "/")
+	if !ok || key == "" || mount == "" || secret == "" {
+		return "", fmt.Errorf("invalid vault reference %q, expected mount/path#key", ref)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.addr+"/v1/"+mount+"/data/"+secret, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+	if p.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.namespace)
+	}
+	var body struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := doSecretRequest(p.client, req, &body); err != nil {
+		return "", err
+	}
+	value, ok := body.Data.Data[key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in vault secret %s", key, path)
+	}
+	return secretValueString(value), nil
+}
+
+// awsSecretsManagerProvider ${awssm:name} 或 ${awssm:name#key}，后者从JSON格式的SecretString中取key
+type awsSecretsManagerProvider struct {
+	endpoint    string
+	region      string
+	credentials aws.CredentialsProvider
+	client      *http.Client
+	signer      *v4.Signer
+	// 未指定region或credentials时首次使用从默认配置链加载
+	mu     sync.Mutex
+	loaded bool
+}
+
+// NewAWSSecretsManagerProvider endpoint为空时按region生成，region与credentials为空时从AWS默认配置链加载
+func NewAWSSecretsManagerProvider(endpoint, region string, credentials aws.CredentialsProvider) SecretProvider {
+	return &awsSecretsManagerProvider{
+		endpoint:    endpoint,
+		region:      region,
+		credentials: credentials,
+		client:      &http.Client{Timeout: secretHTTPTimeout},
+		signer:      v4.NewSigner(),
+		loaded:      region != "" && credentials != nil,
+	}
+}
+
+func (p *awsSecretsManagerProvider) load(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.loaded {
+		return nil
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("load aws config: %w", err)
+	}
+	if p.region == "" {
+		p.region = cfg.Region
+	}
+	if p.credentials == nil {
+		p.credentials = cfg.Credentials
+	}
+	if p.region == "" || p.credentials == nil {
+		return fmt.Errorf("aws region or credentials is not configured")
+	}
+	p.loaded = true
+	return nil
+}
+
+func (p *awsSecretsManagerProvider) Resolve(ctx context.Context, ref string) (string, error) {
+	if err := p.load(ctx); err != nil {
+		return "", err
+	}
+	name, key, hasKey := strings.Cut(ref, "#")
+	payload, err := json.Marshal(map[string]string{"SecretId": name})
+	if err != nil {
+		return "", err
+	}
+	endpoint := p.endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://secretsmanager.%s.amazonaws.com", p.region)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	creds, err := p.credentials.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieve aws credentials: %w", err)
+	}
+	hash := sha256.Sum256(payload)
+	if err := p.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), "secretsmanager", p.region, time.Now()); err != nil {
+		return "", fmt.Errorf("sign request: %w", err)
+	}
+	var body struct {
+		SecretString string `json:"SecretString"`
+	}
+	if err := doSecretRequest(p.client, req, &body); err != nil {
+		return "", err
+	}
+	if !hasKey {
+		return body.SecretString, nil
+	}
+	var values map[string]any
+	if err := json.Unmarshal([]byte(body.SecretString), &values); err != nil {
+		return "", fmt.Errorf("secret %s is not a JSON object: %w", name, err)
+	}
+	value, ok := values[key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in secret %s", key, name)
+	}
+	return secretValueString(value), nil
+}
+
+// doSecretRequest 发送请求并解析JSON响应，非2xx时返回错误，错误中不包含响应内容以免泄露密钥
+func doSecretRequest(client *http.Client, req *http.Request, v any) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return fmt.Errorf("%s %s%s: unexpected status %d", req.Method, req.URL.Host, req.URL.Path, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// secretValueString JSON中的数字、布尔值等按原样转为字符串
+func secretValueString(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	encoded, _ := json.Marshal(value)
+	return string(encoded)
+}
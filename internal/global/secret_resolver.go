@@ -0,0 +1,174 @@
+package global
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 密钥解析相关的环境变量，解析发生在配置加载之前，只能通过环境变量设置
+const (
+	// EnvSecretCacheTTL 密钥缓存时长，到期后后台刷新，值变化时热更新配置；为0时不刷新，默认5m
+	EnvSecretCacheTTL = "SECRET_CACHE_TTL"
+	// EnvVaultAddr Vault地址，如 https://vault.example.com:8200
+	EnvVaultAddr = "VAULT_ADDR"
+	// EnvVaultToken Vault token
+	EnvVaultToken = "VAULT_TOKEN"
+	// EnvVaultNamespace Vault企业版的namespace，可选
+	EnvVaultNamespace = "VAULT_NAMESPACE"
+	// EnvSecretsManagerEndpoint AWS Secrets Manager的endpoint，默认按region生成
+	EnvSecretsManagerEndpoint = "AWS_ENDPOINT_URL_SECRETS_MANAGER"
+)
+
+const (
+	defaultSecretCacheTTL = 5 * time.Minute
+	// secretResolveTimeout 单次读取密钥的超时
+	secretResolveTimeout = 10 * time.Second
+)
+
+// SecretProvider 按引用读取密钥，引用为 ${scheme:ref} 中scheme之后的部分
+type SecretProvider interface {
+	Resolve(ctx context.Context, ref string) (string, error)
+}
+
+// SecretProviderFunc 函数形式的SecretProvider
+type SecretProviderFunc func(ctx context.Context, ref string) (string, error)
+
+func (f SecretProviderFunc) Resolve(ctx context.Context, ref string) (string, error) {
+	return f(ctx, ref)
+}
+
+type cachedSecret struct {
+	value     string
+	expiresAt time.Time
+}
+
+// SecretResolver 解析配置中的 ${scheme:ref} 密钥引用，其余 ${NAME} 按环境变量展开
+// 读取结果缓存ttl，ttl为0时永久缓存；缓存到期后读取失败时沿用旧值
+type SecretResolver struct {
+	ttl       time.Duration
+	providers map[string]SecretProvider
+	mu        sync.Mutex
+	cache     map[string]cachedSecret
+}
+
+func NewSecretResolver(ttl time.Duration) *SecretResolver {
+	return &SecretResolver{
+		ttl:       ttl,
+		providers: make(map[string]SecretProvider),
+		cache:     make(map[string]cachedSecret),
+	}
+}
+
+// NewSecretResolverFromEnv 按环境变量创建，注册vault/file/awssm
+func NewSecretResolverFromEnv() (*SecretResolver, error) {
+	ttl := defaultSecretCacheTTL
+	if value := os.Getenv(EnvSecretCacheTTL); value != "" {
+		d, err := time.ParseDuration(value)
+		if err != nil || d < 0 {
+			return nil, fmt.Errorf("invalid %s %q", EnvSecretCacheTTL, value)
+		}
+		ttl = d
+	}
+	resolver := NewSecretResolver(ttl)
+	resolver.Register("vault", NewVaultSecretProvider(os.Getenv(EnvVaultAddr), os.Getenv(EnvVaultToken), os.Getenv(EnvVaultNamespace)))
+	resolver.Register("file", NewFileSecretProvider())
+	resolver.Register("awssm", NewAWSSecretsManagerProvider(os.Getenv(EnvSecretsManagerEndpoint), "", nil))
+	return resolver, nil
+}
+
+// Register 注册scheme对应的provider
+func (r *SecretResolver) Register(scheme string, provider SecretProvider) {
+	r.providers[scheme] = provider
+}
+
+// TTL 缓存时长
+func (r *SecretResolver) TTL() time.Duration {
+	return r.ttl
+}
+
+// Expand 展开s中的 ${scheme:ref} 与 ${NAME}，任一密钥读取失败时返回error
+func (r *SecretResolver) Expand(s string) (string, error) {
+	if !strings.Contains(s, "$") {
+		return s, nil
+	}
+	var errs []error
+	expanded := os.Expand(s, func(name string) string {
+		scheme, ref, ok := strings.Cut(name, ":")
+		if _, registered := r.providers[scheme]; !ok || !registered {
+			return os.Getenv(name)
+		}
+		value, err := r.resolve(scheme, ref)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return value
+	})
+	return expanded, errors.Join(errs...)
+}
+
+func (r *SecretResolver) resolve(scheme, ref string) (string, error) {
+	key := scheme + ":" + ref
+	r.mu.Lock()
+	cached, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok && (r.ttl == 0 || time.Now().Before(cached.expiresAt)) {
+		return cached.value, nil
+	}
+	value, err := r.fetch(scheme, ref)
+	if err != nil {
+		if ok {
+			log.Warnf("refresh secret %s failed, use cached value: %v", key, err)
+			return cached.value, nil
+		}
+		return "", err
+	}
+	r.store(key, value)
+	return value, nil
+}
+
+func (r *SecretResolver) fetch(scheme, ref string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), secretResolveTimeout)
+	defer cancel()
+	value, err := r.providers[scheme].Resolve(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("resolve secret %s:%s: %w", scheme, ref, err)
+	}
+	return value, nil
+}
+
+func (r *SecretResolver) store(key, value string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache[key] = cachedSecret{value: value, expiresAt: time.Now().Add(r.ttl)}
+}
+
+// Refresh 重新读取所有已缓存的密钥，返回是否有值变化；读取失败的沿用旧值
+func (r *SecretResolver) Refresh() (changed bool) {
+	r.mu.Lock()
+	keys := make(map[string]string, len(r.cache))
+	for key, cached := range r.cache {
+		keys[key] = cached.value
+	}
+	r.mu.Unlock()
+	for key, old := range keys {
+		scheme, ref, _ := strings.Cut(key, ":")
+		value, err := r.fetch(scheme, ref)
+		if err != nil {
+			log.Warnf("refresh secret %s failed, keep cached value: %v", key, err)
+			continue
+		}
+		r.store(key, value)
+		if value != old {
+			log.Infof("secret %s changed", key)
+			changed = true
+		}
+	}
+	return changed
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/global"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newBootstrap(level conf.LogLevel) *conf.Bootstrap {
+	return &conf.Bootstrap{
+		Name:     "test",
+		LogLevel: level,
+		Server:   &conf.Server{Http: &conf.Server_HTTP{Addr: ":8000"}},
+		Data: &conf.Data{
+			Database: &conf.Data_Database{Driver: "postgres"},
+			Redis:    &conf.Data_Redis{Addr: "127.0.0.1:6379"},
+		},
+		Auth:  &conf.Auth{JwtKey_25519: "key", LoginExpires: durationpb.New(time.Hour)},
+		Alarm: &conf.Alarm{DryRun: true},
+	}
+}
+
+func TestReloadConfig(t *testing.T) {
+	global.SetConfig(newBootstrap(conf.LogLevel_Info))
+
+	var levels []conf.LogLevel
+	unsubscribe := global.SubscribeConfig("log_level", func(old, next *conf.Bootstrap) error {
+		levels = append(levels, next.LogLevel)
+		return nil
+	})
+	defer unsubscribe()
+
+	if err := global.ReloadConfig(newBootstrap(conf.LogLevel_Debug)); err != nil {
+		t.Fatal(err)
+	}
+	if global.GetConfig().LogLevel != conf.LogLevel_Debug || len(levels) != 1 {
+		t.Errorf("config not applied: %s, notified %v", global.GetConfig().LogLevel, levels)
+	}
+
+	// 未变化时不通知
+	if err := global.ReloadConfig(newBootstrap(conf.LogLevel_Debug)); err != nil || len(levels) != 1 {
+		t.Errorf("unchanged config should not notify: %v %v", err, levels)
+	}
+
+	// 验证失败时保持当前配置
+	invalid := newBootstrap(conf.LogLevel_Warn)
+	invalid.Server.Http.Addr = ""
+	if err := global.ReloadConfig(invalid); err == nil {
+		t.Error("expected validation error")
+	}
+	if global.GetConfig().LogLevel != conf.LogLevel_Debug || len(levels) != 1 {
+		t.Error("invalid config should not be applied")
+	}
+}
+
+func TestReloadConfig_Rollback(t *testing.T) {
+	current := newBootstrap(conf.LogLevel_Info)
+	global.SetConfig(current)
+
+	var applied []conf.LogLevel
+	unsubscribe := global.SubscribeConfig("first", func(old, next *conf.Bootstrap) error {
+		applied = append(applied, next.LogLevel)
+		return nil
+	})
+	defer unsubscribe()
+	unsubscribeFailing := global.SubscribeConfig("failing", func(old, next *conf.Bootstrap) error {
+		return errors.New("boom")
+	})
+
+	if err := global.ReloadConfig(newBootstrap(conf.LogLevel_Error)); err == nil {
+		t.Fatal("expected subscriber error")
+	}
+	// 先应用新配置，失败后以旧配置回滚
+	if len(applied) != 2 || applied[0] != conf.LogLevel_Error || applied[1] != conf.LogLevel_Info {
+		t.Errorf("unexpected apply sequence: %v", applied)
+	}
+	if !proto.Equal(global.GetConfig(), current) {
+		t.Error("global config should be rolled back")
+	}
+
+	// 取消订阅后不再回调
+	unsubscribeFailing()
+	if err := global.ReloadConfig(newBootstrap(conf.LogLevel_Error)); err != nil {
+		t.Fatal(err)
+	}
+	if global.GetConfig().LogLevel != conf.LogLevel_Error {
+		t.Error("config should be applied after unsubscribe")
+	}
+}
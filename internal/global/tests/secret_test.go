@@ -0,0 +1,154 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/seanbit/kratos/template/internal/global"
+)
+
+func TestParseSecretYaml(t *testing.T) {
+	secrets, err := global.ParseSecretYaml([]byte(`
+# comment
+PG_DSN: "host=db port=5432 password=p:w#d"
+JWT_KEY: 'quoted "value"'
+EMPTY:
+PRIVATE_KEY: |
+  line1
+  line2
+PORT: 6379
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"PG_DSN":      "host=db port=5432 password=p:w#d",
+		"JWT_KEY":     `quoted "value"`,
+		"EMPTY":       "",
+		"PRIVATE_KEY": "line1\nline2\n",
+		"PORT":        "6379",
+	}
+	for key, value := range expected {
+		if secrets[key] != value {
+			t.Errorf("%s: expected %q, got %q", key, value, secrets[key])
+		}
+	}
+	if _, err := global.ParseSecretYaml([]byte("NESTED:\n  a: b\n")); err == nil {
+		t.Error("expected error for nested value")
+	}
+}
+
+func TestSecretResolver_Vault(t *testing.T) {
+	var (
+		requests atomic.Int32
+		password atomic.Value
+		fail     atomic.Bool
+	)
+	password.Store("s3cret")
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("X-Vault-Token") != "token" || r.URL.Path != "/v1/secret/data/app/db" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if fail.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"data": map[string]any{"password": password.Load(), "port": 5432}},
+		})
+	}))
+	defer vault.Close()
+
+	resolver := global.NewSecretResolver(time.Hour)
+	resolver.Register("vault", global.NewVaultSecretProvider(vault.URL, "token", ""))
+	t.Setenv("DB_HOST", "db")
+
+	value, err := resolver.Expand("postgres://app:${vault:secret/app/db#password}@${DB_HOST}:${vault:secret/app/db#port}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "postgres://app:s3cret@db:5432" {
+		t.Errorf("unexpected value: %s", value)
+	}
+	// 命中缓存
+	if _, err := resolver.Expand("${vault:secret/app/db#password}"); err != nil || requests.Load() != 2 {
+		t.Errorf("expected cached value, requests %d, err %v", requests.Load(), err)
+	}
+	if _, err := resolver.Expand("${vault:secret/app/db#missing}"); err == nil {
+		t.Error("expected missing key error")
+	}
+
+	// 刷新时读取失败沿用旧值
+	fail.Store(true)
+	if resolver.Refresh() {
+		t.Error("failed refresh should not report change")
+	}
+	fail.Store(false)
+	password.Store("rotated")
+	if !resolver.Refresh() {
+		t.Error("expected rotated secret to be reported")
+	}
+	if value, _ := resolver.Expand("${vault:secret/app/db#password}"); value != "rotated" {
+		t.Errorf("expected rotated value, got %s", value)
+	}
+}
+
+func TestSecretResolver_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "redis_password")
+	if err := os.WriteFile(path, []byte("pa:ss\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	resolver := global.NewSecretResolver(0)
+	resolver.Register("file", global.NewFileSecretProvider())
+	if value, err := resolver.Expand("${file:" + path + "}"); err != nil || value != "pa:ss" {
+		t.Errorf("unexpected value %q, err %v", value, err)
+	}
+	if _, err := resolver.Expand("${file:" + path + ".missing}"); err == nil {
+		t.Error("expected missing file error")
+	}
+}
+
+func TestSecretResolver_AWSSecretsManager(t *testing.T) {
+	sm := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct{ SecretId string }
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if r.Header.Get("X-Amz-Target") != "secretsmanager.GetSecretValue" ||
+			!strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch req.SecretId {
+		case "prod/jwt":
+			_ = json.NewEncoder(w).Encode(map[string]string{"SecretString": "jwt-key"})
+		case "prod/redis":
+			_ = json.NewEncoder(w).Encode(map[string]string{"SecretString": `{"password":"r3dis"}`})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer sm.Close()
+
+	resolver := global.NewSecretResolver(time.Minute)
+	resolver.Register("awssm", global.NewAWSSecretsManagerProvider(sm.URL, "ap-northeast-1",
+		credentials.NewStaticCredentialsProvider("AKID", "SECRET", "")))
+	value, err := resolver.Expand("${awssm:prod/jwt} ${awssm:prod/redis#password}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "jwt-key r3dis" {
+		t.Errorf("unexpected value: %s", value)
+	}
+	if _, err := resolver.Expand("${awssm:prod/missing}"); err == nil {
+		t.Error("expected error for missing secret")
+	}
+}
@@ -4,12 +4,22 @@ import "gorm.io/gen"
 
 func exportIndexBackendModels(g *gen.Generator) {
 	alarmFilterWord := g.GenerateModelAs("index_backend.alarm_filter_word", "AlarmFilterWord")
+	alarmSilence := g.GenerateModelAs("index_backend.alarm_silence", "AlarmSilence")
+	eventOutbox := g.GenerateModelAs("index_backend.event_outbox", "EventOutbox")
+	jobRun := g.GenerateModelAs("index_backend.job_run", "JobRun")
 	userAuthInfo := g.GenerateModelAs("index_backend.user_auth_info", "UserAuthInfo")
 	userLoginLog := g.GenerateModelAs("index_backend.user_login_log", "UserLoginLog")
+	webhookDelivery := g.GenerateModelAs("index_backend.webhook_delivery", "WebhookDelivery")
+	webhookSubscription := g.GenerateModelAs("index_backend.webhook_subscription", "WebhookSubscription")
 
 	g.ApplyBasic(
 		alarmFilterWord,
+		alarmSilence,
+		eventOutbox,
+		jobRun,
 		userAuthInfo,
 		userLoginLog,
+		webhookDelivery,
+		webhookSubscription,
 	)
 }
@@ -0,0 +1,17 @@
+-- 告警静默规则，按标题/内容正则与标签匹配，ends_at之前生效
+CREATE TABLE IF NOT EXISTS index_backend.alarm_silence
+(
+    id            bigserial PRIMARY KEY,
+    title_pattern character varying(255)   NOT NULL,
+    info_pattern  character varying(1024)  NOT NULL,
+    labels        jsonb                    NOT NULL DEFAULT '{}',
+    creator       character varying(64)    NOT NULL,
+    comment       text                     NOT NULL,
+    starts_at     timestamp with time zone NOT NULL,
+    ends_at       timestamp with time zone NOT NULL,
+    created_at    timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 加载生效中的规则
+CREATE INDEX IF NOT EXISTS idx_alarm_silence_ends_at ON index_backend.alarm_silence (ends_at);
@@ -0,0 +1,25 @@
+-- 事务性outbox：领域事件与业务数据在同一事务中写入，由OutboxRelay投递到事件总线
+CREATE TABLE IF NOT EXISTS index_backend.event_outbox
+(
+    id              bigserial PRIMARY KEY,
+    event_id        character varying(64)    NOT NULL,
+    event_name      character varying(255)   NOT NULL,
+    aggregate_type  character varying(64)    NOT NULL,
+    aggregate_id    character varying(64)    NOT NULL,
+    payload         bytea                    NOT NULL,
+    status          smallint                 NOT NULL DEFAULT 0,
+    attempts        integer                  NOT NULL DEFAULT 0,
+    last_error      text                     NOT NULL DEFAULT '',
+    next_attempt_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at    timestamp with time zone,
+    created_at      timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uk_event_outbox_event_id ON index_backend.event_outbox (event_id);
+-- 取出到期的待投递事件
+CREATE INDEX IF NOT EXISTS idx_event_outbox_pending ON index_backend.event_outbox (next_attempt_at) WHERE status = 0;
+-- 检查同一聚合是否存在更早的待投递事件
+CREATE INDEX IF NOT EXISTS idx_event_outbox_pending_aggregate ON index_backend.event_outbox (aggregate_type, aggregate_id, id) WHERE status = 0;
+-- 清理已投递的事件
+CREATE INDEX IF NOT EXISTS idx_event_outbox_published_at ON index_backend.event_outbox (published_at) WHERE status = 1;
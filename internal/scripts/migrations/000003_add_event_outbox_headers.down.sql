@@ -0,0 +1 @@
+ALTER TABLE index_backend.event_outbox DROP COLUMN IF EXISTS headers;
@@ -0,0 +1,2 @@
+-- 事件头：trace context、用户、来源服务、schema版本与关联ID
+ALTER TABLE index_backend.event_outbox ADD COLUMN IF NOT EXISTS headers jsonb NOT NULL DEFAULT '{}';
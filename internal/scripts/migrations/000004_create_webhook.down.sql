@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS index_backend.webhook_delivery;
+DROP TABLE IF EXISTS index_backend.webhook_subscription;
@@ -0,0 +1,41 @@
+-- webhook订阅：事件处理成功后推送给订阅了该事件的地址
+CREATE TABLE IF NOT EXISTS index_backend.webhook_subscription
+(
+    id          bigserial PRIMARY KEY,
+    name        character varying(64)    NOT NULL,
+    url         character varying(1024)  NOT NULL,
+    event_types jsonb                    NOT NULL DEFAULT '[]',
+    secret      character varying(128)   NOT NULL,
+    enabled     boolean                  NOT NULL DEFAULT true,
+    description text                     NOT NULL DEFAULT '',
+    created_at  timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- webhook投递记录，status: 0 待投递，1 成功，2 失败
+CREATE TABLE IF NOT EXISTS index_backend.webhook_delivery
+(
+    id              bigserial PRIMARY KEY,
+    subscription_id bigint                   NOT NULL,
+    event_id        character varying(64)    NOT NULL,
+    event_name      character varying(255)   NOT NULL,
+    payload         text                     NOT NULL,
+    status          smallint                 NOT NULL DEFAULT 0,
+    attempts        integer                  NOT NULL DEFAULT 0,
+    response_code   integer                  NOT NULL DEFAULT 0,
+    last_error      text                     NOT NULL DEFAULT '',
+    duration_ms     integer                  NOT NULL DEFAULT 0,
+    next_attempt_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at    timestamp with time zone,
+    created_at      timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 同一事件对同一订阅只投递一次，AddDeliveries 依赖此索引去重
+CREATE UNIQUE INDEX IF NOT EXISTS uk_webhook_delivery_subscription_event ON index_backend.webhook_delivery (subscription_id, event_id);
+-- 取出到期的待投递记录
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_pending ON index_backend.webhook_delivery (next_attempt_at) WHERE status = 0;
+-- 按订阅分页查询投递记录
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_subscription_id ON index_backend.webhook_delivery (subscription_id, id);
+-- 清理已结束的投递记录
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_finished ON index_backend.webhook_delivery (updated_at) WHERE status <> 0;
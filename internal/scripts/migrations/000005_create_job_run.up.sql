@@ -0,0 +1,22 @@
+-- 定时任务执行记录，status: 0 执行中，1 成功，2 失败，3 已取消
+CREATE TABLE IF NOT EXISTS index_backend.job_run
+(
+    id            bigserial PRIMARY KEY,
+    job_name      character varying(64)    NOT NULL,
+    shard         integer                  NOT NULL DEFAULT 0,
+    shards        integer                  NOT NULL DEFAULT 0,
+    instance_id   character varying(255)   NOT NULL,
+    fencing_token bigint                   NOT NULL DEFAULT 0,
+    status        smallint                 NOT NULL DEFAULT 0,
+    error         text                     NOT NULL DEFAULT '',
+    duration_ms   bigint                   NOT NULL DEFAULT 0,
+    started_at    timestamp with time zone NOT NULL,
+    finished_at   timestamp with time zone,
+    created_at    timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 按任务查询执行记录及最近的执行状态
+CREATE INDEX IF NOT EXISTS idx_job_run_job_name_started_at ON index_backend.job_run (job_name, started_at DESC, id DESC);
+-- 查询所有任务的执行记录，清理超过保留时长的记录
+CREATE INDEX IF NOT EXISTS idx_job_run_started_at ON index_backend.job_run (started_at);
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/global"
+	"github.com/seanbit/kratos/template/internal/service"
+)
+
+// EventBusServer 以本服务的订阅组订阅已注册处理器的事件
+type EventBusServer struct {
+	group    string
+	bus      biz.IEventBus
+	registry *service.EventRegistry
+	handler  *service.EventService
+	log      *log.Helper
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func NewEventBusServer(config *conf.Server, bus biz.IEventBus, registry *service.EventRegistry, handler *service.EventService, logger log.Logger) *EventBusServer {
+	group := config.GetEventBus().GetGroup()
+	if group == "" {
+		group = global.GetServiceName()
+	}
+	return &EventBusServer{
+		group:    group,
+		bus:      bus,
+		registry: registry,
+		handler:  handler,
+		log:      log.NewHelper(log.With(logger, "module", "eventbus")),
+	}
+}
+
+func (s *EventBusServer) Start(ctx context.Context) error {
+	ctx, s.cancel = context.WithCancel(context.Background())
+	names := s.registry.EventTypes()
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		err := s.bus.Subscribe(ctx, s.group, names, s.handler.Consume)
+		if err != nil {
+			s.log.Errorf("[EventBus] %s subscriber %s stopped: %v", s.bus.Driver(), s.group, err)
+		}
+	}()
+	s.log.Infof("[EventBus] %s subscriber %s started, events: %v", s.bus.Driver(), s.group, names)
+	return nil
+}
+
+func (s *EventBusServer) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	s.log.Info("[EventBus] server stopped")
+	return nil
+}
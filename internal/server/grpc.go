@@ -1,8 +1,10 @@
 package server
 
 import (
+	"github.com/seanbit/kratos/template/api/event"
 	"github.com/seanbit/kratos/template/api/web"
 	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/server/middlewares"
 	"github.com/seanbit/kratos/template/internal/service"
 	"github.com/seanbit/kratos/webkit"
 
@@ -12,7 +14,7 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, probe *service.ProbeService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, probe *service.ProbeService, eventHandler *service.EventService, adminAuth *middlewares.AdminAuth, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
@@ -27,9 +29,14 @@ func NewGRPCServer(c *conf.Server, probe *service.ProbeService, logger log.Logge
 	if c.Grpc.Timeout != nil {
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
-	middlewares := webkit.PrepareMiddleWare()
-	opts = append(opts, grpc.Middleware(middlewares...))
+	middlewareFns := webkit.PrepareMiddleWare()
+	// 其他服务推送事件需要服务间认证
+	middlewareFns = append(middlewareFns, middlewares.NewServiceAuth(c, []string{"/event.EventHandler/"}, logger).Build())
+	// 与HTTP共用管理接口鉴权，注册到gRPC的admin服务同样受保护
+	middlewareFns = append(middlewareFns, adminAuth.Build())
+	opts = append(opts, grpc.Middleware(middlewareFns...))
 	srv := grpc.NewServer(opts...)
 	web.RegisterProbeServer(srv, probe)
+	event.RegisterEventHandlerServer(srv, eventHandler)
 	return srv
 }
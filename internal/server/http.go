@@ -5,9 +5,11 @@ import (
 
 	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/seanbit/kratos/template/api/admin"
 	"github.com/seanbit/kratos/template/api/web"
 	"github.com/seanbit/kratos/template/internal/biz"
 	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/global"
 	"github.com/seanbit/kratos/template/internal/server/middlewares"
 	"github.com/seanbit/kratos/template/internal/service"
 	"github.com/seanbit/kratos/webkit"
@@ -22,13 +24,13 @@ import (
 // NewHTTPServer new an HTTP server.
 func NewHTTPServer(c *conf.Server, logger log.Logger, middlewaresBuilder *middlewares.HttpBuilder,
 	probe *service.ProbeService, alarm biz.IAlarmRepo, auth *service.AuthService,
+	alarmAdmin *service.AlarmService, taskAdmin *service.TaskService, replayAdmin *service.EventReplayService,
+	webhookAdmin *service.WebhookService, crontabAdmin *service.CrontabService, configAdmin *service.ConfigService,
 ) *khttp.Server {
 	var opts = []khttp.ServerOption{
 		khttp.Filter(handlers.CORS(
-			handlers.AllowedOriginValidator(func(origin string) bool {
-				// 这里可以添加更多的逻辑来决定是否允许这个origin
-				return true
-			}),
+			// 读取当前生效的配置，cors_origins支持热更新
+			handlers.AllowedOriginValidator(allowedOrigin),
 			handlers.AllowedHeaders([]string{
 				"Access-Control-Allow-Credentials",
 				"authorization",
@@ -66,25 +68,41 @@ func NewHTTPServer(c *conf.Server, logger log.Logger, middlewaresBuilder *middle
 
 	middlewareFns := webkit.PrepareMiddleWare()
 	middlewareFns = append(middlewareFns, InjectContextMiddleware())
-	recoverFunc := func(ctx context.Context, req, err interface{}) error {
-		alarm.SendBizMessage(ctx, "panic error", "panic error")
-		log.Context(ctx).Errorf("panic error")
-		return recovery.ErrUnknownRequest
-	}
-	middlewareFns = append(middlewareFns, recovery.Recovery(recovery.WithHandler(recoverFunc)))
 	middlewareFns = append(middlewareFns,
 		middlewaresBuilder.Build()...,
 	)
+	// 放在鉴权之后，告警中可以带上用户信息；鉴权中的panic由PrepareMiddleWare中的recovery兜底
+	middlewareFns = append(middlewareFns, recovery.Recovery(recovery.WithHandler(middlewares.NewAlarmRecoveryHandler(alarm))))
 	opts = append(opts, khttp.Middleware(middlewareFns...))
 
 	srv := khttp.NewServer(opts...)
 	web.RegisterProbeHTTPServer(srv, probe)
 	web.RegisterAuthHTTPServer(srv, auth)
+	admin.RegisterAlarmHTTPServer(srv, alarmAdmin)
+	admin.RegisterTaskHTTPServer(srv, taskAdmin)
+	admin.RegisterEventReplayHTTPServer(srv, replayAdmin)
+	admin.RegisterWebhookHTTPServer(srv, webhookAdmin)
+	admin.RegisterCrontabHTTPServer(srv, crontabAdmin)
+	admin.RegisterConfigHTTPServer(srv, configAdmin)
 	srv.Handle("/metrics", promhttp.Handler())
 
 	return srv
 }
 
+// allowedOrigin 未配置cors_origins时允许所有origin
+func allowedOrigin(origin string) bool {
+	origins := global.GetConfig().GetServer().GetHttp().GetCorsOrigins()
+	if len(origins) == 0 {
+		return true
+	}
+	for _, allowed := range origins {
+		if allowed == origin {
+			return true
+		}
+	}
+	return false
+}
+
 func InjectContextMiddleware() middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
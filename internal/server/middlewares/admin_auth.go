@@ -0,0 +1,85 @@
+package middlewares
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/seanbit/kratos/template/api/web"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/global"
+	"github.com/seanbit/kratos/webkit"
+	"google.golang.org/grpc/metadata"
+)
+
+// AdminOperationPrefix 管理接口的路由前缀，HTTP与gRPC的operation相同，如 /admin.Alarm/DeleteSilence
+const AdminOperationPrefix = "/admin."
+
+// AdminAuth 管理接口鉴权：校验jwt并要求用户在配置的admin_user_ids中，HTTP与gRPC共用
+type AdminAuth struct {
+	userInfoServ IUserInfoService
+	adminUserIds atomic.Pointer[[]string]
+}
+
+func NewAdminAuth(config *conf.Auth, userInfoServ *biz.Auth) (*AdminAuth, func()) {
+	mw := &AdminAuth{userInfoServ: userInfoServ}
+	mw.store(config.GetAdminUserIds())
+	unsubscribe := global.SubscribeConfig("admin_user_ids", func(old, next *conf.Bootstrap) error {
+		mw.store(next.GetAuth().GetAdminUserIds())
+		return nil
+	})
+	return mw, unsubscribe
+}
+
+func (mw *AdminAuth) store(userIds []string) {
+	mw.adminUserIds.Store(&userIds)
+}
+
+// IsAdmin 用户是否可以调用管理接口
+func (mw *AdminAuth) IsAdmin(userId string) bool {
+	return userId != "" && slices.Contains(*mw.adminUserIds.Load(), userId)
+}
+
+func (mw *AdminAuth) Build() middleware.Middleware {
+	handler := func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			// 不依赖UserAuth是否已执行，未认证时在此校验jwt
+			userInfo := webkit.UserInfoFromContext(ctx)
+			if userInfo == nil {
+				token := authToken(ctx)
+				if token == "" {
+					return nil, web.ErrorAuthLoginTokenInvalid("missing auth token")
+				}
+				if userInfo, err = mw.userInfoServ.GetUserInfoByAuthToken(ctx, token); err != nil || userInfo == nil {
+					return nil, web.ErrorAuthLoginTokenInvalid("auth token verify failed: %v", err)
+				}
+				ctx = webkit.NewUserInfoContext(ctx, userInfo)
+			}
+			if !mw.IsAdmin(userInfo.UserId) {
+				log.Context(ctx).Warnf("admin auth failed: user %q, operation %s", userInfo.UserId, webkit.GetOperationFromContext(ctx))
+				return nil, web.ErrorAuthPermissionDenied("admin permission required")
+			}
+			return handler(ctx, req)
+		}
+	}
+	return selector.Server(handler).Prefix(AdminOperationPrefix).Build()
+}
+
+// authToken gRPC从metadata读取，HTTP从Authorization头读取
+func authToken(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("x-md-global-jwt-key-gen"); len(values) > 0 {
+			return values[0]
+		}
+	}
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		token, _ := webkit.FromAuthHeader(tr)
+		return token
+	}
+	return ""
+}
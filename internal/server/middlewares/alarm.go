@@ -0,0 +1,128 @@
+package middlewares
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"runtime/debug"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+)
+
+const (
+	// alarmStackMaxLines 告警中保留的调用栈最大行数
+	alarmStackMaxLines = 40
+	// alarmErrorChainMaxDepth 告警中展开的错误链最大深度
+	alarmErrorChainMaxDepth = 8
+)
+
+// NewAlarmRecoveryHandler panic恢复处理：发送携带panic值、调用栈及请求上下文的告警
+func NewAlarmRecoveryHandler(alarm biz.IAlarmRepo) recovery.HandlerFunc {
+	return func(ctx context.Context, req, err interface{}) error {
+		panicValue := fmt.Sprintf("%v", err)
+		stack := TrimStack(debug.Stack(), alarmStackMaxLines)
+		alarm.SendDetailMessage(ctx, &biz.AlarmDetail{
+			Title: "panic error",
+			Info:  panicValue,
+			Panic: panicValue,
+			Stack: stack,
+		})
+		log.Context(ctx).Errorf("panic error: %s\n%s", panicValue, stack)
+		return recovery.ErrUnknownRequest
+	}
+}
+
+// ServerErrorAlarm 对返回5xx错误的请求自动告警
+type ServerErrorAlarm struct {
+	config   *conf.Alarm_ServerError
+	excludes map[string]struct{}
+	alarm    biz.IAlarmRepo
+}
+
+func NewServerErrorAlarm(config *conf.Alarm, alarm biz.IAlarmRepo) *ServerErrorAlarm {
+	excludes := make(map[string]struct{}, len(config.GetServerError().GetExcludeReasons()))
+	for _, reason := range config.GetServerError().GetExcludeReasons() {
+		excludes[reason] = struct{}{}
+	}
+	return &ServerErrorAlarm{config: config.GetServerError(), excludes: excludes, alarm: alarm}
+}
+
+func (mw *ServerErrorAlarm) Build() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			reply, err = handler(ctx, req)
+			if err != nil && mw.shouldAlarm(err) {
+				se := errors.FromError(err)
+				mw.alarm.SendDetailMessage(ctx, &biz.AlarmDetail{
+					Title: fmt.Sprintf("server error: %d %s", se.Code, se.Reason),
+					Info:  se.Message,
+					Error: ErrorChain(err, alarmErrorChainMaxDepth),
+				})
+			}
+			return
+		}
+	}
+}
+
+func (mw *ServerErrorAlarm) shouldAlarm(err error) bool {
+	if !mw.config.GetEnabled() {
+		return false
+	}
+	// panic 已由 recovery 告警
+	if errors.Is(err, recovery.ErrUnknownRequest) {
+		return false
+	}
+	se := errors.FromError(err)
+	if se.Code < 500 {
+		return false
+	}
+	if _, ok := mw.excludes[se.Reason]; ok {
+		return false
+	}
+	if rate := mw.config.GetSampleRate(); rate > 0 && rate < 1 {
+		return rand.Float64() < rate
+	}
+	return true
+}
+
+// TrimStack 裁剪 debug.Stack() 的输出：去掉 panic 之前的 runtime/recovery 帧，并限制最大行数
+func TrimStack(stack []byte, maxLines int) string {
+	lines := strings.Split(strings.TrimSpace(string(stack)), "\n")
+	start := 1 // 跳过 "goroutine N [running]:"
+	for i, line := range lines {
+		if strings.HasPrefix(line, "panic(") {
+			start = i + 2 // 跳过 panic 帧及其文件行
+		}
+	}
+	if start >= len(lines) {
+		start = 0
+	}
+	lines = lines[start:]
+	if len(lines) > maxLines {
+		lines = append(lines[:maxLines], "...")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ErrorChain 展开错误链，每层一行
+func ErrorChain(err error, maxDepth int) string {
+	var chain []string
+	for depth := 0; err != nil && depth < maxDepth; depth++ {
+		msg := err.Error()
+		// 包装错误的信息包含了下一层，只保留本层新增的部分
+		if next := errors.Unwrap(err); next != nil {
+			msg = strings.TrimSuffix(strings.TrimSuffix(msg, next.Error()), ": ")
+		}
+		if msg != "" {
+			chain = append(chain, msg)
+		}
+		err = errors.Unwrap(err)
+	}
+	return strings.Join(chain, "\n  <- ")
+}
@@ -10,10 +10,14 @@ type HttpBuilder struct {
 	builders []Builder
 }
 
-func NewHttpBuilder(userAuth *UserAuth) *HttpBuilder {
+func NewHttpBuilder(routePolicy *RoutePolicy, userAuth *UserAuth, adminAuth *AdminAuth, serverErrorAlarm *ServerErrorAlarm) *HttpBuilder {
 	return &HttpBuilder{
 		builders: []Builder{
+			// 下线的路由不再鉴权
+			routePolicy,
 			userAuth,
+			adminAuth,
+			serverErrorAlarm,
 		},
 	}
 }
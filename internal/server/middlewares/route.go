@@ -0,0 +1,47 @@
+package middlewares
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/seanbit/kratos/template/api/web"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/global"
+)
+
+// RoutePolicy 按operation应用配置中的路由策略，配置热更新时替换
+type RoutePolicy struct {
+	policies atomic.Pointer[map[string]*conf.Server_RoutePolicy]
+}
+
+func NewRoutePolicy(config *conf.Server) (*RoutePolicy, func()) {
+	mw := &RoutePolicy{}
+	mw.store(config.GetRoutePolicies())
+	unsubscribe := global.SubscribeConfig("route_policies", func(old, next *conf.Bootstrap) error {
+		mw.store(next.GetServer().GetRoutePolicies())
+		return nil
+	})
+	return mw, unsubscribe
+}
+
+func (mw *RoutePolicy) store(policies map[string]*conf.Server_RoutePolicy) {
+	mw.policies.Store(&policies)
+}
+
+// Policy operation的路由策略，未配置时返回nil
+func (mw *RoutePolicy) Policy(operation string) *conf.Server_RoutePolicy {
+	return (*mw.policies.Load())[operation]
+}
+
+func (mw *RoutePolicy) Build() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			if tr, ok := transport.FromServerContext(ctx); ok && mw.Policy(tr.Operation()).GetDisabled() {
+				return nil, web.ErrorRouteDisabled("route %s is disabled", tr.Operation())
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package middlewares
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/seanbit/kratos/template/api/web"
+	"github.com/seanbit/kratos/template/internal/conf"
+)
+
+const (
+	// ServiceNameHeader 调用方服务名
+	ServiceNameHeader = "x-service-name"
+	// ServiceTokenHeader 调用方服务的token
+	ServiceTokenHeader = "x-service-token"
+)
+
+// ServiceAuth 服务间调用认证：按调用方服务名校验token
+type ServiceAuth struct {
+	tokens     map[string]string
+	operations []string
+}
+
+// NewServiceAuth operations为需要服务间认证的路由前缀，格式为 /package.service/
+func NewServiceAuth(config *conf.Server, operations []string, logger log.Logger) *ServiceAuth {
+	tokens := config.GetEventIngest().GetServiceTokens()
+	if len(tokens) == 0 {
+		log.NewHelper(logger).Warnf("no service tokens configured, all calls to %v will be rejected", operations)
+	}
+	return &ServiceAuth{tokens: tokens, operations: operations}
+}
+
+func (mw *ServiceAuth) Build() middleware.Middleware {
+	handler := func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, web.ErrorServiceAuthFailed("missing transport")
+			}
+			service := tr.RequestHeader().Get(ServiceNameHeader)
+			token := tr.RequestHeader().Get(ServiceTokenHeader)
+			expected, ok := mw.tokens[service]
+			if !ok || expected == "" || subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+				log.Context(ctx).Warnf("service auth failed: service %q, operation %s", service, tr.Operation())
+				return nil, web.ErrorServiceAuthFailed("service auth failed")
+			}
+			return handler(ctx, req)
+		}
+	}
+	return selector.Server(handler).Prefix(mw.operations...).Build()
+}
@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/seanbit/kratos/template/api/admin"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/global"
+	"github.com/seanbit/kratos/template/internal/server/middlewares"
+	"github.com/seanbit/kratos/template/internal/service"
+	"github.com/seanbit/kratos/webkit"
+	"google.golang.org/grpc/metadata"
+)
+
+type headerCarrier http.Header
+
+func (hc headerCarrier) Get(key string) string      { return http.Header(hc).Get(key) }
+func (hc headerCarrier) Set(key, value string)      { http.Header(hc).Set(key, value) }
+func (hc headerCarrier) Add(key, value string)      { http.Header(hc).Add(key, value) }
+func (hc headerCarrier) Keys() []string             { return nil }
+func (hc headerCarrier) Values(key string) []string { return http.Header(hc).Values(key) }
+
+// testTransport 模拟HTTP请求的transport
+type testTransport struct {
+	operation string
+	header    headerCarrier
+}
+
+func (tr *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (tr *testTransport) Endpoint() string                { return "" }
+func (tr *testTransport) Operation() string               { return tr.operation }
+func (tr *testTransport) RequestHeader() transport.Header { return tr.header }
+func (tr *testTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+
+func newServerContext(operation string, header map[string]string) context.Context {
+	tr := &testTransport{operation: operation, header: headerCarrier{}}
+	for k, v := range header {
+		tr.header.Set(k, v)
+	}
+	return transport.NewServerContext(context.Background(), tr)
+}
+
+func newTestAuth(t *testing.T) *biz.Auth {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return biz.NewAuth(&conf.Auth{JwtKey_25519: hex.EncodeToString(privateKey)}, nil, nil, nil, nil)
+}
+
+func newToken(t *testing.T, auth *biz.Auth, userId string) string {
+	token, err := auth.GenerateToken(&webkit.UserInfo{UserId: userId}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestAdminAuth(t *testing.T) {
+	auth := newTestAuth(t)
+	mw, unsubscribe := middlewares.NewAdminAuth(&conf.Auth{AdminUserIds: []string{"admin"}}, auth)
+	defer unsubscribe()
+
+	const adminOperation = "/admin.Config/GetEffectiveConfig"
+	cases := []struct {
+		name string
+		ctx  context.Context
+		code int
+	}{
+		{"admin", newServerContext(adminOperation, map[string]string{"Authorization": "Bearer " + newToken(t, auth, "admin")}), 200},
+		{"non-admin", newServerContext(adminOperation, map[string]string{"Authorization": "Bearer " + newToken(t, auth, "user")}), 403},
+		{"missing token", newServerContext(adminOperation, nil), 401},
+		{"invalid token", newServerContext(adminOperation, map[string]string{"Authorization": "Bearer invalid"}), 401},
+		{"authenticated non-admin", webkit.NewUserInfoContext(newServerContext("/admin.Alarm/DeleteSilence", nil), &webkit.UserInfo{UserId: "user"}), 403},
+		{"grpc admin", metadata.NewIncomingContext(newServerContext("/admin.Task/DeleteTask", nil), metadata.Pairs("x-md-global-jwt-key-gen", newToken(t, auth, "admin"))), 200},
+		{"grpc non-admin", metadata.NewIncomingContext(newServerContext("/admin.Task/DeleteTask", nil), metadata.Pairs("x-md-global-jwt-key-gen", newToken(t, auth, "user"))), 403},
+		{"non-admin operation", newServerContext("/web.Probe/healthStatus", nil), 200},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			called := false
+			_, err := mw.Build()(func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			})(c.ctx, nil)
+			if code := int(kerrors.Code(err)); code != c.code {
+				t.Errorf("expected %d, got %d: %v", c.code, code, err)
+			}
+			if called != (c.code == 200) {
+				t.Errorf("handler called: %v", called)
+			}
+		})
+	}
+}
+
+// GET /admin/config 只有管理员可以读取生效的配置
+func TestAdminAuth_EffectiveConfig(t *testing.T) {
+	global.SetConfig(&conf.Bootstrap{Name: "admin-test"})
+	auth := newTestAuth(t)
+	mw, unsubscribe := middlewares.NewAdminAuth(&conf.Auth{AdminUserIds: []string{"admin"}}, auth)
+	defer unsubscribe()
+	srv := khttp.NewServer(khttp.Middleware(mw.Build()))
+	admin.RegisterConfigHTTPServer(srv, service.NewConfigService())
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	for userId, code := range map[string]int{"admin": http.StatusOK, "user": http.StatusForbidden, "": http.StatusUnauthorized} {
+		req, err := http.NewRequest(http.MethodGet, ts.URL+"/admin/config", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if userId != "" {
+			req.Header.Set("Authorization", "Bearer "+newToken(t, auth, userId))
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != code {
+			t.Errorf("user %q: expected %d, got %d", userId, code, resp.StatusCode)
+		}
+	}
+}
+
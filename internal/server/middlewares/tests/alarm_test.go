@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/biz/mocks"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/server/middlewares"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAlarmRecoveryHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	alarm := mocks.NewMockIAlarmRepo(ctrl)
+	alarm.EXPECT().SendDetailMessage(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, detail *biz.AlarmDetail) {
+		if detail.Panic != "boom" || detail.Stack == "" {
+			t.Errorf("unexpected alarm detail: %+v", detail)
+		}
+	}).Times(1)
+
+	handler := recovery.Recovery(recovery.WithHandler(middlewares.NewAlarmRecoveryHandler(alarm)))(
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			panic("boom")
+		})
+	if _, err := handler(context.Background(), nil); !errors.Is(err, recovery.ErrUnknownRequest) {
+		t.Errorf("expected unknown request error, got %v", err)
+	}
+}
+
+func TestServerErrorAlarm(t *testing.T) {
+	cases := []struct {
+		name   string
+		config *conf.Alarm_ServerError
+		err    error
+		alarm  bool
+	}{
+		{"5xx", &conf.Alarm_ServerError{Enabled: true}, kerrors.ServiceUnavailable("UNAVAILABLE", "db down"), true},
+		{"4xx", &conf.Alarm_ServerError{Enabled: true}, kerrors.BadRequest("INVALID_PARAMS", "bad"), false},
+		{"disabled", &conf.Alarm_ServerError{}, kerrors.InternalServer("INTERNAL", "oops"), false},
+		{"excluded", &conf.Alarm_ServerError{Enabled: true, ExcludeReasons: []string{"INTERNAL"}}, kerrors.InternalServer("INTERNAL", "oops"), false},
+		{"panic", &conf.Alarm_ServerError{Enabled: true}, recovery.ErrUnknownRequest, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			alarm := mocks.NewMockIAlarmRepo(ctrl)
+			times := 0
+			if c.alarm {
+				times = 1
+			}
+			alarm.EXPECT().SendDetailMessage(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, detail *biz.AlarmDetail) {
+				if !strings.HasPrefix(detail.Title, "server error: 503") || detail.Error == "" {
+					t.Errorf("unexpected alarm detail: %+v", detail)
+				}
+			}).Times(times)
+
+			mw := middlewares.NewServerErrorAlarm(&conf.Alarm{ServerError: c.config}, alarm).Build()
+			_, err := mw(func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, c.err
+			})(context.Background(), nil)
+			if err != c.err {
+				t.Errorf("error should be returned unchanged, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTrimStack(t *testing.T) {
+	stack := strings.Join([]string{
+		"goroutine 1 [running]:",
+		"runtime/debug.Stack()",
+		"\t/usr/local/go/src/runtime/debug/stack.go:26 +0x5e",
+		"panic({0x1, 0x2})",
+		"\t/usr/local/go/src/runtime/panic.go:785 +0x132",
+		"main.handler()",
+		"\t/app/main.go:10 +0x1",
+		"main.main()",
+		"\t/app/main.go:20 +0x1",
+	}, "\n")
+	if trimmed := middlewares.TrimStack([]byte(stack), 10); !strings.HasPrefix(trimmed, "main.handler()") {
+		t.Errorf("frames before panic should be removed: %s", trimmed)
+	}
+	if trimmed := middlewares.TrimStack([]byte(stack), 2); strings.Count(trimmed, "\n") != 2 || !strings.HasSuffix(trimmed, "...") {
+		t.Errorf("stack should be limited to 2 lines: %s", trimmed)
+	}
+}
+
+func TestErrorChain(t *testing.T) {
+	root := errors.New("connection refused")
+	err := fmt.Errorf("query user: %w", fmt.Errorf("dial db: %w", root))
+	if chain := middlewares.ErrorChain(err, 8); chain != "query user\n  <- dial db\n  <- connection refused" {
+		t.Errorf("unexpected chain: %q", chain)
+	}
+	if chain := middlewares.ErrorChain(err, 1); chain != "query user" {
+		t.Errorf("depth should be limited: %q", chain)
+	}
+}
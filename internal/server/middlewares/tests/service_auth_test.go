@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/server/middlewares"
+)
+
+func TestServiceAuth(t *testing.T) {
+	config := &conf.Server{EventIngest: &conf.Server_EventIngest{ServiceTokens: map[string]string{"upstream": "secret"}}}
+	mw := middlewares.NewServiceAuth(config, []string{"/event.EventHandler/"}, log.DefaultLogger).Build()
+
+	const operation = "/event.EventHandler/HandleEvent"
+	cases := []struct {
+		name    string
+		ctx     context.Context
+		allowed bool
+	}{
+		{"correct token", newServerContext(operation, map[string]string{
+			middlewares.ServiceNameHeader: "upstream", middlewares.ServiceTokenHeader: "secret"}), true},
+		{"missing token", newServerContext(operation, map[string]string{middlewares.ServiceNameHeader: "upstream"}), false},
+		{"wrong token", newServerContext(operation, map[string]string{
+			middlewares.ServiceNameHeader: "upstream", middlewares.ServiceTokenHeader: "wrong"}), false},
+		{"unknown service", newServerContext(operation, map[string]string{
+			middlewares.ServiceNameHeader: "other", middlewares.ServiceTokenHeader: "secret"}), false},
+		{"non-matching operation", newServerContext("/web.Probe/healthStatus", nil), true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			called := false
+			_, err := mw(func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			})(c.ctx, nil)
+			if called != c.allowed {
+				t.Errorf("expected allowed %v, got %v: %v", c.allowed, called, err)
+			}
+			if !c.allowed && kerrors.Reason(err) != "SERVICE_AUTH_FAILED" {
+				t.Errorf("expected service auth error, got %v", err)
+			}
+		})
+	}
+
+	// 未配置token时拒绝所有调用
+	mw = middlewares.NewServiceAuth(&conf.Server{}, []string{"/event.EventHandler/"}, log.DefaultLogger).Build()
+	_, err := mw(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})(newServerContext(operation, map[string]string{middlewares.ServiceNameHeader: "upstream"}), nil)
+	if kerrors.Reason(err) != "SERVICE_AUTH_FAILED" {
+		t.Errorf("expected service auth error without tokens, got %v", err)
+	}
+}
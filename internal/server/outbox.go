@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/internal/biz"
+)
+
+// outboxCleanupInterval 清理已投递事件的间隔
+const outboxCleanupInterval = time.Hour
+
+// OutboxServer 定期将outbox中的事件投递到消息队列
+type OutboxServer struct {
+	relay  *biz.OutboxRelay
+	log    *log.Helper
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewOutboxServer(relay *biz.OutboxRelay, logger log.Logger) *OutboxServer {
+	return &OutboxServer{relay: relay, log: log.NewHelper(log.With(logger, "module", "outbox"))}
+}
+
+func (s *OutboxServer) Start(ctx context.Context) error {
+	ctx, s.cancel = context.WithCancel(context.Background())
+	s.wg.Add(2)
+	go s.relayLoop(ctx)
+	go s.cleanupLoop(ctx)
+	s.log.Infof("[Outbox] server started, poll interval %s", s.relay.PollInterval())
+	return nil
+}
+
+func (s *OutboxServer) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	s.log.Info("[Outbox] server stopped")
+	return nil
+}
+
+func (s *OutboxServer) relayLoop(ctx context.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.relay.PollInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		// 一批满额时立即继续，积压清空后再按间隔轮询
+		for ctx.Err() == nil {
+			handled, err := s.relay.RelayOnce(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					s.log.Errorf("relay outbox events: %v", err)
+				}
+				break
+			}
+			if handled < s.relay.BatchSize() {
+				break
+			}
+		}
+	}
+}
+
+func (s *OutboxServer) cleanupLoop(ctx context.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(outboxCleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		deleted, err := s.relay.Cleanup(ctx)
+		if err != nil {
+			s.log.Errorf("cleanup outbox events: %v", err)
+			continue
+		}
+		if deleted > 0 {
+			s.log.Infof("cleanup %d published outbox events", deleted)
+		}
+	}
+}
@@ -3,16 +3,19 @@ package server
 import (
 	"github.com/google/wire"
 	"github.com/seanbit/kratos/template/internal/server/middlewares"
-	"github.com/seanbit/kratos/webkit/transport/crontab"
 )
 
 // ProviderSet is server providers.
 var ProviderSet = wire.NewSet(
+	middlewares.NewRoutePolicy,
 	middlewares.NewUserAuth,
+	middlewares.NewAdminAuth,
+	middlewares.NewServerErrorAlarm,
 	middlewares.NewHttpBuilder,
 	NewGRPCServer,
 	NewHTTPServer,
-	NewAsynqServer,
+	NewEventBusServer,
 	NewAsynqClient,
-	crontab.NewServer,
+	NewOutboxServer,
+	NewWebhookServer,
 )
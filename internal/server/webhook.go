@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/internal/biz"
+)
+
+// webhookCleanupInterval 清理已结束投递记录的间隔
+const webhookCleanupInterval = time.Hour
+
+// WebhookServer 定期推送到期的webhook投递
+type WebhookServer struct {
+	webhook *biz.Webhook
+	log     *log.Helper
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func NewWebhookServer(webhook *biz.Webhook, logger log.Logger) *WebhookServer {
+	return &WebhookServer{webhook: webhook, log: log.NewHelper(log.With(logger, "module", "webhook"))}
+}
+
+func (s *WebhookServer) Start(ctx context.Context) error {
+	ctx, s.cancel = context.WithCancel(context.Background())
+	s.wg.Add(2)
+	go s.deliverLoop(ctx)
+	go s.cleanupLoop(ctx)
+	s.log.Infof("[Webhook] server started, poll interval %s", s.webhook.PollInterval())
+	return nil
+}
+
+func (s *WebhookServer) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	s.log.Info("[Webhook] server stopped")
+	return nil
+}
+
+func (s *WebhookServer) deliverLoop(ctx context.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.webhook.PollInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		// 一批满额时立即继续，积压清空后再按间隔轮询
+		for ctx.Err() == nil {
+			handled, err := s.webhook.DeliverOnce(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					s.log.Errorf("deliver webhooks: %v", err)
+				}
+				break
+			}
+			if handled < s.webhook.BatchSize() {
+				break
+			}
+		}
+	}
+}
+
+func (s *WebhookServer) cleanupLoop(ctx context.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(webhookCleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		deleted, err := s.webhook.Cleanup(ctx)
+		if err != nil {
+			s.log.Errorf("cleanup webhook deliveries: %v", err)
+			continue
+		}
+		if deleted > 0 {
+			s.log.Infof("cleanup %d finished webhook deliveries", deleted)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+
+	"time"
+
+	pb "github.com/seanbit/kratos/template/api/admin"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/webkit"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AlarmService struct {
+	pb.UnimplementedAlarmServer
+	alarmAdminBiz *biz.AlarmAdmin
+}
+
+func NewAlarmService(alarmAdminBiz *biz.AlarmAdmin) *AlarmService {
+	return &AlarmService{alarmAdminBiz: alarmAdminBiz}
+}
+
+// GetAlarmQueueStats 告警队列状态
+func (s *AlarmService) GetAlarmQueueStats(ctx context.Context, req *pb.GetAlarmQueueStatsRequest) (*pb.GetAlarmQueueStatsResponse, error) {
+	stats, err := s.alarmAdminBiz.GetQueueStats(ctx, int(req.DelayedLimit))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetAlarmQueueStatsResponse{
+		QueueLength:     stats.QueueLength,
+		DelayedSize:     stats.DelayedSize,
+		DelayedMessages: make([]*pb.AlarmQueueMessage, 0, len(stats.DelayedMessages)),
+		FusedMessages:   make([]*pb.FusedAlarmMessage, 0, len(stats.FusedMessages)),
+	}
+	for _, msg := range stats.DelayedMessages {
+		resp.DelayedMessages = append(resp.DelayedMessages, &pb.AlarmQueueMessage{
+			Platform:  msg.Platform,
+			Title:     msg.Title,
+			Info:      msg.Info,
+			TraceId:   msg.TraceId,
+			Retry:     int32(msg.Retry),
+			MaxRetry:  int32(msg.MaxRetry),
+			ExecuteAt: timestamppb.New(msg.ExecuteAt),
+		})
+	}
+	for _, msg := range stats.FusedMessages {
+		resp.FusedMessages = append(resp.FusedMessages, &pb.FusedAlarmMessage{
+			Fingerprint: msg.Fingerprint,
+			Info:        msg.Info,
+			TtlSeconds:  int64(msg.TTL.Seconds()),
+		})
+	}
+	return resp, nil
+}
+
+// CreateAlarmSilence 创建静默规则，创建者为当前登录用户
+func (s *AlarmService) CreateAlarmSilence(ctx context.Context, req *pb.CreateAlarmSilenceRequest) (*pb.AlarmSilence, error) {
+	silence := &biz.AlarmSilence{
+		TitlePattern: req.TitlePattern,
+		InfoPattern:  req.InfoPattern,
+		Labels:       req.Labels,
+		Creator:      operatorFromContext(ctx),
+		Comment:      req.Comment,
+	}
+	if req.StartsAt != nil {
+		silence.StartsAt = req.StartsAt.AsTime()
+	}
+	if req.EndsAt != nil {
+		silence.EndsAt = req.EndsAt.AsTime()
+	} else if req.Duration != nil {
+		start := silence.StartsAt
+		if start.IsZero() {
+			start = time.Now()
+		}
+		silence.EndsAt = start.Add(req.Duration.AsDuration())
+	}
+	if err := s.alarmAdminBiz.CreateSilence(ctx, silence); err != nil {
+		return nil, err
+	}
+	return toAlarmSilenceReply(silence, time.Now()), nil
+}
+
+// ListAlarmSilences 静默规则列表
+func (s *AlarmService) ListAlarmSilences(ctx context.Context, req *pb.ListAlarmSilencesRequest) (*pb.ListAlarmSilencesResponse, error) {
+	silences, err := s.alarmAdminBiz.ListSilences(ctx, req.IncludeExpired)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	resp := &pb.ListAlarmSilencesResponse{Silences: make([]*pb.AlarmSilence, 0, len(silences))}
+	for _, silence := range silences {
+		resp.Silences = append(resp.Silences, toAlarmSilenceReply(silence, now))
+	}
+	return resp, nil
+}
+
+// ExpireAlarmSilence 结束静默规则
+func (s *AlarmService) ExpireAlarmSilence(ctx context.Context, req *pb.ExpireAlarmSilenceRequest) (*emptypb.Empty, error) {
+	if err := s.alarmAdminBiz.ExpireSilence(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// AckAlarm 确认告警
+func (s *AlarmService) AckAlarm(ctx context.Context, req *pb.AckAlarmRequest) (*pb.AlarmAck, error) {
+	ack := &biz.AlarmAck{
+		Fingerprint: req.Fingerprint,
+		Creator:     operatorFromContext(ctx),
+		Comment:     req.Comment,
+	}
+	if err := s.alarmAdminBiz.AckAlarm(ctx, ack); err != nil {
+		return nil, err
+	}
+	return toAlarmAckReply(ack), nil
+}
+
+// ListAlarmAcks 已确认的告警列表
+func (s *AlarmService) ListAlarmAcks(ctx context.Context, req *emptypb.Empty) (*pb.ListAlarmAcksResponse, error) {
+	acks, err := s.alarmAdminBiz.ListAcks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListAlarmAcksResponse{Acks: make([]*pb.AlarmAck, 0, len(acks))}
+	for _, ack := range acks {
+		resp.Acks = append(resp.Acks, toAlarmAckReply(ack))
+	}
+	return resp, nil
+}
+
+// UnackAlarm 取消确认
+func (s *AlarmService) UnackAlarm(ctx context.Context, req *pb.UnackAlarmRequest) (*emptypb.Empty, error) {
+	if err := s.alarmAdminBiz.UnackAlarm(ctx, req.Fingerprint); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// operatorFromContext 管理操作的操作人：当前登录用户
+func operatorFromContext(ctx context.Context) string {
+	if userId, ok := webkit.UserIdFromContext(ctx); ok {
+		return userId
+	}
+	return "unknown"
+}
+
+func toAlarmSilenceReply(silence *biz.AlarmSilence, now time.Time) *pb.AlarmSilence {
+	return &pb.AlarmSilence{
+		Id:               silence.ID,
+		TitlePattern:     silence.TitlePattern,
+		InfoPattern:      silence.InfoPattern,
+		Labels:           silence.Labels,
+		Creator:          silence.Creator,
+		Comment:          silence.Comment,
+		StartsAt:         timestamppb.New(silence.StartsAt),
+		EndsAt:           timestamppb.New(silence.EndsAt),
+		CreatedAt:        timestamppb.New(silence.CreatedAt),
+		Active:           silence.IsActive(now),
+		RemainingSeconds: int64(silence.Remaining(now).Seconds()),
+	}
+}
+
+func toAlarmAckReply(ack *biz.AlarmAck) *pb.AlarmAck {
+	return &pb.AlarmAck{
+		Fingerprint:      ack.Fingerprint,
+		Creator:          ack.Creator,
+		Comment:          ack.Comment,
+		AckedAt:          timestamppb.New(ack.AckedAt),
+		RemainingSeconds: int64(ack.TTL.Seconds()),
+	}
+}
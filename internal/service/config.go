@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/seanbit/kratos/template/api/admin"
+	"github.com/seanbit/kratos/template/internal/global"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type ConfigService struct {
+	pb.UnimplementedConfigServer
+}
+
+func NewConfigService() *ConfigService {
+	return &ConfigService{}
+}
+
+// GetEffectiveConfig 当前生效的配置，敏感字段脱敏
+func (s *ConfigService) GetEffectiveConfig(ctx context.Context, req *emptypb.Empty) (*pb.EffectiveConfigReply, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(global.RedactConfig(global.GetConfig()))
+	if err != nil {
+		return nil, err
+	}
+	config := &structpb.Struct{}
+	if err := protojson.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	return &pb.EffectiveConfigReply{Config: config}, nil
+}
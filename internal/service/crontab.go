@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	pb "github.com/seanbit/kratos/template/api/admin"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/crontab"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CrontabService struct {
+	pb.UnimplementedCrontabServer
+	register *crontab.JobRegister
+	control  biz.IJobControlRepo
+	history  *biz.JobHistory
+}
+
+func NewCrontabService(register *crontab.JobRegister, control biz.IJobControlRepo, history *biz.JobHistory) *CrontabService {
+	return &CrontabService{register: register, control: control, history: history}
+}
+
+func (s *CrontabService) ListJobs(ctx context.Context, req *emptypb.Empty) (*pb.ListJobsReply, error) {
+	paused, err := s.control.ListPaused(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	reply := &pb.ListJobsReply{}
+	for _, wrap := range s.register.Jobs() {
+		item := &pb.Job{
+			Name:     wrap.Name(),
+			Spec:     wrap.Spec(),
+			Mode:     wrap.Mode().String(),
+			Shards:   int32(wrap.Shards()),
+			Timezone: wrap.Timezone(),
+			Disabled: wrap.Disabled(),
+		}
+		if next := wrap.Next(now); !next.IsZero() {
+			item.NextRunAt = timestamppb.New(next)
+		}
+		if pause, ok := paused[wrap.Name()]; ok {
+			item.Paused = true
+			item.PausedBy = pause.Operator
+			if !pause.PausedAt.IsZero() {
+				item.PausedAt = timestamppb.New(pause.PausedAt)
+			}
+		}
+		reply.Jobs = append(reply.Jobs, item)
+	}
+	return reply, nil
+}
+
+func (s *CrontabService) TriggerJob(ctx context.Context, req *pb.TriggerJobRequest) (*emptypb.Empty, error) {
+	job, ok := s.register.Job(req.JobName)
+	if !ok {
+		return nil, biz.ErrCrontabJobNotFound
+	}
+	job.Trigger(req.Params)
+	log.Context(ctx).Infof("Operator %s triggered crontab job %s with params %v", operatorFromContext(ctx), req.JobName, req.Params)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CrontabService) PauseJob(ctx context.Context, req *pb.JobNameRequest) (*emptypb.Empty, error) {
+	if _, ok := s.register.Job(req.JobName); !ok {
+		return nil, biz.ErrCrontabJobNotFound
+	}
+	operator := operatorFromContext(ctx)
+	if err := s.control.Pause(ctx, req.JobName, &biz.JobPause{Operator: operator, PausedAt: time.Now()}); err != nil {
+		return nil, err
+	}
+	log.Context(ctx).Infof("Operator %s paused crontab job %s", operator, req.JobName)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CrontabService) ResumeJob(ctx context.Context, req *pb.JobNameRequest) (*emptypb.Empty, error) {
+	if _, ok := s.register.Job(req.JobName); !ok {
+		return nil, biz.ErrCrontabJobNotFound
+	}
+	if err := s.control.Resume(ctx, req.JobName); err != nil {
+		return nil, err
+	}
+	log.Context(ctx).Infof("Operator %s resumed crontab job %s", operatorFromContext(ctx), req.JobName)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CrontabService) ListJobRuns(ctx context.Context, req *pb.ListJobRunsRequest) (*pb.ListJobRunsReply, error) {
+	runs, total, err := s.history.ListRuns(ctx, req.JobName, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListJobRunsReply{Runs: make([]*pb.JobRun, 0, len(runs)), Total: total}
+	for _, run := range runs {
+		reply.Runs = append(reply.Runs, toJobRunReply(run))
+	}
+	return reply, nil
+}
+
+func toJobRunReply(run *biz.JobRun) *pb.JobRun {
+	reply := &pb.JobRun{
+		Id:           run.Id,
+		JobName:      run.JobName,
+		Shard:        int32(run.Shard),
+		Shards:       int32(run.Shards),
+		InstanceId:   run.InstanceId,
+		FencingToken: run.FencingToken,
+		Status:       run.Status,
+		Error:        run.Error,
+		DurationMs:   run.Duration.Milliseconds(),
+		StartedAt:    timestamppb.New(run.StartedAt),
+	}
+	if !run.FinishedAt.IsZero() {
+		reply.FinishedAt = timestamppb.New(run.FinishedAt)
+	}
+	return reply
+}
@@ -3,44 +3,134 @@ package service
 import (
 	"context"
 
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	pkgerrors "github.com/pkg/errors"
 	"github.com/seanbit/kratos/template/api/event"
 	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-var (
-	EventTypeUserLogin = string(proto.MessageName(&event.UserLogin{}))
-)
-
 type EventService struct {
 	event.UnimplementedEventHandlerServer
-	auth *biz.Auth
+	registry  *EventRegistry
+	auth      *biz.Auth
+	publisher biz.IEventPublisher
+	webhook   *biz.Webhook
+	enqueue   bool
 }
 
-func NewEventService(auth *biz.Auth) event.EventHandlerServer {
-	return &EventService{auth: auth}
+func NewEventService(config *conf.Server, registry *EventRegistry, auth *biz.Auth, publisher biz.IEventPublisher, webhook *biz.Webhook, logger log.Logger) *EventService {
+	serv := &EventService{
+		registry:  registry,
+		auth:      auth,
+		publisher: publisher,
+		webhook:   webhook,
+		enqueue:   config.GetEventIngest().GetEnqueue(),
+	}
+	RegisterEventHandler(registry, serv.handleUserLoginEvent, WithIdempotency())
+	registry.AddDispatchHook(serv.notifyWebhook)
+	registry.logEventTypes(logger)
+	return serv
 }
 
+// HandleEvent 接收其他服务推送的事件：按配置投递到事件总线或同步处理
 func (serv *EventService) HandleEvent(ctx context.Context, e *event.Event) (*emptypb.Empty, error) {
-	switch e.Name {
-	case EventTypeUserLogin:
-		return serv.handleUserLoginEvent(ctx, e)
+	if err := serv.ingest(ctx, e); err != nil {
+		return nil, toEventIngestError(err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (serv *EventService) handleUserLoginEvent(ctx context.Context, e *event.Event) (*emptypb.Empty, error) {
-	message := &event.UserLogin{}
-	if err := proto.Unmarshal(e.Payload, message); err != nil {
-		return nil, err
+// HandleEvents 按顺序逐个处理，单个事件失败不影响后续事件
+func (serv *EventService) HandleEvents(ctx context.Context, req *event.HandleEventsRequest) (*event.HandleEventsReply, error) {
+	reply := &event.HandleEventsReply{Results: make([]*event.EventResult, 0, len(req.Events))}
+	for _, e := range req.Events {
+		result := &event.EventResult{Id: e.GetId(), Success: true}
+		if err := serv.ingest(ctx, e); err != nil {
+			se := errors.FromError(toEventIngestError(err))
+			result.Success = false
+			result.Reason = se.Reason
+			result.Message = se.Message
+		}
+		reply.Results = append(reply.Results, result)
 	}
-	err := serv.auth.SaveUserLoginLog(ctx, &biz.UserLoginLog{
+	return reply, nil
+}
+
+// Consume 处理事件总线上订阅到的事件
+func (serv *EventService) Consume(ctx context.Context, e *biz.Event) error {
+	return serv.dispatch(ctx, biz.NewEventEnvelope(e))
+}
+
+func (serv *EventService) ingest(ctx context.Context, e *event.Event) error {
+	if !serv.enqueue {
+		return serv.dispatch(ctx, e)
+	}
+	// 没有处理器的事件投递后只会被归档，直接拒绝
+	if !serv.registry.Handles(e.GetName()) {
+		return pkgerrors.Wrapf(biz.ErrUnhandledEvent, "event %s(%s)", e.GetName(), e.GetId())
+	}
+	be := biz.FromEventEnvelope(e)
+	if be.Timestamp.IsZero() {
+		be.Timestamp = time.Now()
+	}
+	if err := serv.publisher.Publish(ctx, be); err != nil {
+		return err
+	}
+	log.Context(ctx).Debugf("Enqueue event %s(%s) from %s", e.GetName(), e.GetId(), e.GetHeaders().GetSource())
+	return nil
+}
+
+func (serv *EventService) dispatch(ctx context.Context, e *event.Event) (err error) {
+	ctx, span := startEventSpan(ctx, e)
+	defer func() { endEventSpan(span, err) }()
+	log.Context(ctx).Debugf("Handle event %s(%s) from %s, schema version %d, correlation id %s",
+		e.GetName(), e.GetId(), e.GetHeaders().GetSource(), e.GetHeaders().GetSchemaVersion(), e.GetHeaders().GetCorrelationId())
+
+	if err = serv.registry.Dispatch(ctx, e); err != nil {
+		if pkgerrors.Is(err, biz.ErrUnhandledEvent) {
+			log.Context(ctx).Warnf("%v, archived as unhandled", err)
+		}
+		return err
+	}
+	return nil
+}
+
+// toEventIngestError 调用方可处理的错误保留原因和上下文信息，其他错误按5xx返回
+func toEventIngestError(err error) error {
+	se := errors.FromError(err)
+	if se.Code >= 500 {
+		return err
+	}
+	return errors.New(int(se.Code), se.Reason, err.Error())
+}
+
+// notifyWebhook 处理成功的事件推送给订阅方，data为payload的JSON
+func (serv *EventService) notifyWebhook(ctx context.Context, e *event.Event, message proto.Message) error {
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		return pkgerrors.Wrapf(biz.ErrInvalidEventPayload, "event %s(%s): %v", e.GetName(), e.GetId(), err)
+	}
+	createdAt := time.Now()
+	if e.GetTimestamp() != nil {
+		createdAt = e.GetTimestamp().AsTime()
+	}
+	return serv.webhook.Notify(ctx, &biz.WebhookPayload{Id: e.GetId(), Type: e.GetName(), CreatedAt: createdAt, Data: data})
+}
+
+func (serv *EventService) handleUserLoginEvent(ctx context.Context, e *event.Event, message *event.UserLogin) error {
+	return serv.auth.SaveUserLoginLog(ctx, &biz.UserLoginLog{
+		Id:         message.LogId,
 		UserId:     message.UserId,
 		AuthType:   message.AuthType,
 		LoginIp:    message.Ip,
 		LoginTime:  message.Timestamp.AsTime(),
 		IssueToken: message.IssueToken,
 	})
-	return &emptypb.Empty{}, err
 }
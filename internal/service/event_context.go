@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+
+	"github.com/seanbit/kratos/template/api/event"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/webkit"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const eventTracerName = "event"
+
+// startEventSpan 从事件头恢复生产者的trace context并开启消费span，
+// 同时将触发事件的用户和事件头放入ctx，供日志、告警及下游事件使用
+func startEventSpan(ctx context.Context, e *event.Event) (context.Context, trace.Span) {
+	headers := e.GetHeaders()
+	carrier := propagation.MapCarrier{}
+	if headers.GetTraceparent() != "" {
+		carrier.Set("traceparent", headers.GetTraceparent())
+		carrier.Set("tracestate", headers.GetTracestate())
+	}
+	ctx = propagation.TraceContext{}.Extract(ctx, carrier)
+	ctx, span := otel.Tracer(eventTracerName).Start(ctx, e.GetName(),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("event.id", e.GetId()),
+			attribute.String("event.source", headers.GetSource()),
+			attribute.Int("event.schema_version", int(headers.GetSchemaVersion())),
+			attribute.String("event.correlation_id", headers.GetCorrelationId()),
+		),
+	)
+	if headers.GetUserId() != "" {
+		ctx = webkit.NewUserInfoContext(ctx, &webkit.UserInfo{UserId: headers.GetUserId()})
+	}
+	if eventHeaders := biz.FromEventEnvelope(e).Headers; eventHeaders != nil {
+		ctx = biz.NewEventHeadersContext(ctx, eventHeaders)
+	}
+	return ctx, span
+}
+
+func endEventSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
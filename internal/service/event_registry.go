@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/api/event"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/pkg/pbhelper"
+	"google.golang.org/protobuf/proto"
+)
+
+// EventHandlerFunc 事件处理函数，message为按事件类型解码后的payload
+type EventHandlerFunc func(ctx context.Context, e *event.Event, message proto.Message) error
+
+type eventHandler struct {
+	fn         EventHandlerFunc
+	idempotent bool
+}
+
+// EventHandlerOption 事件处理器选项
+type EventHandlerOption func(*eventHandler)
+
+// WithIdempotency 按Event.Id去重：已成功处理的事件不再执行，同一事件不会被并发处理
+func WithIdempotency() EventHandlerOption {
+	return func(h *eventHandler) {
+		h.idempotent = true
+	}
+}
+
+// EventRegistry 按proto消息类型注册的事件处理器
+type EventRegistry struct {
+	handlers    map[string]*eventHandler
+	hooks       []EventHandlerFunc
+	idempotency *biz.EventIdempotency
+}
+
+func NewEventRegistry(idempotency *biz.EventIdempotency) *EventRegistry {
+	return &EventRegistry{handlers: make(map[string]*eventHandler), idempotency: idempotency}
+}
+
+// RegisterEventHandler 注册事件处理器，事件类型为T的proto全名
+func RegisterEventHandler[T proto.Message](registry *EventRegistry, handler func(ctx context.Context, e *event.Event, message T) error, opts ...EventHandlerOption) {
+	var zero T
+	name := string(proto.MessageName(zero))
+	if _, ok := registry.handlers[name]; ok {
+		panic(fmt.Sprintf("event handler for %s already registered", name))
+	}
+	h := &eventHandler{fn: func(ctx context.Context, e *event.Event, message proto.Message) error {
+		return handler(ctx, e, message.(T))
+	}}
+	for _, opt := range opts {
+		opt(h)
+	}
+	registry.handlers[name] = h
+}
+
+// AddDispatchHook 事件处理成功后依次调用hook，hook失败时事件按处理失败重试，hook需可重复执行；
+// context由biz.NewDispatchHooksDisabledContext标记时不调用
+func (registry *EventRegistry) AddDispatchHook(hook EventHandlerFunc) {
+	registry.hooks = append(registry.hooks, hook)
+}
+
+// EventTypes 已注册的事件类型
+func (registry *EventRegistry) EventTypes() []string {
+	types := make([]string, 0, len(registry.handlers))
+	for name := range registry.handlers {
+		types = append(types, name)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Handles 是否注册了事件类型name的处理器
+func (registry *EventRegistry) Handles(name string) bool {
+	_, ok := registry.handlers[name]
+	return ok
+}
+
+// Dispatch 解码事件payload并调用对应的处理器
+func (registry *EventRegistry) Dispatch(ctx context.Context, e *event.Event) error {
+	handler, ok := registry.handlers[e.Name]
+	if !ok {
+		return errors.Wrapf(biz.ErrUnhandledEvent, "event %s(%s)", e.Name, e.Id)
+	}
+	message, err := pbhelper.CreateProtoMessageByName(e.Name)
+	if err != nil {
+		return errors.Wrapf(biz.ErrInvalidEventPayload, "event %s(%s): %v", e.Name, e.Id, err)
+	}
+	if err = proto.Unmarshal(e.Payload, message); err != nil {
+		return errors.Wrapf(biz.ErrInvalidEventPayload, "event %s(%s): %v", e.Name, e.Id, err)
+	}
+	if !handler.idempotent || e.Id == "" {
+		err = handler.fn(ctx, e, message)
+	} else {
+		err = registry.idempotency.Run(ctx, e.Id, e.Name, func(ctx context.Context) error {
+			return handler.fn(ctx, e, message)
+		})
+	}
+	if err != nil || biz.DispatchHooksDisabled(ctx) {
+		return err
+	}
+	for _, hook := range registry.hooks {
+		if err = hook(ctx, e, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// logEventTypes 启动时输出已注册的事件类型
+func (registry *EventRegistry) logEventTypes(logger log.Logger) {
+	helper := log.NewHelper(logger)
+	for _, name := range registry.EventTypes() {
+		helper.Infof("event handler registered: %s, idempotent: %v", name, registry.handlers[name].idempotent)
+	}
+}
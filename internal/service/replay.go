@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	pb "github.com/seanbit/kratos/template/api/admin"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type EventReplayService struct {
+	pb.UnimplementedEventReplayServer
+	replayer *biz.EventReplayer
+	events   *EventService
+}
+
+func NewEventReplayService(replayer *biz.EventReplayer, events *EventService) *EventReplayService {
+	return &EventReplayService{replayer: replayer, events: events}
+}
+
+// StartEventReplay 后台重放事件
+func (s *EventReplayService) StartEventReplay(ctx context.Context, req *pb.StartEventReplayRequest) (*pb.EventReplayProgress, error) {
+	replayReq := biz.EventReplayRequest{
+		Source:         req.Source,
+		Name:           req.Name,
+		Target:         req.Target,
+		From:           req.From.AsTime(),
+		DryRun:         req.DryRun,
+		Rate:           req.Rate,
+		BatchSize:      int(req.BatchSize),
+		Limit:          int(req.Limit),
+		NotifyWebhooks: req.NotifyWebhooks,
+	}
+	if req.To != nil {
+		replayReq.To = req.To.AsTime()
+	}
+	progress, err := s.replayer.Start(replayReq, s.events.Consume)
+	if err != nil {
+		return nil, err
+	}
+	log.Context(ctx).Infof("Operator %s started event replay %s: %+v", operatorFromContext(ctx), progress.Id, replayReq)
+	return ToEventReplayProgressReply(progress), nil
+}
+
+// GetEventReplay 重放进度
+func (s *EventReplayService) GetEventReplay(ctx context.Context, req *pb.EventReplayIdRequest) (*pb.EventReplayProgress, error) {
+	progress, ok := s.replayer.Get(req.Id)
+	if !ok {
+		return nil, biz.ErrEventReplayNotFound
+	}
+	return ToEventReplayProgressReply(progress), nil
+}
+
+// CancelEventReplay 取消重放
+func (s *EventReplayService) CancelEventReplay(ctx context.Context, req *pb.EventReplayIdRequest) (*emptypb.Empty, error) {
+	if !s.replayer.Cancel(req.Id) {
+		return nil, biz.ErrEventReplayNotFound
+	}
+	log.Context(ctx).Infof("Operator %s cancelled event replay %s", operatorFromContext(ctx), req.Id)
+	return &emptypb.Empty{}, nil
+}
+
+// ToEventReplayProgressReply 重放进度的输出格式，命令行与接口共用
+func ToEventReplayProgressReply(progress *biz.EventReplayProgress) *pb.EventReplayProgress {
+	req := progress.Request
+	reply := &pb.EventReplayProgress{
+		Id: progress.Id,
+		Request: &pb.StartEventReplayRequest{
+			Source:         req.Source,
+			Name:           req.Name,
+			Target:         req.Target,
+			From:           timestamppb.New(req.From),
+			To:             timestamppb.New(req.To),
+			DryRun:         req.DryRun,
+			Rate:           req.Rate,
+			BatchSize:      int32(req.BatchSize),
+			Limit:          int32(req.Limit),
+			NotifyWebhooks: req.NotifyWebhooks,
+		},
+		Scanned:   int64(progress.Scanned),
+		Replayed:  int64(progress.Replayed),
+		Failed:    int64(progress.Failed),
+		LastError: progress.LastError,
+		Samples:   make([]*pb.EventReplaySample, 0, len(progress.Samples)),
+		StartedAt: timestamppb.New(progress.StartedAt),
+		Done:      progress.Done,
+	}
+	for _, e := range progress.Samples {
+		reply.Samples = append(reply.Samples, &pb.EventReplaySample{
+			Id:        e.Id,
+			Name:      e.Name,
+			Key:       e.Key,
+			Timestamp: timestamppb.New(e.Timestamp),
+		})
+	}
+	if !progress.FinishedAt.IsZero() {
+		reply.FinishedAt = timestamppb.New(progress.FinishedAt)
+	}
+	return reply
+}
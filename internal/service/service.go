@@ -4,7 +4,14 @@ import "github.com/google/wire"
 
 // ProviderSet is service providers.
 var ProviderSet = wire.NewSet(
+	NewEventRegistry,
 	NewEventService,
 	NewProbeService,
 	NewAuthService,
+	NewAlarmService,
+	NewTaskService,
+	NewEventReplayService,
+	NewWebhookService,
+	NewCrontabService,
+	NewConfigService,
 )
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	pb "github.com/seanbit/kratos/template/api/admin"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TaskService struct {
+	pb.UnimplementedTaskServer
+	taskAdminBiz *biz.TaskAdmin
+}
+
+func NewTaskService(taskAdminBiz *biz.TaskAdmin) *TaskService {
+	return &TaskService{taskAdminBiz: taskAdminBiz}
+}
+
+// ListTaskQueues 队列列表
+func (s *TaskService) ListTaskQueues(ctx context.Context, req *emptypb.Empty) (*pb.ListTaskQueuesResponse, error) {
+	queues, err := s.taskAdminBiz.ListQueues(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListTaskQueuesResponse{Queues: make([]*pb.TaskQueue, 0, len(queues))}
+	for _, queue := range queues {
+		resp.Queues = append(resp.Queues, &pb.TaskQueue{
+			Name:      queue.Queue,
+			Size:      int64(queue.Size),
+			Pending:   int64(queue.Pending),
+			Active:    int64(queue.Active),
+			Scheduled: int64(queue.Scheduled),
+			Retry:     int64(queue.Retry),
+			Archived:  int64(queue.Archived),
+			Completed: int64(queue.Completed),
+			Processed: int64(queue.Processed),
+			Failed:    int64(queue.Failed),
+			LatencyMs: queue.Latency.Milliseconds(),
+			Paused:    queue.Paused,
+		})
+	}
+	return resp, nil
+}
+
+// ListTasks 按状态分页列出任务
+func (s *TaskService) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
+	tasks, total, err := s.taskAdminBiz.ListTasks(ctx, req.Queue, req.State, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListTasksResponse{Total: int64(total), Tasks: make([]*pb.TaskInfo, 0, len(tasks))}
+	for _, task := range tasks {
+		info := &pb.TaskInfo{
+			Id:          task.Id,
+			Queue:       task.Queue,
+			Type:        task.Type,
+			State:       task.State,
+			EventId:     task.EventId,
+			Payload:     task.Payload,
+			DecodeError: task.DecodeError,
+			MaxRetry:    int32(task.MaxRetry),
+			Retried:     int32(task.Retried),
+			LastError:   task.LastErr,
+		}
+		if !task.LastFailedAt.IsZero() {
+			info.LastFailedAt = timestamppb.New(task.LastFailedAt)
+		}
+		if !task.NextProcessAt.IsZero() {
+			info.NextProcessAt = timestamppb.New(task.NextProcessAt)
+		}
+		resp.Tasks = append(resp.Tasks, info)
+	}
+	return resp, nil
+}
+
+// RunTask 立即执行任务
+func (s *TaskService) RunTask(ctx context.Context, req *pb.TaskRequest) (*emptypb.Empty, error) {
+	return s.applyAction(ctx, biz.TaskActionRun, req)
+}
+
+// ArchiveTask 归档任务
+func (s *TaskService) ArchiveTask(ctx context.Context, req *pb.TaskRequest) (*emptypb.Empty, error) {
+	return s.applyAction(ctx, biz.TaskActionArchive, req)
+}
+
+// DeleteTask 删除任务
+func (s *TaskService) DeleteTask(ctx context.Context, req *pb.TaskRequest) (*emptypb.Empty, error) {
+	return s.applyAction(ctx, biz.TaskActionDelete, req)
+}
+
+// BatchTasks 批量执行、归档或删除任务
+func (s *TaskService) BatchTasks(ctx context.Context, req *pb.BatchTasksRequest) (*pb.BatchTasksResponse, error) {
+	affected, failures, err := s.taskAdminBiz.BatchApplyAction(ctx, req.Action, req.Queue, req.State, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	log.Context(ctx).Infof("Operator %s %s tasks in %s, affected: %d, failed: %d",
+		operatorFromContext(ctx), req.Action, req.Queue, affected, len(failures))
+	resp := &pb.BatchTasksResponse{Affected: int64(affected), Failures: make([]*pb.BatchTaskFailure, 0, len(failures))}
+	for _, failure := range failures {
+		resp.Failures = append(resp.Failures, &pb.BatchTaskFailure{Id: failure.Id, Message: errors.FromError(failure.Err).Message})
+	}
+	return resp, nil
+}
+
+func (s *TaskService) applyAction(ctx context.Context, action string, req *pb.TaskRequest) (*emptypb.Empty, error) {
+	if err := s.taskAdminBiz.ApplyAction(ctx, action, req.Queue, req.Id); err != nil {
+		return nil, err
+	}
+	log.Context(ctx).Infof("Operator %s %s task %s/%s", operatorFromContext(ctx), action, req.Queue, req.Id)
+	return &emptypb.Empty{}, nil
+}
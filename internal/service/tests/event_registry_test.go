@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/seanbit/kratos/template/api/event"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/biz/mocks"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/service"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func newUserLoginEvent(t *testing.T, id, userId string) *event.Event {
+	payload, err := proto.Marshal(&event.UserLogin{UserId: userId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &event.Event{Id: id, Name: string(proto.MessageName(&event.UserLogin{})), Payload: payload}
+}
+
+func TestEventRegistry_Dispatch(t *testing.T) {
+	registry := service.NewEventRegistry(nil)
+	var (
+		handled []string
+		hooked  []string
+	)
+	service.RegisterEventHandler(registry, func(ctx context.Context, e *event.Event, message *event.UserLogin) error {
+		handled = append(handled, message.UserId)
+		if message.UserId == "failed" {
+			return errors.New("save failed")
+		}
+		return nil
+	})
+	service.RegisterEventHandler(registry, func(ctx context.Context, e *event.Event, message *emptypb.Empty) error {
+		return nil
+	})
+	registry.AddDispatchHook(func(ctx context.Context, e *event.Event, message proto.Message) error {
+		hooked = append(hooked, e.Id)
+		return nil
+	})
+
+	if types := registry.EventTypes(); !slices.Equal(types, []string{"event.UserLogin", "google.protobuf.Empty"}) {
+		t.Errorf("unexpected event types: %v", types)
+	}
+	if !registry.Handles("event.UserLogin") || registry.Handles("event.Unknown") {
+		t.Error("unexpected Handles result")
+	}
+
+	if err := registry.Dispatch(context.Background(), newUserLoginEvent(t, "1", "user")); err != nil {
+		t.Fatal(err)
+	}
+	// 处理失败时不调用hook
+	if err := registry.Dispatch(context.Background(), newUserLoginEvent(t, "2", "failed")); err == nil {
+		t.Error("expected handler error")
+	}
+	if !slices.Equal(handled, []string{"user", "failed"}) || !slices.Equal(hooked, []string{"1"}) {
+		t.Errorf("unexpected dispatch: handled %v, hooked %v", handled, hooked)
+	}
+
+	err := registry.Dispatch(context.Background(), &event.Event{Id: "3", Name: "event.Unknown"})
+	if !pkgerrors.Is(err, biz.ErrUnhandledEvent) {
+		t.Errorf("expected unhandled event error, got %v", err)
+	}
+	err = registry.Dispatch(context.Background(), &event.Event{Id: "4", Name: "event.UserLogin", Payload: []byte{0xff}})
+	if !pkgerrors.Is(err, biz.ErrInvalidEventPayload) {
+		t.Errorf("expected invalid payload error, got %v", err)
+	}
+}
+
+func TestEventRegistry_HookError(t *testing.T) {
+	registry := service.NewEventRegistry(nil)
+	service.RegisterEventHandler(registry, func(ctx context.Context, e *event.Event, message *event.UserLogin) error {
+		return nil
+	})
+	hookErr := errors.New("webhook unavailable")
+	registry.AddDispatchHook(func(ctx context.Context, e *event.Event, message proto.Message) error {
+		return hookErr
+	})
+	if err := registry.Dispatch(context.Background(), newUserLoginEvent(t, "1", "user")); !errors.Is(err, hookErr) {
+		t.Errorf("hook error should fail the dispatch, got %v", err)
+	}
+}
+
+func TestEventRegistry_HooksDisabled(t *testing.T) {
+	registry := service.NewEventRegistry(nil)
+	handled := 0
+	service.RegisterEventHandler(registry, func(ctx context.Context, e *event.Event, message *event.UserLogin) error {
+		handled++
+		return nil
+	})
+	registry.AddDispatchHook(func(ctx context.Context, e *event.Event, message proto.Message) error {
+		t.Error("hook should not be called")
+		return nil
+	})
+	ctx := biz.NewDispatchHooksDisabledContext(context.Background())
+	if err := registry.Dispatch(ctx, newUserLoginEvent(t, "1", "user")); err != nil || handled != 1 {
+		t.Errorf("expected handler called without hooks, handled %d, err %v", handled, err)
+	}
+}
+
+func TestEventRegistry_DuplicateHandler(t *testing.T) {
+	registry := service.NewEventRegistry(nil)
+	handler := func(ctx context.Context, e *event.Event, message *event.UserLogin) error { return nil }
+	service.RegisterEventHandler(registry, handler)
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic on duplicate registration")
+		}
+	}()
+	service.RegisterEventHandler(registry, handler)
+}
+
+func TestEventRegistry_Idempotency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockIProcessedEventRepo(ctrl)
+	gomock.InOrder(
+		repo.EXPECT().Claim(gomock.Any(), "1", "event.UserLogin", gomock.Any()).Return(biz.EventProcessClaimed, nil),
+		repo.EXPECT().Complete(gomock.Any(), "1", gomock.Any()).Return(nil),
+		repo.EXPECT().Claim(gomock.Any(), "1", "event.UserLogin", gomock.Any()).Return(biz.EventProcessCompleted, nil),
+	)
+	registry := service.NewEventRegistry(biz.NewEventIdempotency(&conf.Data{}, repo))
+	calls := 0
+	service.RegisterEventHandler(registry, func(ctx context.Context, e *event.Event, message *event.UserLogin) error {
+		calls++
+		return nil
+	}, service.WithIdempotency())
+
+	for i := 0; i < 2; i++ {
+		if err := registry.Dispatch(context.Background(), newUserLoginEvent(t, "1", "user")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected handler called once, got %d", calls)
+	}
+	// 没有事件ID时不去重
+	if err := registry.Dispatch(context.Background(), newUserLoginEvent(t, "", "user")); err != nil || calls != 2 {
+		t.Errorf("event without id should be handled directly, calls %d, err %v", calls, err)
+	}
+}
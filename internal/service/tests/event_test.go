@@ -0,0 +1,148 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/seanbit/kratos/template/api/event"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"github.com/seanbit/kratos/template/internal/biz/mocks"
+	"github.com/seanbit/kratos/template/internal/conf"
+	"github.com/seanbit/kratos/template/internal/service"
+	"github.com/seanbit/kratos/webkit"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// 消费事件时从事件头恢复trace、用户与关联ID
+func TestEventService_ConsumeRestoresContext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serv, registry := newTestEventService(ctrl, false, nil)
+
+	var handled context.Context
+	service.RegisterEventHandler(registry, func(ctx context.Context, e *event.Event, message *emptypb.Empty) error {
+		handled = ctx
+		return nil
+	})
+	payload, _ := proto.Marshal(&emptypb.Empty{})
+	err := serv.Consume(context.Background(), &biz.Event{
+		Id:      "1",
+		Name:    "google.protobuf.Empty",
+		Payload: payload,
+		Headers: &biz.EventHeaders{
+			Traceparent:   "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			UserId:        "user",
+			CorrelationId: "correlation",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if traceId := trace.SpanContextFromContext(handled).TraceID().String(); traceId != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("trace not restored: %s", traceId)
+	}
+	if userId, _ := webkit.UserIdFromContext(handled); userId != "user" {
+		t.Errorf("user not restored: %q", userId)
+	}
+	if headers := biz.EventHeadersFromContext(handled); headers == nil || headers.CorrelationId != "correlation" {
+		t.Errorf("event headers not restored: %+v", headers)
+	}
+}
+
+func newTestEventService(ctrl *gomock.Controller, enqueue bool, publisher biz.IEventPublisher) (*service.EventService, *service.EventRegistry) {
+	webhookRepo := mocks.NewMockIWebhookRepo(ctrl)
+	webhookRepo.EXPECT().ListSubscriptionsByEvent(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	registry := service.NewEventRegistry(nil)
+	serv := service.NewEventService(&conf.Server{EventIngest: &conf.Server_EventIngest{Enqueue: enqueue}},
+		registry, nil, publisher, biz.NewWebhook(&conf.Data{}, webhookRepo, nil, nil), log.DefaultLogger)
+	return serv, registry
+}
+
+func newEmptyEvent(id string) *event.Event {
+	payload, _ := proto.Marshal(&emptypb.Empty{})
+	return &event.Event{Id: id, Name: "google.protobuf.Empty", Payload: payload}
+}
+
+// 同步处理时逐个返回结果，单个事件失败不影响后续事件
+func TestEventService_HandleEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	serv, registry := newTestEventService(ctrl, false, nil)
+
+	var handled []string
+	service.RegisterEventHandler(registry, func(ctx context.Context, e *event.Event, message *emptypb.Empty) error {
+		handled = append(handled, e.Id)
+		if e.Id == "failed" {
+			return errors.New("db down")
+		}
+		return nil
+	})
+	reply, err := serv.HandleEvents(context.Background(), &event.HandleEventsRequest{Events: []*event.Event{
+		newEmptyEvent("ok"),
+		{Id: "unknown", Name: "event.Unknown"},
+		{Id: "invalid", Name: "google.protobuf.Empty", Payload: []byte{0xff}},
+		newEmptyEvent("failed"),
+		newEmptyEvent("last"),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []struct {
+		id      string
+		success bool
+		reason  string
+	}{
+		{"ok", true, ""},
+		{"unknown", false, "EVENT_UNHANDLED"},
+		{"invalid", false, "EVENT_INVALID_PAYLOAD"},
+		{"failed", false, ""},
+		{"last", true, ""},
+	}
+	if len(reply.Results) != len(expected) {
+		t.Fatalf("expected %d results, got %v", len(expected), reply.Results)
+	}
+	for i, result := range reply.Results {
+		if result.Id != expected[i].id || result.Success != expected[i].success || result.Reason != expected[i].reason {
+			t.Errorf("result %d: expected %+v, got %v", i, expected[i], result)
+		}
+	}
+	if !slices.Equal(handled, []string{"ok", "failed", "last"}) {
+		t.Errorf("unexpected handled events: %v", handled)
+	}
+}
+
+// 投递模式下只发布有处理器的事件，缺少时间戳时补充当前时间
+func TestEventService_HandleEventsEnqueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	publisher := mocks.NewMockIEventPublisher(ctrl)
+	serv, registry := newTestEventService(ctrl, true, publisher)
+	service.RegisterEventHandler(registry, func(ctx context.Context, e *event.Event, message *emptypb.Empty) error {
+		t.Error("handler should not be called when enqueue is enabled")
+		return nil
+	})
+
+	publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, e *biz.Event) error {
+		if e.Id != "ok" || e.Timestamp.IsZero() {
+			t.Errorf("unexpected published event: %+v", e)
+		}
+		return nil
+	}).Times(1)
+	reply, err := serv.HandleEvents(context.Background(), &event.HandleEventsRequest{Events: []*event.Event{
+		newEmptyEvent("ok"),
+		{Id: "unknown", Name: "event.Unknown"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reply.Results[0].Success || reply.Results[1].Success || reply.Results[1].Reason != "EVENT_UNHANDLED" {
+		t.Errorf("unexpected results: %v", reply.Results)
+	}
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	pb "github.com/seanbit/kratos/template/api/admin"
+	"github.com/seanbit/kratos/template/internal/biz"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WebhookService struct {
+	pb.UnimplementedWebhookServer
+	webhook  *biz.Webhook
+	registry *EventRegistry
+}
+
+func NewWebhookService(webhook *biz.Webhook, registry *EventRegistry) *WebhookService {
+	return &WebhookService{webhook: webhook, registry: registry}
+}
+
+// CreateWebhookSubscription 创建订阅，返回的secret用于订阅方校验签名
+func (s *WebhookService) CreateWebhookSubscription(ctx context.Context, req *pb.WebhookSubscription) (*pb.WebhookSubscription, error) {
+	if err := s.validateEventTypes(req.EventTypes); err != nil {
+		return nil, err
+	}
+	subscription := fromWebhookSubscriptionRequest(req)
+	if err := s.webhook.CreateSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+	log.Context(ctx).Infof("Operator %s created webhook subscription %s(%d) for %v",
+		operatorFromContext(ctx), subscription.Name, subscription.Id, subscription.EventTypes)
+	reply := toWebhookSubscriptionReply(subscription)
+	reply.Secret = subscription.Secret
+	return reply, nil
+}
+
+func (s *WebhookService) ListWebhookSubscriptions(ctx context.Context, req *emptypb.Empty) (*pb.ListWebhookSubscriptionsReply, error) {
+	subscriptions, err := s.webhook.ListSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListWebhookSubscriptionsReply{Subscriptions: make([]*pb.WebhookSubscription, 0, len(subscriptions))}
+	for _, subscription := range subscriptions {
+		reply.Subscriptions = append(reply.Subscriptions, toWebhookSubscriptionReply(subscription))
+	}
+	return reply, nil
+}
+
+func (s *WebhookService) UpdateWebhookSubscription(ctx context.Context, req *pb.WebhookSubscription) (*pb.WebhookSubscription, error) {
+	if err := s.validateEventTypes(req.EventTypes); err != nil {
+		return nil, err
+	}
+	subscription := fromWebhookSubscriptionRequest(req)
+	if err := s.webhook.UpdateSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+	log.Context(ctx).Infof("Operator %s updated webhook subscription %s(%d)", operatorFromContext(ctx), subscription.Name, subscription.Id)
+	return toWebhookSubscriptionReply(subscription), nil
+}
+
+func (s *WebhookService) DeleteWebhookSubscription(ctx context.Context, req *pb.WebhookSubscriptionIdRequest) (*emptypb.Empty, error) {
+	if err := s.webhook.DeleteSubscription(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	log.Context(ctx).Infof("Operator %s deleted webhook subscription %d", operatorFromContext(ctx), req.Id)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesReply, error) {
+	deliveries, total, err := s.webhook.ListDeliveries(ctx, req.SubscriptionId, req.Status, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListWebhookDeliveriesReply{Deliveries: make([]*pb.WebhookDelivery, 0, len(deliveries)), Total: total}
+	for _, delivery := range deliveries {
+		reply.Deliveries = append(reply.Deliveries, toWebhookDeliveryReply(delivery))
+	}
+	return reply, nil
+}
+
+func (s *WebhookService) RedeliverWebhook(ctx context.Context, req *pb.WebhookDeliveryIdRequest) (*emptypb.Empty, error) {
+	if err := s.webhook.Redeliver(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	log.Context(ctx).Infof("Operator %s redelivered webhook delivery %d", operatorFromContext(ctx), req.Id)
+	return &emptypb.Empty{}, nil
+}
+
+// validateEventTypes 只能订阅本服务能处理的事件
+func (s *WebhookService) validateEventTypes(eventTypes []string) error {
+	for _, name := range eventTypes {
+		if !s.registry.Handles(name) {
+			return biz.ErrWebhookSubscriptionInvalid.WithCause(fmt.Errorf("unknown event type: %s", name))
+		}
+	}
+	return nil
+}
+
+func fromWebhookSubscriptionRequest(req *pb.WebhookSubscription) *biz.WebhookSubscription {
+	return &biz.WebhookSubscription{
+		Id:          req.Id,
+		Name:        req.Name,
+		Url:         req.Url,
+		EventTypes:  req.EventTypes,
+		Secret:      req.Secret,
+		Enabled:     req.Enabled,
+		Description: req.Description,
+	}
+}
+
+func toWebhookSubscriptionReply(subscription *biz.WebhookSubscription) *pb.WebhookSubscription {
+	return &pb.WebhookSubscription{
+		Id:          subscription.Id,
+		Name:        subscription.Name,
+		Url:         subscription.Url,
+		EventTypes:  subscription.EventTypes,
+		Enabled:     subscription.Enabled,
+		Description: subscription.Description,
+		CreatedAt:   timestamppb.New(subscription.CreatedAt),
+		UpdatedAt:   timestamppb.New(subscription.UpdatedAt),
+	}
+}
+
+func toWebhookDeliveryReply(delivery *biz.WebhookDelivery) *pb.WebhookDelivery {
+	reply := &pb.WebhookDelivery{
+		Id:             delivery.Id,
+		SubscriptionId: delivery.SubscriptionId,
+		EventId:        delivery.EventId,
+		EventName:      delivery.EventName,
+		Payload:        string(delivery.Payload),
+		Status:         delivery.Status,
+		Attempts:       int32(delivery.Attempts),
+		ResponseCode:   int32(delivery.ResponseCode),
+		LastError:      delivery.LastError,
+		DurationMs:     int32(delivery.Duration.Milliseconds()),
+		NextAttemptAt:  timestamppb.New(delivery.NextAttemptAt),
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+	}
+	if !delivery.DeliveredAt.IsZero() {
+		reply.DeliveredAt = timestamppb.New(delivery.DeliveredAt)
+	}
+	return reply
+}